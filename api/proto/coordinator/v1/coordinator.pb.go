@@ -0,0 +1,1726 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/proto/coordinator/v1/coordinator.proto
+
+package coordinatorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       string                 `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	WeightKg      float64                `protobuf:"fixed64,2,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	DestinationUf string                 `protobuf:"bytes,3,opt,name=destination_uf,json=destinationUf,proto3" json:"destination_uf,omitempty"`
+	DeclaredValue float64                `protobuf:"fixed64,4,opt,name=declared_value,json=declaredValue,proto3" json:"declared_value,omitempty"`
+	AutoContract  *bool                  `protobuf:"varint,5,opt,name=auto_contract,json=autoContract,proto3,oneof" json:"auto_contract,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateOrderRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetWeightKg() float64 {
+	if x != nil {
+		return x.WeightKg
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetDestinationUf() string {
+	if x != nil {
+		return x.DestinationUf
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetDeclaredValue() float64 {
+	if x != nil {
+		return x.DeclaredValue
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetAutoContract() bool {
+	if x != nil && x.AutoContract != nil {
+		return *x.AutoContract
+	}
+	return false
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Product       string                 `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	WeightKg      string                 `protobuf:"bytes,3,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	DestinationUf string                 `protobuf:"bytes,4,opt,name=destination_uf,json=destinationUf,proto3" json:"destination_uf,omitempty"`
+	DeclaredValue string                 `protobuf:"bytes,5,opt,name=declared_value,json=declaredValue,proto3" json:"declared_value,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ContractId    *string                `protobuf:"bytes,7,opt,name=contract_id,json=contractId,proto3,oneof" json:"contract_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *Order) GetWeightKg() string {
+	if x != nil {
+		return x.WeightKg
+	}
+	return ""
+}
+
+func (x *Order) GetDestinationUf() string {
+	if x != nil {
+		return x.DestinationUf
+	}
+	return ""
+}
+
+func (x *Order) GetDeclaredValue() string {
+	if x != nil {
+		return x.DeclaredValue
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetContractId() string {
+	if x != nil && x.ContractId != nil {
+		return *x.ContractId
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateOrderStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{4}
+}
+
+type CreateCarrierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Policies      []*CarrierPolicyInput  `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCarrierRequest) Reset() {
+	*x = CreateCarrierRequest{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCarrierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCarrierRequest) ProtoMessage() {}
+
+func (x *CreateCarrierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCarrierRequest.ProtoReflect.Descriptor instead.
+func (*CreateCarrierRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateCarrierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCarrierRequest) GetPolicies() []*CarrierPolicyInput {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type CarrierPolicyInput struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Region              string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	EstimatedDays       int32                  `protobuf:"varint,2,opt,name=estimated_days,json=estimatedDays,proto3" json:"estimated_days,omitempty"`
+	PricePerKg          float64                `protobuf:"fixed64,3,opt,name=price_per_kg,json=pricePerKg,proto3" json:"price_per_kg,omitempty"`
+	AdValoremPercentage float64                `protobuf:"fixed64,4,opt,name=ad_valorem_percentage,json=adValoremPercentage,proto3" json:"ad_valorem_percentage,omitempty"`
+	GrisPercentage      float64                `protobuf:"fixed64,5,opt,name=gris_percentage,json=grisPercentage,proto3" json:"gris_percentage,omitempty"`
+	GrisMinimum         float64                `protobuf:"fixed64,6,opt,name=gris_minimum,json=grisMinimum,proto3" json:"gris_minimum,omitempty"`
+	TollPerFraction     float64                `protobuf:"fixed64,7,opt,name=toll_per_fraction,json=tollPerFraction,proto3" json:"toll_per_fraction,omitempty"`
+	TdePercentage       float64                `protobuf:"fixed64,8,opt,name=tde_percentage,json=tdePercentage,proto3" json:"tde_percentage,omitempty"`
+	TdeUfs              []string               `protobuf:"bytes,9,rep,name=tde_ufs,json=tdeUfs,proto3" json:"tde_ufs,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CarrierPolicyInput) Reset() {
+	*x = CarrierPolicyInput{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarrierPolicyInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrierPolicyInput) ProtoMessage() {}
+
+func (x *CarrierPolicyInput) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrierPolicyInput.ProtoReflect.Descriptor instead.
+func (*CarrierPolicyInput) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CarrierPolicyInput) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CarrierPolicyInput) GetEstimatedDays() int32 {
+	if x != nil {
+		return x.EstimatedDays
+	}
+	return 0
+}
+
+func (x *CarrierPolicyInput) GetPricePerKg() float64 {
+	if x != nil {
+		return x.PricePerKg
+	}
+	return 0
+}
+
+func (x *CarrierPolicyInput) GetAdValoremPercentage() float64 {
+	if x != nil {
+		return x.AdValoremPercentage
+	}
+	return 0
+}
+
+func (x *CarrierPolicyInput) GetGrisPercentage() float64 {
+	if x != nil {
+		return x.GrisPercentage
+	}
+	return 0
+}
+
+func (x *CarrierPolicyInput) GetGrisMinimum() float64 {
+	if x != nil {
+		return x.GrisMinimum
+	}
+	return 0
+}
+
+func (x *CarrierPolicyInput) GetTollPerFraction() float64 {
+	if x != nil {
+		return x.TollPerFraction
+	}
+	return 0
+}
+
+func (x *CarrierPolicyInput) GetTdePercentage() float64 {
+	if x != nil {
+		return x.TdePercentage
+	}
+	return 0
+}
+
+func (x *CarrierPolicyInput) GetTdeUfs() []string {
+	if x != nil {
+		return x.TdeUfs
+	}
+	return nil
+}
+
+type Carrier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Policies      []*CarrierPolicy       `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Carrier) Reset() {
+	*x = Carrier{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Carrier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Carrier) ProtoMessage() {}
+
+func (x *Carrier) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Carrier.ProtoReflect.Descriptor instead.
+func (*Carrier) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Carrier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Carrier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Carrier) GetPolicies() []*CarrierPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *Carrier) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Carrier) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CarrierPolicy struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Region              string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	EstimatedDays       int32                  `protobuf:"varint,2,opt,name=estimated_days,json=estimatedDays,proto3" json:"estimated_days,omitempty"`
+	PricePerKg          string                 `protobuf:"bytes,3,opt,name=price_per_kg,json=pricePerKg,proto3" json:"price_per_kg,omitempty"`
+	AdValoremPercentage string                 `protobuf:"bytes,4,opt,name=ad_valorem_percentage,json=adValoremPercentage,proto3" json:"ad_valorem_percentage,omitempty"`
+	GrisPercentage      string                 `protobuf:"bytes,5,opt,name=gris_percentage,json=grisPercentage,proto3" json:"gris_percentage,omitempty"`
+	GrisMinimum         string                 `protobuf:"bytes,6,opt,name=gris_minimum,json=grisMinimum,proto3" json:"gris_minimum,omitempty"`
+	TollPerFraction     string                 `protobuf:"bytes,7,opt,name=toll_per_fraction,json=tollPerFraction,proto3" json:"toll_per_fraction,omitempty"`
+	TdePercentage       string                 `protobuf:"bytes,8,opt,name=tde_percentage,json=tdePercentage,proto3" json:"tde_percentage,omitempty"`
+	TdeUfs              []string               `protobuf:"bytes,9,rep,name=tde_ufs,json=tdeUfs,proto3" json:"tde_ufs,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CarrierPolicy) Reset() {
+	*x = CarrierPolicy{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarrierPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrierPolicy) ProtoMessage() {}
+
+func (x *CarrierPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrierPolicy.ProtoReflect.Descriptor instead.
+func (*CarrierPolicy) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CarrierPolicy) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CarrierPolicy) GetEstimatedDays() int32 {
+	if x != nil {
+		return x.EstimatedDays
+	}
+	return 0
+}
+
+func (x *CarrierPolicy) GetPricePerKg() string {
+	if x != nil {
+		return x.PricePerKg
+	}
+	return ""
+}
+
+func (x *CarrierPolicy) GetAdValoremPercentage() string {
+	if x != nil {
+		return x.AdValoremPercentage
+	}
+	return ""
+}
+
+func (x *CarrierPolicy) GetGrisPercentage() string {
+	if x != nil {
+		return x.GrisPercentage
+	}
+	return ""
+}
+
+func (x *CarrierPolicy) GetGrisMinimum() string {
+	if x != nil {
+		return x.GrisMinimum
+	}
+	return ""
+}
+
+func (x *CarrierPolicy) GetTollPerFraction() string {
+	if x != nil {
+		return x.TollPerFraction
+	}
+	return ""
+}
+
+func (x *CarrierPolicy) GetTdePercentage() string {
+	if x != nil {
+		return x.TdePercentage
+	}
+	return ""
+}
+
+func (x *CarrierPolicy) GetTdeUfs() []string {
+	if x != nil {
+		return x.TdeUfs
+	}
+	return nil
+}
+
+type GetQuotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Sort          string                 `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotesRequest) Reset() {
+	*x = GetQuotesRequest{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotesRequest) ProtoMessage() {}
+
+func (x *GetQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotesRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetQuotesRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetQuotesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type GetQuotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotes        []*Quote               `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotesResponse) Reset() {
+	*x = GetQuotesResponse{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotesResponse) ProtoMessage() {}
+
+func (x *GetQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotesResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetQuotesResponse) GetQuotes() []*Quote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+type Quote struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CarrierId               string                 `protobuf:"bytes,2,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	CarrierName             string                 `protobuf:"bytes,3,opt,name=carrier_name,json=carrierName,proto3" json:"carrier_name,omitempty"`
+	BasePrice               string                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	FuelSurchargePercentage string                 `protobuf:"bytes,5,opt,name=fuel_surcharge_percentage,json=fuelSurchargePercentage,proto3" json:"fuel_surcharge_percentage,omitempty"`
+	FuelSurcharge           string                 `protobuf:"bytes,6,opt,name=fuel_surcharge,json=fuelSurcharge,proto3" json:"fuel_surcharge,omitempty"`
+	AdValorem               string                 `protobuf:"bytes,7,opt,name=ad_valorem,json=adValorem,proto3" json:"ad_valorem,omitempty"`
+	Gris                    string                 `protobuf:"bytes,8,opt,name=gris,proto3" json:"gris,omitempty"`
+	Toll                    string                 `protobuf:"bytes,9,opt,name=toll,proto3" json:"toll,omitempty"`
+	Tde                     string                 `protobuf:"bytes,10,opt,name=tde,proto3" json:"tde,omitempty"`
+	Discount                string                 `protobuf:"bytes,11,opt,name=discount,proto3" json:"discount,omitempty"`
+	IcmsRate                string                 `protobuf:"bytes,12,opt,name=icms_rate,json=icmsRate,proto3" json:"icms_rate,omitempty"`
+	Icms                    string                 `protobuf:"bytes,13,opt,name=icms,proto3" json:"icms,omitempty"`
+	TotalPrice              string                 `protobuf:"bytes,14,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Price                   string                 `protobuf:"bytes,15,opt,name=price,proto3" json:"price,omitempty"`
+	EstimatedDays           int32                  `protobuf:"varint,16,opt,name=estimated_days,json=estimatedDays,proto3" json:"estimated_days,omitempty"`
+	Reliability             string                 `protobuf:"bytes,17,opt,name=reliability,proto3" json:"reliability,omitempty"`
+	Score                   string                 `protobuf:"bytes,18,opt,name=score,proto3" json:"score,omitempty"`
+	Recommended             bool                   `protobuf:"varint,19,opt,name=recommended,proto3" json:"recommended,omitempty"`
+	PolicyVersion           int32                  `protobuf:"varint,20,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	ExpiresAt               *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Breakdown               []*PriceLine           `protobuf:"bytes,22,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	Explanation             *PriceExplanation      `protobuf:"bytes,23,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Quote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Quote) GetCarrierId() string {
+	if x != nil {
+		return x.CarrierId
+	}
+	return ""
+}
+
+func (x *Quote) GetCarrierName() string {
+	if x != nil {
+		return x.CarrierName
+	}
+	return ""
+}
+
+func (x *Quote) GetBasePrice() string {
+	if x != nil {
+		return x.BasePrice
+	}
+	return ""
+}
+
+func (x *Quote) GetFuelSurchargePercentage() string {
+	if x != nil {
+		return x.FuelSurchargePercentage
+	}
+	return ""
+}
+
+func (x *Quote) GetFuelSurcharge() string {
+	if x != nil {
+		return x.FuelSurcharge
+	}
+	return ""
+}
+
+func (x *Quote) GetAdValorem() string {
+	if x != nil {
+		return x.AdValorem
+	}
+	return ""
+}
+
+func (x *Quote) GetGris() string {
+	if x != nil {
+		return x.Gris
+	}
+	return ""
+}
+
+func (x *Quote) GetToll() string {
+	if x != nil {
+		return x.Toll
+	}
+	return ""
+}
+
+func (x *Quote) GetTde() string {
+	if x != nil {
+		return x.Tde
+	}
+	return ""
+}
+
+func (x *Quote) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
+func (x *Quote) GetIcmsRate() string {
+	if x != nil {
+		return x.IcmsRate
+	}
+	return ""
+}
+
+func (x *Quote) GetIcms() string {
+	if x != nil {
+		return x.Icms
+	}
+	return ""
+}
+
+func (x *Quote) GetTotalPrice() string {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return ""
+}
+
+func (x *Quote) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Quote) GetEstimatedDays() int32 {
+	if x != nil {
+		return x.EstimatedDays
+	}
+	return 0
+}
+
+func (x *Quote) GetReliability() string {
+	if x != nil {
+		return x.Reliability
+	}
+	return ""
+}
+
+func (x *Quote) GetScore() string {
+	if x != nil {
+		return x.Score
+	}
+	return ""
+}
+
+func (x *Quote) GetRecommended() bool {
+	if x != nil {
+		return x.Recommended
+	}
+	return false
+}
+
+func (x *Quote) GetPolicyVersion() int32 {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return 0
+}
+
+func (x *Quote) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Quote) GetBreakdown() []*PriceLine {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *Quote) GetExplanation() *PriceExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+type PriceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLine) Reset() {
+	*x = PriceLine{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLine) ProtoMessage() {}
+
+func (x *PriceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLine.ProtoReflect.Descriptor instead.
+func (*PriceLine) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PriceLine) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PriceLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PriceLine) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type PriceExplanation struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Source             string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	PolicyId           *string                `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3,oneof" json:"policy_id,omitempty"`
+	PolicyVersion      int32                  `protobuf:"varint,3,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	Region             string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	OriginUf           string                 `protobuf:"bytes,5,opt,name=origin_uf,json=originUf,proto3" json:"origin_uf,omitempty"`
+	DestinationUf      string                 `protobuf:"bytes,6,opt,name=destination_uf,json=destinationUf,proto3" json:"destination_uf,omitempty"`
+	ChargeableWeightKg string                 `protobuf:"bytes,7,opt,name=chargeable_weight_kg,json=chargeableWeightKg,proto3" json:"chargeable_weight_kg,omitempty"`
+	RatePerKg          string                 `protobuf:"bytes,8,opt,name=rate_per_kg,json=ratePerKg,proto3" json:"rate_per_kg,omitempty"`
+	CarrierReference   string                 `protobuf:"bytes,9,opt,name=carrier_reference,json=carrierReference,proto3" json:"carrier_reference,omitempty"`
+	Rounding           string                 `protobuf:"bytes,10,opt,name=rounding,proto3" json:"rounding,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PriceExplanation) Reset() {
+	*x = PriceExplanation{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceExplanation) ProtoMessage() {}
+
+func (x *PriceExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceExplanation.ProtoReflect.Descriptor instead.
+func (*PriceExplanation) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PriceExplanation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetPolicyId() string {
+	if x != nil && x.PolicyId != nil {
+		return *x.PolicyId
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetPolicyVersion() int32 {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return 0
+}
+
+func (x *PriceExplanation) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetOriginUf() string {
+	if x != nil {
+		return x.OriginUf
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetDestinationUf() string {
+	if x != nil {
+		return x.DestinationUf
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetChargeableWeightKg() string {
+	if x != nil {
+		return x.ChargeableWeightKg
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetRatePerKg() string {
+	if x != nil {
+		return x.RatePerKg
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetCarrierReference() string {
+	if x != nil {
+		return x.CarrierReference
+	}
+	return ""
+}
+
+func (x *PriceExplanation) GetRounding() string {
+	if x != nil {
+		return x.Rounding
+	}
+	return ""
+}
+
+type ContractCarrierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CarrierId     string                 `protobuf:"bytes,2,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	QuoteId       *string                `protobuf:"bytes,3,opt,name=quote_id,json=quoteId,proto3,oneof" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContractCarrierRequest) Reset() {
+	*x = ContractCarrierRequest{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractCarrierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCarrierRequest) ProtoMessage() {}
+
+func (x *ContractCarrierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCarrierRequest.ProtoReflect.Descriptor instead.
+func (*ContractCarrierRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ContractCarrierRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ContractCarrierRequest) GetCarrierId() string {
+	if x != nil {
+		return x.CarrierId
+	}
+	return ""
+}
+
+func (x *ContractCarrierRequest) GetQuoteId() string {
+	if x != nil && x.QuoteId != nil {
+		return *x.QuoteId
+	}
+	return ""
+}
+
+type Contract struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId                 string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CarrierId               string                 `protobuf:"bytes,3,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	Price                   string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	EstimatedDays           int32                  `protobuf:"varint,5,opt,name=estimated_days,json=estimatedDays,proto3" json:"estimated_days,omitempty"`
+	Status                  string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	QuoteId                 *string                `protobuf:"bytes,7,opt,name=quote_id,json=quoteId,proto3,oneof" json:"quote_id,omitempty"`
+	TrackingCode            *string                `protobuf:"bytes,8,opt,name=tracking_code,json=trackingCode,proto3,oneof" json:"tracking_code,omitempty"`
+	LoadId                  *string                `protobuf:"bytes,9,opt,name=load_id,json=loadId,proto3,oneof" json:"load_id,omitempty"`
+	ContractedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=contracted_at,json=contractedAt,proto3" json:"contracted_at,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FuelSurchargePercentage string                 `protobuf:"bytes,13,opt,name=fuel_surcharge_percentage,json=fuelSurchargePercentage,proto3" json:"fuel_surcharge_percentage,omitempty"`
+	FuelSurcharge           string                 `protobuf:"bytes,14,opt,name=fuel_surcharge,json=fuelSurcharge,proto3" json:"fuel_surcharge,omitempty"`
+	AdValorem               string                 `protobuf:"bytes,15,opt,name=ad_valorem,json=adValorem,proto3" json:"ad_valorem,omitempty"`
+	Gris                    string                 `protobuf:"bytes,16,opt,name=gris,proto3" json:"gris,omitempty"`
+	Toll                    string                 `protobuf:"bytes,17,opt,name=toll,proto3" json:"toll,omitempty"`
+	Tde                     string                 `protobuf:"bytes,18,opt,name=tde,proto3" json:"tde,omitempty"`
+	Discount                string                 `protobuf:"bytes,19,opt,name=discount,proto3" json:"discount,omitempty"`
+	IcmsRate                string                 `protobuf:"bytes,20,opt,name=icms_rate,json=icmsRate,proto3" json:"icms_rate,omitempty"`
+	Icms                    string                 `protobuf:"bytes,21,opt,name=icms,proto3" json:"icms,omitempty"`
+	TotalPrice              string                 `protobuf:"bytes,22,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Breakdown               []*PriceLine           `protobuf:"bytes,23,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	Explanation             *PriceExplanation      `protobuf:"bytes,24,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Contract) Reset() {
+	*x = Contract{}
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contract) ProtoMessage() {}
+
+func (x *Contract) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_coordinator_v1_coordinator_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contract.ProtoReflect.Descriptor instead.
+func (*Contract) Descriptor() ([]byte, []int) {
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Contract) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contract) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Contract) GetCarrierId() string {
+	if x != nil {
+		return x.CarrierId
+	}
+	return ""
+}
+
+func (x *Contract) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Contract) GetEstimatedDays() int32 {
+	if x != nil {
+		return x.EstimatedDays
+	}
+	return 0
+}
+
+func (x *Contract) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Contract) GetQuoteId() string {
+	if x != nil && x.QuoteId != nil {
+		return *x.QuoteId
+	}
+	return ""
+}
+
+func (x *Contract) GetTrackingCode() string {
+	if x != nil && x.TrackingCode != nil {
+		return *x.TrackingCode
+	}
+	return ""
+}
+
+func (x *Contract) GetLoadId() string {
+	if x != nil && x.LoadId != nil {
+		return *x.LoadId
+	}
+	return ""
+}
+
+func (x *Contract) GetContractedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ContractedAt
+	}
+	return nil
+}
+
+func (x *Contract) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Contract) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Contract) GetFuelSurchargePercentage() string {
+	if x != nil {
+		return x.FuelSurchargePercentage
+	}
+	return ""
+}
+
+func (x *Contract) GetFuelSurcharge() string {
+	if x != nil {
+		return x.FuelSurcharge
+	}
+	return ""
+}
+
+func (x *Contract) GetAdValorem() string {
+	if x != nil {
+		return x.AdValorem
+	}
+	return ""
+}
+
+func (x *Contract) GetGris() string {
+	if x != nil {
+		return x.Gris
+	}
+	return ""
+}
+
+func (x *Contract) GetToll() string {
+	if x != nil {
+		return x.Toll
+	}
+	return ""
+}
+
+func (x *Contract) GetTde() string {
+	if x != nil {
+		return x.Tde
+	}
+	return ""
+}
+
+func (x *Contract) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
+func (x *Contract) GetIcmsRate() string {
+	if x != nil {
+		return x.IcmsRate
+	}
+	return ""
+}
+
+func (x *Contract) GetIcms() string {
+	if x != nil {
+		return x.Icms
+	}
+	return ""
+}
+
+func (x *Contract) GetTotalPrice() string {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return ""
+}
+
+func (x *Contract) GetBreakdown() []*PriceLine {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *Contract) GetExplanation() *PriceExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+var File_api_proto_coordinator_v1_coordinator_proto protoreflect.FileDescriptor
+
+const file_api_proto_coordinator_v1_coordinator_proto_rawDesc = "" +
+	"\n" +
+	"*api/proto/coordinator/v1/coordinator.proto\x12\x0ecoordinator.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd5\x01\n" +
+	"\x12CreateOrderRequest\x12\x18\n" +
+	"\aproduct\x18\x01 \x01(\tR\aproduct\x12\x1b\n" +
+	"\tweight_kg\x18\x02 \x01(\x01R\bweightKg\x12%\n" +
+	"\x0edestination_uf\x18\x03 \x01(\tR\rdestinationUf\x12%\n" +
+	"\x0edeclared_value\x18\x04 \x01(\x01R\rdeclaredValue\x12(\n" +
+	"\rauto_contract\x18\x05 \x01(\bH\x00R\fautoContract\x88\x01\x01B\x10\n" +
+	"\x0e_auto_contract\"!\n" +
+	"\x0fGetOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe0\x02\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aproduct\x18\x02 \x01(\tR\aproduct\x12\x1b\n" +
+	"\tweight_kg\x18\x03 \x01(\tR\bweightKg\x12%\n" +
+	"\x0edestination_uf\x18\x04 \x01(\tR\rdestinationUf\x12%\n" +
+	"\x0edeclared_value\x18\x05 \x01(\tR\rdeclaredValue\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12$\n" +
+	"\vcontract_id\x18\a \x01(\tH\x00R\n" +
+	"contractId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_contract_id\"B\n" +
+	"\x18UpdateOrderStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x1b\n" +
+	"\x19UpdateOrderStatusResponse\"j\n" +
+	"\x14CreateCarrierRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\bpolicies\x18\x02 \x03(\v2\".coordinator.v1.CarrierPolicyInputR\bpolicies\"\xe1\x02\n" +
+	"\x12CarrierPolicyInput\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12%\n" +
+	"\x0eestimated_days\x18\x02 \x01(\x05R\restimatedDays\x12 \n" +
+	"\fprice_per_kg\x18\x03 \x01(\x01R\n" +
+	"pricePerKg\x122\n" +
+	"\x15ad_valorem_percentage\x18\x04 \x01(\x01R\x13adValoremPercentage\x12'\n" +
+	"\x0fgris_percentage\x18\x05 \x01(\x01R\x0egrisPercentage\x12!\n" +
+	"\fgris_minimum\x18\x06 \x01(\x01R\vgrisMinimum\x12*\n" +
+	"\x11toll_per_fraction\x18\a \x01(\x01R\x0ftollPerFraction\x12%\n" +
+	"\x0etde_percentage\x18\b \x01(\x01R\rtdePercentage\x12\x17\n" +
+	"\atde_ufs\x18\t \x03(\tR\x06tdeUfs\"\xde\x01\n" +
+	"\aCarrier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\bpolicies\x18\x03 \x03(\v2\x1d.coordinator.v1.CarrierPolicyR\bpolicies\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xdc\x02\n" +
+	"\rCarrierPolicy\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12%\n" +
+	"\x0eestimated_days\x18\x02 \x01(\x05R\restimatedDays\x12 \n" +
+	"\fprice_per_kg\x18\x03 \x01(\tR\n" +
+	"pricePerKg\x122\n" +
+	"\x15ad_valorem_percentage\x18\x04 \x01(\tR\x13adValoremPercentage\x12'\n" +
+	"\x0fgris_percentage\x18\x05 \x01(\tR\x0egrisPercentage\x12!\n" +
+	"\fgris_minimum\x18\x06 \x01(\tR\vgrisMinimum\x12*\n" +
+	"\x11toll_per_fraction\x18\a \x01(\tR\x0ftollPerFraction\x12%\n" +
+	"\x0etde_percentage\x18\b \x01(\tR\rtdePercentage\x12\x17\n" +
+	"\atde_ufs\x18\t \x03(\tR\x06tdeUfs\"A\n" +
+	"\x10GetQuotesRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\tR\x04sort\"B\n" +
+	"\x11GetQuotesResponse\x12-\n" +
+	"\x06quotes\x18\x01 \x03(\v2\x15.coordinator.v1.QuoteR\x06quotes\"\x98\x06\n" +
+	"\x05Quote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"carrier_id\x18\x02 \x01(\tR\tcarrierId\x12!\n" +
+	"\fcarrier_name\x18\x03 \x01(\tR\vcarrierName\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\x04 \x01(\tR\tbasePrice\x12:\n" +
+	"\x19fuel_surcharge_percentage\x18\x05 \x01(\tR\x17fuelSurchargePercentage\x12%\n" +
+	"\x0efuel_surcharge\x18\x06 \x01(\tR\rfuelSurcharge\x12\x1d\n" +
+	"\n" +
+	"ad_valorem\x18\a \x01(\tR\tadValorem\x12\x12\n" +
+	"\x04gris\x18\b \x01(\tR\x04gris\x12\x12\n" +
+	"\x04toll\x18\t \x01(\tR\x04toll\x12\x10\n" +
+	"\x03tde\x18\n" +
+	" \x01(\tR\x03tde\x12\x1a\n" +
+	"\bdiscount\x18\v \x01(\tR\bdiscount\x12\x1b\n" +
+	"\ticms_rate\x18\f \x01(\tR\bicmsRate\x12\x12\n" +
+	"\x04icms\x18\r \x01(\tR\x04icms\x12\x1f\n" +
+	"\vtotal_price\x18\x0e \x01(\tR\n" +
+	"totalPrice\x12\x14\n" +
+	"\x05price\x18\x0f \x01(\tR\x05price\x12%\n" +
+	"\x0eestimated_days\x18\x10 \x01(\x05R\restimatedDays\x12 \n" +
+	"\vreliability\x18\x11 \x01(\tR\vreliability\x12\x14\n" +
+	"\x05score\x18\x12 \x01(\tR\x05score\x12 \n" +
+	"\vrecommended\x18\x13 \x01(\bR\vrecommended\x12%\n" +
+	"\x0epolicy_version\x18\x14 \x01(\x05R\rpolicyVersion\x129\n" +
+	"\n" +
+	"expires_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x127\n" +
+	"\tbreakdown\x18\x16 \x03(\v2\x19.coordinator.v1.PriceLineR\tbreakdown\x12B\n" +
+	"\vexplanation\x18\x17 \x01(\v2 .coordinator.v1.PriceExplanationR\vexplanation\"Y\n" +
+	"\tPriceLine\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\xf8\x02\n" +
+	"\x10PriceExplanation\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12 \n" +
+	"\tpolicy_id\x18\x02 \x01(\tH\x00R\bpolicyId\x88\x01\x01\x12%\n" +
+	"\x0epolicy_version\x18\x03 \x01(\x05R\rpolicyVersion\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1b\n" +
+	"\torigin_uf\x18\x05 \x01(\tR\boriginUf\x12%\n" +
+	"\x0edestination_uf\x18\x06 \x01(\tR\rdestinationUf\x120\n" +
+	"\x14chargeable_weight_kg\x18\a \x01(\tR\x12chargeableWeightKg\x12\x1e\n" +
+	"\vrate_per_kg\x18\b \x01(\tR\tratePerKg\x12+\n" +
+	"\x11carrier_reference\x18\t \x01(\tR\x10carrierReference\x12\x1a\n" +
+	"\brounding\x18\n" +
+	" \x01(\tR\broundingB\f\n" +
+	"\n" +
+	"_policy_id\"\x7f\n" +
+	"\x16ContractCarrierRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"carrier_id\x18\x02 \x01(\tR\tcarrierId\x12\x1e\n" +
+	"\bquote_id\x18\x03 \x01(\tH\x00R\aquoteId\x88\x01\x01B\v\n" +
+	"\t_quote_id\"\x9a\a\n" +
+	"\bContract\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"carrier_id\x18\x03 \x01(\tR\tcarrierId\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12%\n" +
+	"\x0eestimated_days\x18\x05 \x01(\x05R\restimatedDays\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1e\n" +
+	"\bquote_id\x18\a \x01(\tH\x00R\aquoteId\x88\x01\x01\x12(\n" +
+	"\rtracking_code\x18\b \x01(\tH\x01R\ftrackingCode\x88\x01\x01\x12\x1c\n" +
+	"\aload_id\x18\t \x01(\tH\x02R\x06loadId\x88\x01\x01\x12?\n" +
+	"\rcontracted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fcontractedAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\x19fuel_surcharge_percentage\x18\r \x01(\tR\x17fuelSurchargePercentage\x12%\n" +
+	"\x0efuel_surcharge\x18\x0e \x01(\tR\rfuelSurcharge\x12\x1d\n" +
+	"\n" +
+	"ad_valorem\x18\x0f \x01(\tR\tadValorem\x12\x12\n" +
+	"\x04gris\x18\x10 \x01(\tR\x04gris\x12\x12\n" +
+	"\x04toll\x18\x11 \x01(\tR\x04toll\x12\x10\n" +
+	"\x03tde\x18\x12 \x01(\tR\x03tde\x12\x1a\n" +
+	"\bdiscount\x18\x13 \x01(\tR\bdiscount\x12\x1b\n" +
+	"\ticms_rate\x18\x14 \x01(\tR\bicmsRate\x12\x12\n" +
+	"\x04icms\x18\x15 \x01(\tR\x04icms\x12\x1f\n" +
+	"\vtotal_price\x18\x16 \x01(\tR\n" +
+	"totalPrice\x127\n" +
+	"\tbreakdown\x18\x17 \x03(\v2\x19.coordinator.v1.PriceLineR\tbreakdown\x12B\n" +
+	"\vexplanation\x18\x18 \x01(\v2 .coordinator.v1.PriceExplanationR\vexplanationB\v\n" +
+	"\t_quote_idB\x10\n" +
+	"\x0e_tracking_codeB\n" +
+	"\n" +
+	"\b_load_id2\x84\x04\n" +
+	"\x13ShipmentCoordinator\x12H\n" +
+	"\vCreateOrder\x12\".coordinator.v1.CreateOrderRequest\x1a\x15.coordinator.v1.Order\x12B\n" +
+	"\bGetOrder\x12\x1f.coordinator.v1.GetOrderRequest\x1a\x15.coordinator.v1.Order\x12h\n" +
+	"\x11UpdateOrderStatus\x12(.coordinator.v1.UpdateOrderStatusRequest\x1a).coordinator.v1.UpdateOrderStatusResponse\x12N\n" +
+	"\rCreateCarrier\x12$.coordinator.v1.CreateCarrierRequest\x1a\x17.coordinator.v1.Carrier\x12P\n" +
+	"\tGetQuotes\x12 .coordinator.v1.GetQuotesRequest\x1a!.coordinator.v1.GetQuotesResponse\x12S\n" +
+	"\x0fContractCarrier\x12&.coordinator.v1.ContractCarrierRequest\x1a\x18.coordinator.v1.ContractBTZRgithub.com/victorvcruz/shipment-coordinator/api/proto/coordinator/v1;coordinatorv1b\x06proto3"
+
+var (
+	file_api_proto_coordinator_v1_coordinator_proto_rawDescOnce sync.Once
+	file_api_proto_coordinator_v1_coordinator_proto_rawDescData []byte
+)
+
+func file_api_proto_coordinator_v1_coordinator_proto_rawDescGZIP() []byte {
+	file_api_proto_coordinator_v1_coordinator_proto_rawDescOnce.Do(func() {
+		file_api_proto_coordinator_v1_coordinator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_coordinator_v1_coordinator_proto_rawDesc), len(file_api_proto_coordinator_v1_coordinator_proto_rawDesc)))
+	})
+	return file_api_proto_coordinator_v1_coordinator_proto_rawDescData
+}
+
+var file_api_proto_coordinator_v1_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_proto_coordinator_v1_coordinator_proto_goTypes = []any{
+	(*CreateOrderRequest)(nil),        // 0: coordinator.v1.CreateOrderRequest
+	(*GetOrderRequest)(nil),           // 1: coordinator.v1.GetOrderRequest
+	(*Order)(nil),                     // 2: coordinator.v1.Order
+	(*UpdateOrderStatusRequest)(nil),  // 3: coordinator.v1.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil), // 4: coordinator.v1.UpdateOrderStatusResponse
+	(*CreateCarrierRequest)(nil),      // 5: coordinator.v1.CreateCarrierRequest
+	(*CarrierPolicyInput)(nil),        // 6: coordinator.v1.CarrierPolicyInput
+	(*Carrier)(nil),                   // 7: coordinator.v1.Carrier
+	(*CarrierPolicy)(nil),             // 8: coordinator.v1.CarrierPolicy
+	(*GetQuotesRequest)(nil),          // 9: coordinator.v1.GetQuotesRequest
+	(*GetQuotesResponse)(nil),         // 10: coordinator.v1.GetQuotesResponse
+	(*Quote)(nil),                     // 11: coordinator.v1.Quote
+	(*PriceLine)(nil),                 // 12: coordinator.v1.PriceLine
+	(*PriceExplanation)(nil),          // 13: coordinator.v1.PriceExplanation
+	(*ContractCarrierRequest)(nil),    // 14: coordinator.v1.ContractCarrierRequest
+	(*Contract)(nil),                  // 15: coordinator.v1.Contract
+	(*timestamppb.Timestamp)(nil),     // 16: google.protobuf.Timestamp
+}
+var file_api_proto_coordinator_v1_coordinator_proto_depIdxs = []int32{
+	16, // 0: coordinator.v1.Order.created_at:type_name -> google.protobuf.Timestamp
+	16, // 1: coordinator.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: coordinator.v1.CreateCarrierRequest.policies:type_name -> coordinator.v1.CarrierPolicyInput
+	8,  // 3: coordinator.v1.Carrier.policies:type_name -> coordinator.v1.CarrierPolicy
+	16, // 4: coordinator.v1.Carrier.created_at:type_name -> google.protobuf.Timestamp
+	16, // 5: coordinator.v1.Carrier.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 6: coordinator.v1.GetQuotesResponse.quotes:type_name -> coordinator.v1.Quote
+	16, // 7: coordinator.v1.Quote.expires_at:type_name -> google.protobuf.Timestamp
+	12, // 8: coordinator.v1.Quote.breakdown:type_name -> coordinator.v1.PriceLine
+	13, // 9: coordinator.v1.Quote.explanation:type_name -> coordinator.v1.PriceExplanation
+	16, // 10: coordinator.v1.Contract.contracted_at:type_name -> google.protobuf.Timestamp
+	16, // 11: coordinator.v1.Contract.created_at:type_name -> google.protobuf.Timestamp
+	16, // 12: coordinator.v1.Contract.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 13: coordinator.v1.Contract.breakdown:type_name -> coordinator.v1.PriceLine
+	13, // 14: coordinator.v1.Contract.explanation:type_name -> coordinator.v1.PriceExplanation
+	0,  // 15: coordinator.v1.ShipmentCoordinator.CreateOrder:input_type -> coordinator.v1.CreateOrderRequest
+	1,  // 16: coordinator.v1.ShipmentCoordinator.GetOrder:input_type -> coordinator.v1.GetOrderRequest
+	3,  // 17: coordinator.v1.ShipmentCoordinator.UpdateOrderStatus:input_type -> coordinator.v1.UpdateOrderStatusRequest
+	5,  // 18: coordinator.v1.ShipmentCoordinator.CreateCarrier:input_type -> coordinator.v1.CreateCarrierRequest
+	9,  // 19: coordinator.v1.ShipmentCoordinator.GetQuotes:input_type -> coordinator.v1.GetQuotesRequest
+	14, // 20: coordinator.v1.ShipmentCoordinator.ContractCarrier:input_type -> coordinator.v1.ContractCarrierRequest
+	2,  // 21: coordinator.v1.ShipmentCoordinator.CreateOrder:output_type -> coordinator.v1.Order
+	2,  // 22: coordinator.v1.ShipmentCoordinator.GetOrder:output_type -> coordinator.v1.Order
+	4,  // 23: coordinator.v1.ShipmentCoordinator.UpdateOrderStatus:output_type -> coordinator.v1.UpdateOrderStatusResponse
+	7,  // 24: coordinator.v1.ShipmentCoordinator.CreateCarrier:output_type -> coordinator.v1.Carrier
+	10, // 25: coordinator.v1.ShipmentCoordinator.GetQuotes:output_type -> coordinator.v1.GetQuotesResponse
+	15, // 26: coordinator.v1.ShipmentCoordinator.ContractCarrier:output_type -> coordinator.v1.Contract
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_coordinator_v1_coordinator_proto_init() }
+func file_api_proto_coordinator_v1_coordinator_proto_init() {
+	if File_api_proto_coordinator_v1_coordinator_proto != nil {
+		return
+	}
+	file_api_proto_coordinator_v1_coordinator_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_proto_coordinator_v1_coordinator_proto_msgTypes[2].OneofWrappers = []any{}
+	file_api_proto_coordinator_v1_coordinator_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_proto_coordinator_v1_coordinator_proto_msgTypes[14].OneofWrappers = []any{}
+	file_api_proto_coordinator_v1_coordinator_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_coordinator_v1_coordinator_proto_rawDesc), len(file_api_proto_coordinator_v1_coordinator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_coordinator_v1_coordinator_proto_goTypes,
+		DependencyIndexes: file_api_proto_coordinator_v1_coordinator_proto_depIdxs,
+		MessageInfos:      file_api_proto_coordinator_v1_coordinator_proto_msgTypes,
+	}.Build()
+	File_api_proto_coordinator_v1_coordinator_proto = out.File
+	file_api_proto_coordinator_v1_coordinator_proto_goTypes = nil
+	file_api_proto_coordinator_v1_coordinator_proto_depIdxs = nil
+}
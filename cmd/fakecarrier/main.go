@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway/fakecarrier"
+)
+
+func main() {
+	addr := flag.String("addr", ":8081", "address to listen on")
+	apiKey := flag.String("api-key", "", "API key expected as a bearer token, empty disables auth")
+	basePrice := flag.Float64("base-price", 5, "fixed price of every rate in BRL")
+	pricePerKg := flag.Float64("price-per-kg", 2.5, "price per kg in BRL")
+	days := flag.Int("days", 3, "estimated delivery days")
+	ufs := flag.String("ufs", "", "comma separated UFs served, empty serves every UF")
+	latency := flag.Duration("latency", 0, "delay added to every response")
+	failureRate := flag.Float64("failure-rate", 0, "share of requests answered with 503, from 0 to 1")
+	flag.Parse()
+
+	var served []string
+	if *ufs != "" {
+		served = strings.Split(*ufs, ",")
+	}
+
+	handler := fakecarrier.NewHandler(fakecarrier.Config{
+		APIKey:        *apiKey,
+		BasePrice:     decimal.NewFromFloat(*basePrice),
+		PricePerKg:    decimal.NewFromFloat(*pricePerKg),
+		EstimatedDays: *days,
+		ServedUFs:     served,
+		Latency:       *latency,
+		FailureRate:   *failureRate,
+	})
+
+	log.Debug("starting fake carrier ", "addr ", *addr)
+	if err := http.ListenAndServe(*addr, handler); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal("failed to start fake carrier ", err)
+	}
+}
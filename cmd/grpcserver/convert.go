@@ -0,0 +1,156 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	coordinatorv1 "github.com/victorvcruz/shipment-coordinator/api/proto/coordinator/v1"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	log "go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{order.ErrOrderNotFound, codes.NotFound},
+	{carrier.ErrCarrierNotFound, codes.NotFound},
+	{shipping.ErrQuoteNotFound, codes.NotFound},
+	{order.ErrStatusAlreadySet, codes.FailedPrecondition},
+	{order.ErrInvalidStatusTransition, codes.FailedPrecondition},
+	{shipping.ErrQuoteExpired, codes.FailedPrecondition},
+	{shipping.ErrQuoteMismatch, codes.InvalidArgument},
+	{shipping.ErrNoValidPolicy, codes.FailedPrecondition},
+	{shipping.ErrContractAlreadyExists, codes.AlreadyExists},
+	{shipping.ErrContractNotActive, codes.Aborted},
+}
+
+func toStatusError(err error) error {
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return status.Error(e.code, e.err.Error())
+		}
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	log.L().
+		Error("grpc request failed", log.Error(err))
+	return status.Error(codes.Internal, "internal error")
+}
+
+func toOrder(o *order.Order) *coordinatorv1.Order {
+	return &coordinatorv1.Order{
+		Id:            o.ID.String(),
+		Product:       o.Product,
+		WeightKg:      o.WeightKg.StringFixed(2),
+		DestinationUf: o.DestinationUF.Sigla,
+		DeclaredValue: o.DeclaredValue.StringFixed(2),
+		Status:        string(o.Status),
+		CreatedAt:     timestamppb.New(o.CreatedAt),
+		UpdatedAt:     timestamppb.New(o.UpdatedAt),
+	}
+}
+
+func toQuote(q *shipping.Quote) *coordinatorv1.Quote {
+	return &coordinatorv1.Quote{
+		Id:                      q.ID.String(),
+		CarrierId:               q.CarrierID.String(),
+		CarrierName:             q.CarrierName,
+		BasePrice:               q.BasePrice.StringFixed(2),
+		FuelSurchargePercentage: q.FuelSurchargePercentage.StringFixed(2),
+		FuelSurcharge:           q.FuelSurcharge.StringFixed(2),
+		AdValorem:               q.AdValorem.StringFixed(2),
+		Gris:                    q.GRIS.StringFixed(2),
+		Toll:                    q.Toll.StringFixed(2),
+		Tde:                     q.TDE.StringFixed(2),
+		Discount:                q.Discount.StringFixed(2),
+		IcmsRate:                q.ICMSRate.StringFixed(2),
+		Icms:                    q.ICMS.StringFixed(2),
+		TotalPrice:              q.TotalPrice().StringFixed(2),
+		Price:                   q.Price.StringFixed(2),
+		EstimatedDays:           int32(q.EstimatedDays),
+		Reliability:             q.Reliability.StringFixed(4),
+		Score:                   q.Score.StringFixed(4),
+		Recommended:             q.Recommended,
+		PolicyVersion:           int32(q.PolicyVersion),
+		ExpiresAt:               timestamppb.New(q.ExpiresAt),
+		Breakdown:               toPriceLines(q.Breakdown),
+		Explanation:             toPriceExplanation(q.Explanation),
+	}
+}
+
+func toContract(c *shipping.Contract) *coordinatorv1.Contract {
+	return &coordinatorv1.Contract{
+		Id:                      c.ID.String(),
+		OrderId:                 c.OrderID.String(),
+		CarrierId:               c.CarrierID.String(),
+		Price:                   c.Price.StringFixed(2),
+		EstimatedDays:           int32(c.EstimatedDays),
+		Status:                  string(c.Status),
+		QuoteId:                 optionalID(c.QuoteID),
+		TrackingCode:            c.TrackingCode,
+		LoadId:                  optionalID(c.LoadID),
+		ContractedAt:            timestamppb.New(c.ContractedAt),
+		CreatedAt:               timestamppb.New(c.CreatedAt),
+		UpdatedAt:               timestamppb.New(c.UpdatedAt),
+		FuelSurchargePercentage: c.FuelSurchargePercentage.StringFixed(2),
+		FuelSurcharge:           c.FuelSurcharge.StringFixed(2),
+		AdValorem:               c.AdValorem.StringFixed(2),
+		Gris:                    c.GRIS.StringFixed(2),
+		Toll:                    c.Toll.StringFixed(2),
+		Tde:                     c.TDE.StringFixed(2),
+		Discount:                c.Discount.StringFixed(2),
+		IcmsRate:                c.ICMSRate.StringFixed(2),
+		Icms:                    c.ICMS.StringFixed(2),
+		TotalPrice:              c.TotalPrice().StringFixed(2),
+		Breakdown:               toPriceLines(c.Breakdown),
+		Explanation:             toPriceExplanation(c.Explanation),
+	}
+}
+
+func toPriceLines(lines []shipping.PriceLine) []*coordinatorv1.PriceLine {
+	response := make([]*coordinatorv1.PriceLine, len(lines))
+	for i, l := range lines {
+		response[i] = &coordinatorv1.PriceLine{
+			Rule:        l.Rule,
+			Description: l.Description,
+			Amount:      l.Amount.StringFixed(2),
+		}
+	}
+	return response
+}
+
+func toPriceExplanation(e shipping.PriceExplanation) *coordinatorv1.PriceExplanation {
+	return &coordinatorv1.PriceExplanation{
+		Source:             e.Source,
+		PolicyId:           optionalID(e.PolicyID),
+		PolicyVersion:      int32(e.PolicyVersion),
+		Region:             e.Region,
+		OriginUf:           e.OriginUF,
+		DestinationUf:      e.DestinationUF,
+		ChargeableWeightKg: e.ChargeableWeightKg.StringFixed(3),
+		RatePerKg:          e.RatePerKg.StringFixed(2),
+		CarrierReference:   e.CarrierReference,
+		Rounding:           e.Rounding.StringFixed(2),
+	}
+}
+
+func optionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,285 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	coordinatorv1 "github.com/victorvcruz/shipment-coordinator/api/proto/coordinator/v1"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	log "go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Server struct {
+	coordinatorv1.UnimplementedShipmentCoordinatorServer
+	orderService    order.Service
+	carrierService  carrier.Service
+	shippingService shipping.Service
+}
+
+func NewServer(
+	orderService order.Service,
+	carrierService carrier.Service,
+	shippingService shipping.Service,
+) *Server {
+	return &Server{
+		orderService:    orderService,
+		carrierService:  carrierService,
+		shippingService: shippingService,
+	}
+}
+
+func New(server *Server) *grpc.Server {
+	s := grpc.NewServer()
+	coordinatorv1.RegisterShipmentCoordinatorServer(s, server)
+	reflection.Register(s)
+	return s
+}
+
+func (s *Server) CreateOrder(
+	ctx context.Context,
+	request *coordinatorv1.CreateOrderRequest,
+) (*coordinatorv1.Order, error) {
+	now := time.Now().UTC()
+	state, ok := states.States[request.DestinationUf]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid destination UF")
+	}
+
+	if request.DeclaredValue < 0 {
+		return nil, status.Error(codes.InvalidArgument, "declared value must not be negative")
+	}
+
+	createdOrder, err := s.orderService.Create(ctx, &order.Order{
+		Product:       request.Product,
+		WeightKg:      decimal.NewFromFloat(request.WeightKg),
+		DestinationUF: state,
+		DeclaredValue: decimal.NewFromFloat(request.DeclaredValue),
+		Status:        order.StatusCreated,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	log.L().Info("Created order", log.String("order_id", createdOrder.ID.String()))
+
+	var contractID *string
+	contract, err := s.shippingService.AutoContract(ctx, createdOrder.ID, request.AutoContract)
+	if err != nil {
+		log.L().
+			Warn("failed to auto contract order", log.String("order_id", createdOrder.ID.String()), log.Error(err))
+	}
+	if contract != nil {
+		id := contract.ID.String()
+		contractID = &id
+		createdOrder.Status = order.StatusAwaitingPickup
+		createdOrder.UpdatedAt = contract.UpdatedAt
+	}
+
+	response := toOrder(createdOrder)
+	response.ContractId = contractID
+	return response, nil
+}
+
+func (s *Server) GetOrder(
+	ctx context.Context,
+	request *coordinatorv1.GetOrderRequest,
+) (*coordinatorv1.Order, error) {
+	id, err := parseID(request.Id, "invalid order ID")
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.orderService.GetByID(ctx, id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toOrder(found), nil
+}
+
+func (s *Server) UpdateOrderStatus(
+	ctx context.Context,
+	request *coordinatorv1.UpdateOrderStatusRequest,
+) (*coordinatorv1.UpdateOrderStatusResponse, error) {
+	id, err := parseID(request.Id, "invalid order ID")
+	if err != nil {
+		return nil, err
+	}
+
+	orderStatus, ok := order.StatusValues[request.Status]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	if err := s.orderService.UpdateStatus(ctx, id, orderStatus); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	log.L().
+		Info("Updated order status", log.String("order_id", id.String()), log.String("status", request.Status))
+	return &coordinatorv1.UpdateOrderStatusResponse{}, nil
+}
+
+func (s *Server) CreateCarrier(
+	ctx context.Context,
+	request *coordinatorv1.CreateCarrierRequest,
+) (*coordinatorv1.Carrier, error) {
+	now := time.Now().UTC()
+
+	policies := make([]carrier.Policy, len(request.Policies))
+	for i, p := range request.Policies {
+		region, ok := states.Regions[p.Region]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid region")
+		}
+
+		if p.AdValoremPercentage < 0 || p.GrisPercentage < 0 || p.GrisMinimum < 0 ||
+			p.TollPerFraction < 0 || p.TdePercentage < 0 {
+			return nil, status.Error(codes.InvalidArgument, "freight components must not be negative")
+		}
+
+		tdeUFs := make([]string, len(p.TdeUfs))
+		for j, uf := range p.TdeUfs {
+			state, ok := states.States[strings.ToUpper(uf)]
+			if !ok || state.Region != region.Name {
+				return nil, status.Error(codes.InvalidArgument, "invalid TDE UF for region "+region.Name)
+			}
+			tdeUFs[j] = state.Sigla
+		}
+
+		policies[i] = carrier.Policy{
+			Region:              region,
+			EstimatedDays:       int(p.EstimatedDays),
+			PricePerKg:          decimal.NewFromFloat(p.PricePerKg),
+			AdValoremPercentage: decimal.NewFromFloat(p.AdValoremPercentage),
+			GRISPercentage:      decimal.NewFromFloat(p.GrisPercentage),
+			GRISMinimum:         decimal.NewFromFloat(p.GrisMinimum),
+			TollPerFraction:     decimal.NewFromFloat(p.TollPerFraction),
+			TDEPercentage:       decimal.NewFromFloat(p.TdePercentage),
+			TDEUFs:              tdeUFs,
+			CreatedAt:           now,
+			UpdatedAt:           now,
+		}
+	}
+
+	createdCarrier, err := s.carrierService.Create(ctx, &carrier.Carrier{
+		Name:      request.Name,
+		Policies:  policies,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	policiesResponse := make([]*coordinatorv1.CarrierPolicy, len(createdCarrier.Policies))
+	for i, p := range createdCarrier.Policies {
+		policiesResponse[i] = &coordinatorv1.CarrierPolicy{
+			Region:              p.Region.Name,
+			EstimatedDays:       int32(p.EstimatedDays),
+			PricePerKg:          p.PricePerKg.StringFixed(2),
+			AdValoremPercentage: p.AdValoremPercentage.StringFixed(2),
+			GrisPercentage:      p.GRISPercentage.StringFixed(2),
+			GrisMinimum:         p.GRISMinimum.StringFixed(2),
+			TollPerFraction:     p.TollPerFraction.StringFixed(2),
+			TdePercentage:       p.TDEPercentage.StringFixed(2),
+			TdeUfs:              p.TDEUFs,
+		}
+	}
+
+	log.L().Info("Created carrier", log.String("carrier_id", createdCarrier.ID.String()))
+	return &coordinatorv1.Carrier{
+		Id:        createdCarrier.ID.String(),
+		Name:      createdCarrier.Name,
+		Policies:  policiesResponse,
+		CreatedAt: timestamppb.New(now),
+		UpdatedAt: timestamppb.New(now),
+	}, nil
+}
+
+func (s *Server) GetQuotes(
+	ctx context.Context,
+	request *coordinatorv1.GetQuotesRequest,
+) (*coordinatorv1.GetQuotesResponse, error) {
+	id, err := parseID(request.OrderId, "invalid order ID")
+	if err != nil {
+		return nil, err
+	}
+
+	var strategy shipping.RankingStrategy
+	if request.Sort != "" {
+		var ok bool
+		strategy, ok = shipping.RankingStrategies[request.Sort]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid sort strategy")
+		}
+	}
+
+	quotes, err := s.shippingService.QuoteAll(ctx, id, strategy)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &coordinatorv1.GetQuotesResponse{Quotes: make([]*coordinatorv1.Quote, len(quotes))}
+	for i, q := range quotes {
+		response.Quotes[i] = toQuote(q)
+	}
+	return response, nil
+}
+
+func (s *Server) ContractCarrier(
+	ctx context.Context,
+	request *coordinatorv1.ContractCarrierRequest,
+) (*coordinatorv1.Contract, error) {
+	orderID, err := parseID(request.OrderId, "invalid order ID")
+	if err != nil {
+		return nil, err
+	}
+
+	carrierID, err := parseID(request.CarrierId, "invalid carrier ID")
+	if err != nil {
+		return nil, err
+	}
+
+	var quoteID *uuid.UUID
+	if request.QuoteId != nil {
+		id, err := parseID(*request.QuoteId, "invalid quote ID")
+		if err != nil {
+			return nil, err
+		}
+		quoteID = &id
+	}
+
+	contract, err := s.shippingService.ContractCarrier(ctx, orderID, carrierID, quoteID, false)
+	if err != nil {
+		if errors.Is(err, order.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, "invalid order status for contracting")
+		}
+		return nil, toStatusError(err)
+	}
+
+	log.L().Info("Carrier contracted", log.String("contract_id", contract.ID.String()))
+	return toContract(contract), nil
+}
+
+func parseID(raw, message string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, message)
+	}
+	return id, nil
+}
@@ -0,0 +1,197 @@
+package grpcserver_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	coordinatorv1 "github.com/victorvcruz/shipment-coordinator/api/proto/coordinator/v1"
+	"github.com/victorvcruz/shipment-coordinator/cmd/grpcserver"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	shippingmock "github.com/victorvcruz/shipment-coordinator/internal/shipping/mocks"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func dial(t *testing.T, server *grpcserver.Server) coordinatorv1.ShipmentCoordinatorClient {
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpcserver.New(server)
+	go s.Serve(listener) //nolint:errcheck
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	return coordinatorv1.NewShipmentCoordinatorClient(conn)
+}
+
+func TestServer_CreateOrder_AutoContract(t *testing.T) {
+	orderID, contractID := uuid.New(), uuid.New()
+	orderSvc := &ordermock.ServiceMock{
+		CreateFunc: func(ctx context.Context, o *order.Order) (*order.Order, error) {
+			o.ID = orderID
+			return o, nil
+		},
+	}
+	shippingSvc := &shippingmock.ServiceMock{
+		AutoContractFunc: func(ctx context.Context, id uuid.UUID, requested *bool) (*shipping.Contract, error) {
+			assert.True(t, *requested)
+			return &shipping.Contract{ID: contractID, UpdatedAt: time.Now()}, nil
+		},
+	}
+	client := dial(t, grpcserver.NewServer(orderSvc, nil, shippingSvc))
+
+	autoContract := true
+	resp, err := client.CreateOrder(context.Background(), &coordinatorv1.CreateOrderRequest{
+		Product:       "Notebook",
+		WeightKg:      2.5,
+		DestinationUf: "SP",
+		DeclaredValue: 1500,
+		AutoContract:  &autoContract,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, orderID.String(), resp.Id)
+	assert.Equal(t, "2.50", resp.WeightKg)
+	assert.Equal(t, string(order.StatusAwaitingPickup), resp.Status)
+	assert.Equal(t, contractID.String(), resp.GetContractId())
+}
+
+func TestServer_CreateOrder_InvalidUF(t *testing.T) {
+	client := dial(t, grpcserver.NewServer(&ordermock.ServiceMock{}, nil, nil))
+
+	_, err := client.CreateOrder(context.Background(), &coordinatorv1.CreateOrderRequest{DestinationUf: "XX"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_UpdateOrderStatus_MapsDomainErrors(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{order.ErrOrderNotFound, codes.NotFound},
+		{order.ErrInvalidStatusTransition, codes.FailedPrecondition},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{assert.AnError, codes.Internal},
+	}
+
+	for _, c := range cases {
+		orderSvc := &ordermock.ServiceMock{
+			UpdateStatusFunc: func(ctx context.Context, id uuid.UUID, status order.Status) error {
+				return c.err
+			},
+		}
+		client := dial(t, grpcserver.NewServer(orderSvc, nil, nil))
+
+		_, err := client.UpdateOrderStatus(context.Background(), &coordinatorv1.UpdateOrderStatusRequest{
+			Id:     uuid.NewString(),
+			Status: "delivered",
+		})
+		assert.Equal(t, c.code, status.Code(err), c.err.Error())
+	}
+}
+
+func TestServer_CreateCarrier_InvalidRegion(t *testing.T) {
+	client := dial(t, grpcserver.NewServer(nil, nil, nil))
+
+	_, err := client.CreateCarrier(context.Background(), &coordinatorv1.CreateCarrierRequest{
+		Name:     "Fast Delivery",
+		Policies: []*coordinatorv1.CarrierPolicyInput{{Region: "Leste", EstimatedDays: 3, PricePerKg: 10}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetQuotes_Success(t *testing.T) {
+	orderID, carrierID := uuid.New(), uuid.New()
+	shippingSvc := &shippingmock.ServiceMock{
+		QuoteAllFunc: func(ctx context.Context, id uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.Quote, error) {
+			assert.Equal(t, orderID, id)
+			return []*shipping.Quote{{
+				ID:            uuid.New(),
+				CarrierID:     carrierID,
+				CarrierName:   "Fast Delivery",
+				Price:         decimal.NewFromFloat(42.5),
+				EstimatedDays: 3,
+				Recommended:   true,
+				Breakdown:     []shipping.PriceLine{{Rule: "base", Amount: decimal.NewFromFloat(42.5)}},
+				Explanation:   shipping.PriceExplanation{Source: shipping.PriceSourcePolicy, Region: states.Sudeste.Name},
+			}}, nil
+		},
+	}
+	client := dial(t, grpcserver.NewServer(nil, nil, shippingSvc))
+
+	resp, err := client.GetQuotes(context.Background(), &coordinatorv1.GetQuotesRequest{OrderId: orderID.String()})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Quotes, 1)
+	assert.Equal(t, carrierID.String(), resp.Quotes[0].CarrierId)
+	assert.Equal(t, "42.50", resp.Quotes[0].Price)
+	assert.Len(t, resp.Quotes[0].Breakdown, 1)
+	assert.Equal(t, "Sudeste", resp.Quotes[0].Explanation.Region)
+	assert.Nil(t, resp.Quotes[0].Explanation.PolicyId)
+}
+
+func TestServer_ContractCarrier_QuoteExpired(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
+			assert.NotNil(t, quoteID)
+			return nil, shipping.ErrQuoteExpired
+		},
+	}
+	client := dial(t, grpcserver.NewServer(nil, nil, shippingSvc))
+
+	quoteID := uuid.NewString()
+	_, err := client.ContractCarrier(context.Background(), &coordinatorv1.ContractCarrierRequest{
+		OrderId:   uuid.NewString(),
+		CarrierId: uuid.NewString(),
+		QuoteId:   &quoteID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "quote expired", status.Convert(err).Message())
+}
+
+func TestServer_ContractCarrier_CarrierNotFound(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
+			return nil, carrier.ErrCarrierNotFound
+		},
+	}
+	client := dial(t, grpcserver.NewServer(nil, nil, shippingSvc))
+
+	_, err := client.ContractCarrier(context.Background(), &coordinatorv1.ContractCarrierRequest{
+		OrderId:   uuid.NewString(),
+		CarrierId: uuid.NewString(),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_ContractCarrier_PreviousContractNoLongerActive(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
+			return nil, shipping.ErrContractNotActive
+		},
+	}
+	client := dial(t, grpcserver.NewServer(nil, nil, shippingSvc))
+
+	_, err := client.ContractCarrier(context.Background(), &coordinatorv1.ContractCarrierRequest{
+		OrderId:   uuid.NewString(),
+		CarrierId: uuid.NewString(),
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
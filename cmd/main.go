@@ -4,15 +4,29 @@ import (
 	"context"
 	"errors"
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/cmd/grpcserver"
 	"github.com/victorvcruz/shipment-coordinator/cmd/server"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+	"github.com/victorvcruz/shipment-coordinator/internal/label"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest"
 	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
 	"github.com/victorvcruz/shipment-coordinator/internal/platform/config"
 	"github.com/victorvcruz/shipment-coordinator/internal/platform/logger"
 	"github.com/victorvcruz/shipment-coordinator/internal/platform/postgres"
 	"github.com/victorvcruz/shipment-coordinator/internal/platform/postgres/migrations"
 	"github.com/victorvcruz/shipment-coordinator/internal/platform/telemetry"
 	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/internal/stream"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	"net"
 	"net/http"
 )
 
@@ -53,19 +67,188 @@ func main() {
 
 	carrierService := carrier.NewService(carrierRepository)
 
-	shippingRepository := shipping.NewRepository(db)
+	shippingRepository := shipping.NewRepository(db, orderRepository)
 
-	shippingService := shipping.NewService(orderRepository, carrierRepository, shippingRepository)
+	gateways := carriergateway.Registry{}
+	for _, g := range cfg.Gateways {
+		carrierID, err := uuid.Parse(g.CarrierID)
+		if err != nil {
+			log.Fatal("invalid carrier gateway carrier ID ", err)
+		}
+		gateways[carrierID] = carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+			BaseURL:          g.BaseURL,
+			APIKey:           g.APIKey,
+			Timeout:          g.Timeout,
+			Retries:          g.Retries,
+			Backoff:          g.Backoff,
+			FailureThreshold: g.FailureThreshold,
+			Cooldown:         g.Cooldown,
+		}, nil)
+	}
+
+	strategy, ok := shipping.RankingStrategies[cfg.Shipping.Ranking.Strategy]
+	if !ok {
+		log.Fatal("unknown shipping ranking strategy ", cfg.Shipping.Ranking.Strategy)
+	}
+
+	origin, ok := states.States[cfg.Shipping.OriginUF]
+	if !ok {
+		log.Fatal("unknown shipping origin UF ", cfg.Shipping.OriginUF)
+	}
+
+	shippingService := shipping.NewService(
+		orderRepository,
+		carrierRepository,
+		shippingRepository,
+		shipping.Config{
+			Strategy: strategy,
+			Weights: shipping.RankingWeights{
+				Price:       cfg.Shipping.Ranking.PriceWeight,
+				Days:        cfg.Shipping.Ranking.DaysWeight,
+				Reliability: cfg.Shipping.Ranking.ReliabilityWeight,
+			},
+			AutoContract:    cfg.Shipping.AutoContract,
+			QuoteTTL:        cfg.Shipping.QuoteTTL,
+			SnapshotTTL:     cfg.Shipping.SnapshotTTL,
+			Origin:          origin,
+			Gateways:        gateways,
+			GatewayDeadline: cfg.Shipping.GatewayDeadline,
+			Booking: shipping.BookingPolicy{
+				MaxAttempts: cfg.Shipping.Booking.MaxAttempts,
+				Backoff:     cfg.Shipping.Booking.Backoff,
+				Lease:       cfg.Shipping.Booking.Lease,
+			},
+		},
+	)
+
+	booker := shipping.NewBooker(shippingService, shipping.BookerConfig{
+		Interval:  cfg.Shipping.Booking.Interval,
+		BatchSize: cfg.Shipping.Booking.BatchSize,
+	})
+
+	trackingRepository := tracking.NewRepository(db, orderRepository)
+
+	trackingService := tracking.NewService(trackingRepository, shippingRepository, orderService)
+
+	webhookRepository := carrierwebhook.NewRepository(db)
+
+	webhookService := carrierwebhook.NewService(
+		webhookRepository,
+		carrierRepository,
+		shippingRepository,
+		trackingService,
+		carrierwebhook.Config{Tolerance: cfg.Webhooks.Tolerance},
+	)
+
+	labelRepository := label.NewRepository(db)
+
+	labelService := label.NewService(labelRepository, shippingRepository, orderService, carrierRepository)
+
+	invoiceRepository := invoice.NewRepository(db)
+
+	invoiceService := invoice.NewService(
+		invoiceRepository,
+		carrierRepository,
+		orderRepository,
+		shippingRepository,
+		invoice.Config{
+			PriceTolerance:    decimal.NewFromFloat(cfg.Invoices.PriceTolerance),
+			WeightToleranceKg: decimal.NewFromFloat(cfg.Invoices.WeightToleranceKg),
+		},
+	)
+
+	manifestRepository := manifest.NewRepository(db, orderRepository)
+
+	manifestService := manifest.NewService(manifestRepository, carrierRepository)
 
-	handler := server.NewHandler(orderService, carrierService, shippingService)
+	subscriptionRepository := subscription.NewRepository(db)
+
+	dispatcher := subscription.NewDispatcher(subscriptionRepository, nil, subscription.DispatcherConfig{
+		Interval:  cfg.Subscriptions.Interval,
+		BatchSize: cfg.Subscriptions.BatchSize,
+		Timeout:   cfg.Subscriptions.Timeout,
+		Lease:     cfg.Subscriptions.Lease,
+		Retry: subscription.RetryPolicy{
+			MaxAttempts: cfg.Subscriptions.MaxAttempts,
+			Backoff:     cfg.Subscriptions.Backoff,
+			MaxBackoff:  cfg.Subscriptions.MaxBackoff,
+		},
+	})
+
+	subscriptionService := subscription.NewService(subscriptionRepository, dispatcher)
+
+	var publisher outbox.Publisher
+	switch cfg.Outbox.Publisher {
+	case "log_file":
+		filePublisher, err := outbox.NewLogFilePublisher(cfg.Outbox.Path)
+		if err != nil {
+			log.Fatal("failed to open outbox log file ", err)
+		}
+		defer filePublisher.Close() //nolint:errcheck
+		publisher = filePublisher
+	default:
+		log.Fatal("unsupported outbox publisher ", cfg.Outbox.Publisher)
+	}
+
+	publishers := outbox.Publishers{publisher, subscription.NewPublisher(subscriptionRepository)}
+
+	relay := outbox.NewRelay(outbox.NewRepository(db), publishers, outbox.RelayConfig{
+		Interval:    cfg.Outbox.Interval,
+		BatchSize:   cfg.Outbox.BatchSize,
+		Lease:       cfg.Outbox.Lease,
+		RetryDelay:  cfg.Outbox.RetryDelay,
+		MaxAttempts: cfg.Outbox.MaxAttempts,
+	})
+
+	hub := stream.NewHub(orderRepository)
+
+	listener := stream.NewListener(db, hub)
+
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	go relay.Run(workersCtx)
+	go dispatcher.Run(workersCtx)
+	go booker.Run(workersCtx)
+	go listener.Run(workersCtx)
+
+	handler := server.NewHandler(
+		orderService,
+		carrierService,
+		shippingService,
+		trackingService,
+		webhookService,
+		labelService,
+		invoiceService,
+		manifestService,
+		subscriptionService,
+	)
 
 	api := server.RouterSetup(cfg, handler)
 
+	mux := http.NewServeMux()
+	mux.Handle(server.OrderStreamPath, server.NewOrderStreamHandler(hub))
+	mux.Handle("/", api.Adapter())
+
 	svr := http.Server{
 		Addr:    ":" + cfg.Server.Port,
-		Handler: api.Adapter(),
+		Handler: mux,
+	}
+
+	grpcListener, err := net.Listen("tcp", ":"+cfg.Server.GRPCPort)
+	if err != nil {
+		log.Fatal("failed to listen on grpc port ", err)
 	}
 
+	grpcServer := grpcserver.New(grpcserver.NewServer(orderService, carrierService, shippingService))
+	defer grpcServer.GracefulStop()
+
+	go func() {
+		log.Debug("starting grpc server ", "port ", cfg.Server.GRPCPort)
+		if serveErr := grpcServer.Serve(grpcListener); serveErr != nil {
+			log.Fatal("failed to start grpc server ", serveErr)
+		}
+	}()
+
 	log.Debug("starting server ", "port ", cfg.Server.Port)
 	if serverErr := svr.ListenAndServe(); serverErr != nil &&
 		!errors.Is(serverErr, http.ErrServerClosed) {
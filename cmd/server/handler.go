@@ -3,34 +3,61 @@ package server
 import (
 	"context"
 	"errors"
+	"mime"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+	"github.com/victorvcruz/shipment-coordinator/internal/label"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest"
 	"github.com/victorvcruz/shipment-coordinator/internal/order"
 	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	"github.com/victorvcruz/shipment-coordinator/pkg/barcode"
 	"github.com/victorvcruz/shipment-coordinator/pkg/states"
 	log "go.uber.org/zap"
 )
 
 type Handler struct {
-	orderService    order.Service
-	carrierService  carrier.Service
-	shippingService shipping.Service
+	orderService        order.Service
+	carrierService      carrier.Service
+	shippingService     shipping.Service
+	trackingService     tracking.Service
+	webhookService      carrierwebhook.Service
+	labelService        label.Service
+	invoiceService      invoice.Service
+	manifestService     manifest.Service
+	subscriptionService subscription.Service
 }
 
 func NewHandler(
 	service order.Service,
 	carrierService carrier.Service,
 	shippingService shipping.Service,
+	trackingService tracking.Service,
+	webhookService carrierwebhook.Service,
+	labelService label.Service,
+	invoiceService invoice.Service,
+	manifestService manifest.Service,
+	subscriptionService subscription.Service,
 ) *Handler {
 	return &Handler{
-		orderService:    service,
-		carrierService:  carrierService,
-		shippingService: shippingService,
+		orderService:        service,
+		carrierService:      carrierService,
+		shippingService:     shippingService,
+		trackingService:     trackingService,
+		webhookService:      webhookService,
+		labelService:        labelService,
+		invoiceService:      invoiceService,
+		manifestService:     manifestService,
+		subscriptionService: subscriptionService,
 	}
 }
 
@@ -44,10 +71,15 @@ func (h *Handler) CreateOrder(
 		return nil, huma.Error400BadRequest("invalid destination UF")
 	}
 
+	if input.Body.DeclaredValue < 0 {
+		return nil, huma.Error400BadRequest("declared value must not be negative")
+	}
+
 	createdOrder, err := h.orderService.Create(ctx, &order.Order{
 		Product:       input.Body.Product,
 		WeightKg:      decimal.NewFromFloat(input.Body.WeightKg),
 		DestinationUF: state,
+		DeclaredValue: decimal.NewFromFloat(input.Body.DeclaredValue),
 		Status:        order.StatusCreated,
 		CreatedAt:     now,
 		UpdatedAt:     now,
@@ -57,13 +89,28 @@ func (h *Handler) CreateOrder(
 	}
 
 	log.L().Info("Created order", log.String("order_id", createdOrder.ID.String()))
+
+	var contractID *uuid.UUID
+	contract, err := h.shippingService.AutoContract(ctx, createdOrder.ID, input.Body.AutoContract)
+	if err != nil {
+		log.L().
+			Warn("failed to auto contract order", log.String("order_id", createdOrder.ID.String()), log.Error(err))
+	}
+	if contract != nil {
+		contractID = &contract.ID
+		createdOrder.Status = order.StatusAwaitingPickup
+		createdOrder.UpdatedAt = contract.UpdatedAt
+	}
+
 	return &order.OrderResponseOutput{
 		Body: order.OrderResponseOutputBody{
 			ID:            createdOrder.ID,
 			Product:       createdOrder.Product,
 			WeightKg:      createdOrder.WeightKg.StringFixed(2),
 			DestinationUF: createdOrder.DestinationUF.Sigla,
+			DeclaredValue: createdOrder.DeclaredValue.StringFixed(2),
 			Status:        createdOrder.Status,
+			ContractID:    contractID,
 			CreatedAt:     createdOrder.CreatedAt,
 			UpdatedAt:     createdOrder.UpdatedAt,
 		},
@@ -88,6 +135,7 @@ func (h *Handler) GetOrder(
 			Product:       found.Product,
 			WeightKg:      found.WeightKg.StringFixed(2),
 			DestinationUF: found.DestinationUF.Sigla,
+			DeclaredValue: found.DeclaredValue.StringFixed(2),
 			Status:        found.Status,
 			CreatedAt:     found.CreatedAt,
 			UpdatedAt:     found.UpdatedAt,
@@ -138,12 +186,32 @@ func (h *Handler) CreateCarrier(
 			return nil, huma.Error400BadRequest("invalid region")
 		}
 
+		if p.AdValoremPercentage < 0 || p.GRISPercentage < 0 || p.GRISMinimum < 0 ||
+			p.TollPerFraction < 0 || p.TDEPercentage < 0 {
+			return nil, huma.Error400BadRequest("freight components must not be negative")
+		}
+
+		tdeUFs := make([]string, len(p.TDEUFs))
+		for j, uf := range p.TDEUFs {
+			state, ok := states.States[strings.ToUpper(uf)]
+			if !ok || state.Region != region.Name {
+				return nil, huma.Error400BadRequest("invalid TDE UF for region " + region.Name)
+			}
+			tdeUFs[j] = state.Sigla
+		}
+
 		policies[i] = carrier.Policy{
-			Region:        region,
-			EstimatedDays: p.EstimatedDays,
-			PricePerKg:    decimal.NewFromFloat(p.PricePerKg),
-			CreatedAt:     now,
-			UpdatedAt:     now,
+			Region:              region,
+			EstimatedDays:       p.EstimatedDays,
+			PricePerKg:          decimal.NewFromFloat(p.PricePerKg),
+			AdValoremPercentage: decimal.NewFromFloat(p.AdValoremPercentage),
+			GRISPercentage:      decimal.NewFromFloat(p.GRISPercentage),
+			GRISMinimum:         decimal.NewFromFloat(p.GRISMinimum),
+			TollPerFraction:     decimal.NewFromFloat(p.TollPerFraction),
+			TDEPercentage:       decimal.NewFromFloat(p.TDEPercentage),
+			TDEUFs:              tdeUFs,
+			CreatedAt:           now,
+			UpdatedAt:           now,
 		}
 	}
 
@@ -160,11 +228,17 @@ func (h *Handler) CreateCarrier(
 	policiesResponse := make([]carrier.CarrierPolicyResponse, len(createdCarrier.Policies))
 	for i, p := range createdCarrier.Policies {
 		policiesResponse[i] = carrier.CarrierPolicyResponse{
-			Region:        p.Region.Name,
-			EstimatedDays: p.EstimatedDays,
-			PricePerKg:    p.PricePerKg.StringFixed(2),
-			CreatedAt:     p.CreatedAt,
-			UpdatedAt:     p.UpdatedAt,
+			Region:              p.Region.Name,
+			EstimatedDays:       p.EstimatedDays,
+			PricePerKg:          p.PricePerKg.StringFixed(2),
+			AdValoremPercentage: p.AdValoremPercentage.StringFixed(2),
+			GRISPercentage:      p.GRISPercentage.StringFixed(2),
+			GRISMinimum:         p.GRISMinimum.StringFixed(2),
+			TollPerFraction:     p.TollPerFraction.StringFixed(2),
+			TDEPercentage:       p.TDEPercentage.StringFixed(2),
+			TDEUFs:              p.TDEUFs,
+			CreatedAt:           p.CreatedAt,
+			UpdatedAt:           p.UpdatedAt,
 		}
 	}
 
@@ -181,6 +255,236 @@ func (h *Handler) CreateCarrier(
 	}, nil
 }
 
+func (h *Handler) GetCoverageByState(
+	ctx context.Context,
+	input *carrier.GetCoverageByStateInput,
+) (*carrier.CoverageByStateOutput, error) {
+	state, ok := states.States[strings.ToUpper(input.UF)]
+	if !ok {
+		return nil, huma.Error400BadRequest("invalid UF")
+	}
+
+	carriers, err := h.carrierService.ListByState(ctx, state)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to list carriers", err)
+	}
+
+	carriersResponse := make([]carrier.CoverageCarrierResponse, 0, len(carriers))
+	for _, c := range carriers {
+		for _, p := range c.Policies {
+			if p.Region.Name != state.Region {
+				continue
+			}
+			carriersResponse = append(carriersResponse, carrier.CoverageCarrierResponse{
+				CarrierID:     c.ID,
+				CarrierName:   c.Name,
+				EstimatedDays: p.EstimatedDays,
+				PricePerKg:    p.PricePerKg.StringFixed(2),
+			})
+		}
+	}
+
+	return &carrier.CoverageByStateOutput{
+		Body: carrier.CoverageByStateOutputBody{
+			UF:       state.Sigla,
+			State:    state.LongName,
+			Region:   state.Region,
+			Carriers: carriersResponse,
+		},
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) GetCarrierCoverage(
+	ctx context.Context,
+	input *carrier.GetCarrierCoverageInput,
+) (*carrier.CarrierCoverageOutput, error) {
+	found, err := h.carrierService.GetByID(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, carrier.ErrCarrierNotFound) {
+			return nil, huma.Error404NotFound("carrier not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get carrier", err)
+	}
+
+	coverage := found.Coverage()
+	statesResponse := make([]carrier.CoverageStateResponse, len(coverage))
+	for i, c := range coverage {
+		statesResponse[i] = carrier.CoverageStateResponse{
+			UF:            c.State.Sigla,
+			State:         c.State.LongName,
+			Region:        c.State.Region,
+			EstimatedDays: c.Policy.EstimatedDays,
+			PricePerKg:    c.Policy.PricePerKg.StringFixed(2),
+		}
+	}
+
+	return &carrier.CarrierCoverageOutput{
+		Body: carrier.CarrierCoverageOutputBody{
+			CarrierID:   found.ID,
+			CarrierName: found.Name,
+			States:      statesResponse,
+		},
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) CreateFuelSurcharge(
+	ctx context.Context,
+	input *carrier.CreateFuelSurchargeInput,
+) (*carrier.FuelSurchargeResponseOutput, error) {
+	if input.Body.Percentage < 0 {
+		return nil, huma.Error400BadRequest("percentage must not be negative")
+	}
+
+	created, err := h.carrierService.CreateFuelSurcharge(ctx, &carrier.FuelSurcharge{
+		CarrierID:     input.Body.CarrierID,
+		Percentage:    decimal.NewFromFloat(input.Body.Percentage),
+		EffectiveFrom: input.Body.EffectiveFrom.UTC(),
+		CreatedAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		if errors.Is(err, carrier.ErrCarrierNotFound) {
+			return nil, huma.Error404NotFound("carrier not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to create fuel surcharge", err)
+	}
+
+	log.L().Info("Created fuel surcharge", log.String("fuel_surcharge_id", created.ID.String()))
+	return &carrier.FuelSurchargeResponseOutput{
+		Body:   toFuelSurchargeResponse(*created),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) ListFuelSurcharges(
+	ctx context.Context,
+	_ *struct{},
+) (*carrier.ListFuelSurchargesOutput, error) {
+	surcharges, err := h.carrierService.ListFuelSurcharges(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to list fuel surcharges", err)
+	}
+
+	response := make([]carrier.FuelSurchargeResponse, len(surcharges))
+	for i, s := range surcharges {
+		response[i] = toFuelSurchargeResponse(s)
+	}
+
+	return &carrier.ListFuelSurchargesOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toFuelSurchargeResponse(s carrier.FuelSurcharge) carrier.FuelSurchargeResponse {
+	return carrier.FuelSurchargeResponse{
+		ID:            s.ID,
+		CarrierID:     s.CarrierID,
+		Percentage:    s.Percentage.StringFixed(2),
+		EffectiveFrom: s.EffectiveFrom,
+		CreatedAt:     s.CreatedAt,
+	}
+}
+
+func (h *Handler) CreateCarrierDiscount(
+	ctx context.Context,
+	input *carrier.CreateDiscountInput,
+) (*carrier.DiscountResponseOutput, error) {
+	body := input.Body
+	if body.Percentage <= 0 || body.Percentage > 100 {
+		return nil, huma.Error400BadRequest("percentage must be greater than 0 and at most 100")
+	}
+	if body.MinWeightKg < 0 || body.MaxWeightKg < 0 || body.MinMonthlyVolume < 0 {
+		return nil, huma.Error400BadRequest("conditions must not be negative")
+	}
+	if body.MaxWeightKg > 0 && body.MaxWeightKg < body.MinWeightKg {
+		return nil, huma.Error400BadRequest("max_weight_kg must not be lower than min_weight_kg")
+	}
+	if body.ValidUntil != nil && !body.ValidUntil.After(body.ValidFrom) {
+		return nil, huma.Error400BadRequest("valid_until must be after valid_from")
+	}
+
+	var region string
+	if body.Region != "" {
+		r, ok := states.Regions[body.Region]
+		if !ok {
+			return nil, huma.Error400BadRequest("invalid region")
+		}
+		region = r.Name
+	}
+
+	var validUntil *time.Time
+	if body.ValidUntil != nil {
+		until := body.ValidUntil.UTC()
+		validUntil = &until
+	}
+
+	created, err := h.carrierService.CreateDiscount(ctx, &carrier.Discount{
+		CarrierID:        input.ID,
+		Name:             body.Name,
+		Percentage:       decimal.NewFromFloat(body.Percentage),
+		Region:           region,
+		MinWeightKg:      decimal.NewFromFloat(body.MinWeightKg),
+		MaxWeightKg:      decimal.NewFromFloat(body.MaxWeightKg),
+		MinMonthlyVolume: body.MinMonthlyVolume,
+		ValidFrom:        body.ValidFrom.UTC(),
+		ValidUntil:       validUntil,
+		CreatedAt:        time.Now().UTC(),
+	})
+	if err != nil {
+		if errors.Is(err, carrier.ErrCarrierNotFound) {
+			return nil, huma.Error404NotFound("carrier not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to create discount", err)
+	}
+
+	log.L().Info("Created carrier discount", log.String("discount_id", created.ID.String()))
+	return &carrier.DiscountResponseOutput{
+		Body:   toDiscountResponse(*created),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) ListCarrierDiscounts(
+	ctx context.Context,
+	input *carrier.GetDiscountsInput,
+) (*carrier.ListDiscountsOutput, error) {
+	discounts, err := h.carrierService.ListDiscounts(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, carrier.ErrCarrierNotFound) {
+			return nil, huma.Error404NotFound("carrier not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to list discounts", err)
+	}
+
+	response := make([]carrier.DiscountResponse, len(discounts))
+	for i, d := range discounts {
+		response[i] = toDiscountResponse(d)
+	}
+
+	return &carrier.ListDiscountsOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toDiscountResponse(d carrier.Discount) carrier.DiscountResponse {
+	return carrier.DiscountResponse{
+		ID:               d.ID,
+		CarrierID:        d.CarrierID,
+		Name:             d.Name,
+		Percentage:       d.Percentage.StringFixed(2),
+		Region:           d.Region,
+		MinWeightKg:      d.MinWeightKg.StringFixed(2),
+		MaxWeightKg:      d.MaxWeightKg.StringFixed(2),
+		MinMonthlyVolume: d.MinMonthlyVolume,
+		ValidFrom:        d.ValidFrom,
+		ValidUntil:       d.ValidUntil,
+		CreatedAt:        d.CreatedAt,
+	}
+}
+
 func (h *Handler) GetQuotes(
 	ctx context.Context,
 	input *shipping.GetQuotesInput,
@@ -190,7 +494,16 @@ func (h *Handler) GetQuotes(
 		return nil, huma.Error404NotFound("invalid ID format")
 	}
 
-	quotes, err := h.shippingService.QuoteAll(ctx, id)
+	var strategy shipping.RankingStrategy
+	if input.Sort != "" {
+		var ok bool
+		strategy, ok = shipping.RankingStrategies[input.Sort]
+		if !ok {
+			return nil, huma.Error400BadRequest("invalid sort strategy")
+		}
+	}
+
+	quotes, err := h.shippingService.QuoteAll(ctx, id, strategy)
 	if err != nil {
 		if errors.Is(err, order.ErrOrderNotFound) {
 			return nil, huma.Error404NotFound("order not found")
@@ -198,18 +511,287 @@ func (h *Handler) GetQuotes(
 		return nil, err
 	}
 
+	return &shipping.QuotesOutput{
+		Body:   toQuotesOutputBody(quotes),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) GetBatchQuotes(
+	ctx context.Context,
+	input *shipping.BatchQuotesInput,
+) (*shipping.BatchQuotesOutput, error) {
+	var strategy shipping.RankingStrategy
+	if input.Sort != "" {
+		var ok bool
+		strategy, ok = shipping.RankingStrategies[input.Sort]
+		if !ok {
+			return nil, huma.Error400BadRequest("invalid sort strategy")
+		}
+	}
+
+	results, err := h.shippingService.QuoteBatch(ctx, input.Body.OrderIDs, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]shipping.BatchQuotesOutputBody, len(results))
+	for i, r := range results {
+		response[i] = shipping.BatchQuotesOutputBody{
+			OrderID: r.OrderID.String(),
+			Quotes:  toQuotesOutputBody(r.Quotes),
+		}
+		if r.Err != nil {
+			if errors.Is(r.Err, order.ErrOrderNotFound) {
+				response[i].Error = "order not found"
+			} else {
+				response[i].Error = "failed to quote order"
+			}
+		}
+	}
+
+	return &shipping.BatchQuotesOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) AllocateOrders(
+	ctx context.Context,
+	input *shipping.AllocateInput,
+) (*shipping.AllocationOutput, error) {
+	request := shipping.AllocationRequest{
+		OrderIDs:         input.Body.OrderIDs,
+		Capacity:         make(map[uuid.UUID]int, len(input.Body.Capacities)),
+		MaxDays:          input.Body.MaxDays,
+		RequiredCarriers: input.Body.RequiredCarrierIDs,
+		Contract:         input.Body.Contract,
+	}
+	for _, c := range input.Body.Capacities {
+		if _, ok := request.Capacity[c.CarrierID]; ok {
+			return nil, huma.Error400BadRequest("carrier capacity given more than once")
+		}
+		request.Capacity[c.CarrierID] = c.MaxOrders
+	}
+
+	allocation, err := h.shippingService.Allocate(ctx, request)
+	if err != nil {
+		switch {
+		case errors.Is(err, order.ErrOrderNotFound):
+			return nil, huma.Error404NotFound("order not found")
+		case errors.Is(err, shipping.ErrAllocationInfeasible):
+			return nil, huma.Error422UnprocessableEntity("no allocation satisfies the constraints")
+		case errors.Is(err, shipping.ErrOrderNotAllocatable):
+			return nil, huma.Error409Conflict("only created orders can be allocated")
+		}
+		return nil, huma.Error500InternalServerError("failed to allocate orders", err)
+	}
+
+	response := shipping.AllocationResponse{
+		Assignments:   make([]shipping.AssignmentResponse, len(allocation.Assignments)),
+		Carriers:      make([]shipping.CarrierLoadResponse, len(allocation.Carriers)),
+		TotalPrice:    allocation.TotalPrice.StringFixed(2),
+		CheapestPrice: allocation.CheapestPrice.StringFixed(2),
+	}
+	for i, a := range allocation.Assignments {
+		response.Assignments[i] = shipping.AssignmentResponse{
+			OrderID:       a.OrderID.String(),
+			CarrierID:     a.CarrierID.String(),
+			CarrierName:   a.CarrierName,
+			QuoteID:       a.QuoteID.String(),
+			TotalPrice:    a.TotalPrice.StringFixed(2),
+			EstimatedDays: a.EstimatedDays,
+		}
+		if a.ContractID != nil {
+			id := a.ContractID.String()
+			response.Assignments[i].ContractID = &id
+		}
+		if a.Err != nil {
+			switch {
+			case errors.Is(a.Err, order.ErrInvalidStatusTransition):
+				response.Assignments[i].Error = "invalid order status for contracting"
+			case errors.Is(a.Err, shipping.ErrContractAlreadyExists):
+				response.Assignments[i].Error = "order already has an active contract"
+			case errors.Is(a.Err, shipping.ErrContractNotActive):
+				response.Assignments[i].Error = "order contract changed while contracting, retry"
+			default:
+				response.Assignments[i].Error = "failed to contract order"
+			}
+		}
+	}
+	for i, c := range allocation.Carriers {
+		response.Carriers[i] = shipping.CarrierLoadResponse{
+			CarrierID:   c.CarrierID.String(),
+			CarrierName: c.CarrierName,
+			Orders:      c.Orders,
+			TotalPrice:  c.TotalPrice.StringFixed(2),
+		}
+	}
+
+	return &shipping.AllocationOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toQuotesOutputBody(quotes []*shipping.Quote) []shipping.QuotesOutputBody {
 	quotesResponse := make([]shipping.QuotesOutputBody, len(quotes))
 	for i, q := range quotes {
 		quotesResponse[i] = shipping.QuotesOutputBody{
-			CarrierID:     q.CarrierID.String(),
-			CarrierName:   q.CarrierName,
-			Price:         q.Price.StringFixed(2),
-			EstimatedDays: q.EstimatedDays,
+			ID:                      q.ID.String(),
+			CarrierID:               q.CarrierID.String(),
+			CarrierName:             q.CarrierName,
+			BasePrice:               q.BasePrice.StringFixed(2),
+			FuelSurchargePercentage: q.FuelSurchargePercentage.StringFixed(2),
+			FuelSurcharge:           q.FuelSurcharge.StringFixed(2),
+			AdValorem:               q.AdValorem.StringFixed(2),
+			GRIS:                    q.GRIS.StringFixed(2),
+			Toll:                    q.Toll.StringFixed(2),
+			TDE:                     q.TDE.StringFixed(2),
+			Discount:                q.Discount.StringFixed(2),
+			ICMSRate:                q.ICMSRate.StringFixed(2),
+			ICMS:                    q.ICMS.StringFixed(2),
+			TotalPrice:              q.TotalPrice().StringFixed(2),
+			Price:                   q.Price.StringFixed(2),
+			EstimatedDays:           q.EstimatedDays,
+			Reliability:             q.Reliability.StringFixed(4),
+			Score:                   q.Score.StringFixed(4),
+			Recommended:             q.Recommended,
+			PolicyVersion:           q.PolicyVersion,
+			ExpiresAt:               q.ExpiresAt,
+			Breakdown:               toPriceLinesOutput(q.Breakdown),
+			Explanation:             toPriceExplanationOutput(q.Explanation),
 		}
 	}
+	return quotesResponse
+}
 
-	return &shipping.QuotesOutput{
-		Body:   quotesResponse,
+func toPriceLinesOutput(lines []shipping.PriceLine) []shipping.PriceLineOutputBody {
+	response := make([]shipping.PriceLineOutputBody, len(lines))
+	for i, l := range lines {
+		response[i] = shipping.PriceLineOutputBody{
+			Rule:        l.Rule,
+			Description: l.Description,
+			Amount:      l.Amount.StringFixed(2),
+		}
+	}
+	return response
+}
+
+func toPriceExplanationOutput(e shipping.PriceExplanation) shipping.PriceExplanationOutputBody {
+	var policyID *string
+	if e.PolicyID != nil {
+		id := e.PolicyID.String()
+		policyID = &id
+	}
+
+	return shipping.PriceExplanationOutputBody{
+		Source:             e.Source,
+		PolicyID:           policyID,
+		PolicyVersion:      e.PolicyVersion,
+		Region:             e.Region,
+		OriginUF:           e.OriginUF,
+		DestinationUF:      e.DestinationUF,
+		ChargeableWeightKg: e.ChargeableWeightKg.StringFixed(3),
+		RatePerKg:          e.RatePerKg.StringFixed(2),
+		CarrierReference:   e.CarrierReference,
+		Rounding:           e.Rounding.StringFixed(2),
+	}
+}
+
+func (h *Handler) SimulateQuotes(
+	ctx context.Context,
+	input *shipping.SimulateQuotesInput,
+) (*shipping.SimulateQuotesOutput, error) {
+	if input.Body.WeightKg <= 0 {
+		return nil, huma.Error400BadRequest("weight must be greater than zero")
+	}
+
+	if input.Body.LengthCm < 0 || input.Body.WidthCm < 0 || input.Body.HeightCm < 0 ||
+		input.Body.DeclaredValue < 0 {
+		return nil, huma.Error400BadRequest("dimensions and declared value must not be negative")
+	}
+
+	var (
+		state states.State
+		ok    bool
+	)
+	if input.Body.DestinationUF != "" {
+		state, ok = states.States[strings.ToUpper(input.Body.DestinationUF)]
+		if !ok {
+			return nil, huma.Error400BadRequest("invalid destination UF")
+		}
+	}
+
+	if input.Body.DestinationCEP != "" {
+		cepState, err := states.FromCEP(input.Body.DestinationCEP)
+		if err != nil {
+			return nil, huma.Error400BadRequest("invalid destination CEP")
+		}
+		if ok && cepState.Sigla != state.Sigla {
+			return nil, huma.Error400BadRequest("destination CEP does not belong to the destination UF")
+		}
+		state, ok = cepState, true
+	}
+
+	if !ok {
+		return nil, huma.Error400BadRequest("destination UF or CEP is required")
+	}
+
+	var strategy shipping.RankingStrategy
+	if input.Sort != "" {
+		strategy, ok = shipping.RankingStrategies[input.Sort]
+		if !ok {
+			return nil, huma.Error400BadRequest("invalid sort strategy")
+		}
+	}
+
+	simulation := shipping.Simulation{
+		DestinationUF: state,
+		WeightKg:      decimal.NewFromFloat(input.Body.WeightKg),
+		LengthCm:      decimal.NewFromFloat(input.Body.LengthCm),
+		WidthCm:       decimal.NewFromFloat(input.Body.WidthCm),
+		HeightCm:      decimal.NewFromFloat(input.Body.HeightCm),
+		DeclaredValue: decimal.NewFromFloat(input.Body.DeclaredValue),
+	}
+
+	quotes, err := h.shippingService.Simulate(ctx, simulation, strategy)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to simulate quotes", err)
+	}
+
+	quotesResponse := make([]shipping.SimulatedQuoteOutputBody, len(quotes))
+	for i, q := range quotes {
+		quotesResponse[i] = shipping.SimulatedQuoteOutputBody{
+			CarrierID:               q.CarrierID.String(),
+			CarrierName:             q.CarrierName,
+			BasePrice:               q.BasePrice.StringFixed(2),
+			FuelSurchargePercentage: q.FuelSurchargePercentage.StringFixed(2),
+			FuelSurcharge:           q.FuelSurcharge.StringFixed(2),
+			AdValorem:               q.AdValorem.StringFixed(2),
+			GRIS:                    q.GRIS.StringFixed(2),
+			Toll:                    q.Toll.StringFixed(2),
+			TDE:                     q.TDE.StringFixed(2),
+			Discount:                q.Discount.StringFixed(2),
+			ICMSRate:                q.ICMSRate.StringFixed(2),
+			ICMS:                    q.ICMS.StringFixed(2),
+			TotalPrice:              q.TotalPrice().StringFixed(2),
+			Price:                   q.Price.StringFixed(2),
+			EstimatedDays:           q.EstimatedDays,
+			Reliability:             q.Reliability.StringFixed(4),
+			Score:                   q.Score.StringFixed(4),
+			Recommended:             q.Recommended,
+			Breakdown:               toPriceLinesOutput(q.Breakdown),
+			Explanation:             toPriceExplanationOutput(q.Explanation),
+		}
+	}
+
+	return &shipping.SimulateQuotesOutput{
+		Body: shipping.SimulateQuotesOutputBody{
+			DestinationUF:      state.Sigla,
+			ChargeableWeightKg: simulation.ChargeableWeightKg().StringFixed(2),
+			Quotes:             quotesResponse,
+		},
 		Status: http.StatusOK,
 	}, nil
 }
@@ -222,6 +804,8 @@ func (h *Handler) ContractCarrier(
 		ctx,
 		input.Body.OrderID,
 		input.Body.CarrierID,
+		input.Body.QuoteID,
+		input.Body.Replace,
 	)
 	if err != nil {
 		switch {
@@ -231,6 +815,16 @@ func (h *Handler) ContractCarrier(
 			return nil, huma.Error404NotFound("carrier not found")
 		case errors.Is(err, order.ErrInvalidStatusTransition):
 			return nil, huma.Error400BadRequest("invalid order status for contracting")
+		case errors.Is(err, shipping.ErrQuoteNotFound):
+			return nil, huma.Error404NotFound("quote not found")
+		case errors.Is(err, shipping.ErrQuoteMismatch):
+			return nil, huma.Error400BadRequest("quote does not belong to the order and carrier")
+		case errors.Is(err, shipping.ErrQuoteExpired):
+			return nil, huma.Error410Gone("quote expired")
+		case errors.Is(err, shipping.ErrContractAlreadyExists):
+			return nil, huma.Error409Conflict("order already has an active contract")
+		case errors.Is(err, shipping.ErrContractNotActive):
+			return nil, huma.Error409Conflict("order contract changed while contracting, retry")
 		case errors.Is(err, shipping.ErrNoValidPolicy):
 			return nil, huma.Error400BadRequest(
 				"no valid policy for the carrier in the order's destination region",
@@ -241,16 +835,1191 @@ func (h *Handler) ContractCarrier(
 
 	log.L().Info("Carrier contracted", log.String("contract_id", contract.ID.String()))
 	return &shipping.ContractCarrierOutput{
-		Body: shipping.ContractCarrierOutputBody{
-			ID:            contract.ID.String(),
-			OrderID:       contract.OrderID.String(),
-			CarrierID:     contract.CarrierID.String(),
-			Price:         contract.Price.StringFixed(2),
-			EstimatedDays: contract.EstimatedDays,
-			ContractedAt:  contract.ContractedAt,
-			CreatedAt:     contract.CreatedAt,
-			UpdatedAt:     contract.UpdatedAt,
-		},
+		Body:   toContractOutputBody(*contract),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) GetContract(
+	ctx context.Context,
+	input *shipping.GetContractInput,
+) (*shipping.ContractCarrierOutput, error) {
+	contract, err := h.shippingService.GetContract(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, shipping.ErrContractNotFound) {
+			return nil, huma.Error404NotFound("contract not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get contract", err)
+	}
+
+	return &shipping.ContractCarrierOutput{
+		Body:   toContractOutputBody(*contract),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) ListContracts(
+	ctx context.Context,
+	input *shipping.ListContractsInput,
+) (*shipping.ListContractsOutput, error) {
+	filter := shipping.ContractFilter{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	}
+	if input.OrderID != uuid.Nil {
+		filter.OrderID = &input.OrderID
+	}
+	if input.CarrierID != uuid.Nil {
+		filter.CarrierID = &input.CarrierID
+	}
+	if input.Status != "" {
+		status, ok := shipping.ContractStatusValues[input.Status]
+		if !ok {
+			return nil, huma.Error400BadRequest("invalid status")
+		}
+		filter.Status = &status
+	}
+	if !input.From.IsZero() {
+		from := input.From.UTC()
+		filter.From = &from
+	}
+	if !input.To.IsZero() {
+		to := input.To.UTC()
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return nil, huma.Error400BadRequest("to must be after from")
+	}
+
+	contracts, err := h.shippingService.ListContracts(ctx, filter)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to list contracts", err)
+	}
+
+	response := make([]shipping.ContractCarrierOutputBody, len(contracts))
+	for i, c := range contracts {
+		response[i] = toContractOutputBody(c)
+	}
+
+	return &shipping.ListContractsOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) CancelContract(
+	ctx context.Context,
+	input *shipping.CancelContractInput,
+) (*shipping.ContractCarrierOutput, error) {
+	reason := strings.TrimSpace(input.Body.Reason)
+	if reason == "" {
+		return nil, huma.Error400BadRequest("reason is required")
+	}
+
+	contract, err := h.shippingService.CancelContract(ctx, input.ID, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, shipping.ErrContractNotFound):
+			return nil, huma.Error404NotFound("contract not found")
+		case errors.Is(err, shipping.ErrContractNotActive):
+			return nil, huma.Error409Conflict("contract is not active")
+		case errors.Is(err, order.ErrInvalidStatusTransition):
+			return nil, huma.Error409Conflict("order has already been picked up")
+		}
+		return nil, huma.Error500InternalServerError("failed to cancel contract", err)
+	}
+
+	log.L().Info("Contract cancelled", log.String("contract_id", contract.ID.String()))
+	return &shipping.ContractCarrierOutput{
+		Body:   toContractOutputBody(*contract),
 		Status: http.StatusOK,
 	}, nil
 }
+
+func (h *Handler) SetTrackingCode(
+	ctx context.Context,
+	input *tracking.SetTrackingCodeInput,
+) (*shipping.ContractCarrierOutput, error) {
+	code := strings.TrimSpace(input.Body.TrackingCode)
+	if code == "" {
+		return nil, huma.Error400BadRequest("tracking_code is required")
+	}
+
+	contract, err := h.trackingService.SetTrackingCode(ctx, input.ID, code)
+	if err != nil {
+		switch {
+		case errors.Is(err, shipping.ErrContractNotFound):
+			return nil, huma.Error404NotFound("contract not found")
+		case errors.Is(err, shipping.ErrContractNotActive):
+			return nil, huma.Error409Conflict("contract is not active")
+		case errors.Is(err, shipping.ErrTrackingCodeTaken):
+			return nil, huma.Error409Conflict("tracking code already used by another contract of the carrier")
+		}
+		return nil, huma.Error500InternalServerError("failed to set tracking code", err)
+	}
+
+	log.L().Info("Tracking code set", log.String("contract_id", contract.ID.String()))
+	return &shipping.ContractCarrierOutput{
+		Body:   toContractOutputBody(*contract),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) AppendTrackingEvent(
+	ctx context.Context,
+	input *tracking.AppendEventInput,
+) (*tracking.EventResponseOutput, error) {
+	code, ok := tracking.EventCodes[input.Body.Code]
+	if !ok {
+		return nil, huma.Error400BadRequest("invalid tracking event code")
+	}
+
+	event, err := h.trackingService.AppendEvent(ctx, input.ID, &tracking.Event{
+		Code:        code,
+		Description: input.Body.Description,
+		Location:    input.Body.Location,
+		OccurredAt:  input.Body.OccurredAt.UTC(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, shipping.ErrContractNotFound):
+			return nil, huma.Error404NotFound("contract not found")
+		case errors.Is(err, shipping.ErrContractNotActive):
+			return nil, huma.Error409Conflict("contract is not active")
+		case errors.Is(err, tracking.ErrDuplicateEvent):
+			return nil, huma.Error409Conflict("tracking event already recorded")
+		}
+		return nil, huma.Error500InternalServerError("failed to append tracking event", err)
+	}
+
+	log.L().
+		Info("Tracking event appended", log.String("contract_id", event.ContractID.String()), log.String("code", string(event.Code)))
+	return &tracking.EventResponseOutput{
+		Body:   toEventResponse(*event),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) GetTrackingTrail(
+	ctx context.Context,
+	input *tracking.GetTrailInput,
+) (*tracking.TrailOutput, error) {
+	trail, err := h.trackingService.GetTrail(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, order.ErrOrderNotFound) {
+			return nil, huma.Error404NotFound("order not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get tracking trail", err)
+	}
+
+	events := make([]tracking.EventResponse, len(trail.Events))
+	for i, e := range trail.Events {
+		events[i] = toEventResponse(e)
+	}
+
+	return &tracking.TrailOutput{
+		Body: tracking.TrailOutputBody{
+			OrderID:      trail.OrderID,
+			OrderStatus:  trail.OrderStatus,
+			ContractID:   trail.ContractID,
+			CarrierID:    trail.CarrierID,
+			TrackingCode: trail.TrackingCode,
+			Events:       events,
+		},
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toEventResponse(e tracking.Event) tracking.EventResponse {
+	return tracking.EventResponse{
+		ID:          e.ID,
+		ContractID:  e.ContractID,
+		Code:        string(e.Code),
+		Description: e.Description,
+		Location:    e.Location,
+		OccurredAt:  e.OccurredAt,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func (h *Handler) RotateCarrierWebhookSecret(
+	ctx context.Context,
+	input *carrierwebhook.RotateSecretInput,
+) (*carrierwebhook.RotateSecretOutput, error) {
+	secret, err := h.webhookService.RotateSecret(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, carrier.ErrCarrierNotFound) {
+			return nil, huma.Error404NotFound("carrier not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to rotate webhook secret", err)
+	}
+
+	log.L().Info("Rotated carrier webhook secret", log.String("carrier_id", input.ID.String()))
+	return &carrierwebhook.RotateSecretOutput{
+		Body: carrierwebhook.RotateSecretOutputBody{
+			CarrierID: input.ID,
+			Secret:    secret,
+		},
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) ReceiveCarrierWebhook(
+	ctx context.Context,
+	input *carrierwebhook.ReceiveInput,
+) (*carrierwebhook.ReceiveOutput, error) {
+	delivery, err := h.webhookService.Receive(ctx, input.ID, carrierwebhook.Signed{
+		Timestamp: input.Timestamp,
+		Nonce:     input.Nonce,
+		Signature: input.Signature,
+		Body:      input.RawBody,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, carrierwebhook.ErrSecretNotFound),
+			errors.Is(err, carrierwebhook.ErrInvalidSignature),
+			errors.Is(err, carrierwebhook.ErrStaleTimestamp):
+			return nil, huma.Error401Unauthorized("invalid webhook signature")
+		case errors.Is(err, carrierwebhook.ErrReplayedDelivery):
+			return nil, huma.Error409Conflict("webhook nonce already received")
+		case errors.Is(err, carrierwebhook.ErrInvalidPayload):
+			return nil, huma.Error400BadRequest("invalid webhook payload")
+		}
+		return nil, huma.Error500InternalServerError("failed to process webhook", err)
+	}
+
+	log.L().
+		Info("Received carrier webhook", log.String("delivery_id", delivery.ID.String()), log.Int("accepted", delivery.Accepted), log.Int("unmatched", delivery.Unmatched))
+	return &carrierwebhook.ReceiveOutput{
+		Body: carrierwebhook.ReceiveOutputBody{
+			DeliveryID: delivery.ID,
+			Accepted:   delivery.Accepted,
+			Unmatched:  delivery.Unmatched,
+		},
+		Status: http.StatusAccepted,
+	}, nil
+}
+
+func (h *Handler) GetShippingLabel(
+	ctx context.Context,
+	input *label.RenderInput,
+) (*label.RenderOutput, error) {
+	format, ok := label.Formats[input.Format]
+	if !ok {
+		return nil, huma.Error400BadRequest("invalid label format")
+	}
+
+	l, err := h.labelService.Render(ctx, input.ID, format)
+	if err != nil {
+		switch {
+		case errors.Is(err, shipping.ErrContractNotFound):
+			return nil, huma.Error404NotFound("contract not found")
+		case errors.Is(err, shipping.ErrContractNotActive):
+			return nil, huma.Error409Conflict("contract is not active")
+		case errors.Is(err, label.ErrMissingTrackingCode):
+			return nil, huma.Error409Conflict("contract has no tracking code")
+		case errors.Is(err, barcode.ErrUnsupportedCharacter):
+			return nil, huma.Error422UnprocessableEntity("tracking code cannot be encoded as a barcode")
+		case errors.Is(err, label.ErrInvalidTemplate):
+			return nil, huma.Error422UnprocessableEntity("carrier label template is invalid", err)
+		}
+		return nil, huma.Error500InternalServerError("failed to render label", err)
+	}
+
+	return &label.RenderOutput{
+		ContentType:        l.ContentType,
+		ContentDisposition: `inline; filename="` + l.Filename + `"`,
+		Body:               l.Content,
+	}, nil
+}
+
+func (h *Handler) SetLabelTemplate(
+	ctx context.Context,
+	input *label.SetTemplateInput,
+) (*label.TemplateOutput, error) {
+	format, ok := label.Formats[input.Format]
+	if !ok {
+		return nil, huma.Error400BadRequest("invalid label format")
+	}
+
+	template, err := h.labelService.SetTemplate(ctx, &label.Template{
+		CarrierID: input.ID,
+		Format:    format,
+		Content:   input.Body.Content,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, carrier.ErrCarrierNotFound):
+			return nil, huma.Error404NotFound("carrier not found")
+		case errors.Is(err, label.ErrInvalidTemplate):
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		return nil, huma.Error500InternalServerError("failed to set label template", err)
+	}
+
+	log.L().
+		Info("Label template set", log.String("carrier_id", template.CarrierID.String()), log.String("format", string(template.Format)))
+	return &label.TemplateOutput{
+		Body:   toTemplateOutputBody(*template),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) GetLabelTemplate(
+	ctx context.Context,
+	input *label.TemplateInput,
+) (*label.TemplateOutput, error) {
+	format, ok := label.Formats[input.Format]
+	if !ok {
+		return nil, huma.Error400BadRequest("invalid label format")
+	}
+
+	template, err := h.labelService.GetTemplate(ctx, input.ID, format)
+	if err != nil {
+		if errors.Is(err, carrier.ErrCarrierNotFound) {
+			return nil, huma.Error404NotFound("carrier not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get label template", err)
+	}
+
+	return &label.TemplateOutput{
+		Body:   toTemplateOutputBody(*template),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toTemplateOutputBody(template label.Template) label.TemplateOutputBody {
+	var updatedAt *time.Time
+	if !template.Default {
+		updatedAt = &template.UpdatedAt
+	}
+
+	return label.TemplateOutputBody{
+		CarrierID: template.CarrierID,
+		Format:    string(template.Format),
+		Content:   template.Content,
+		Default:   template.Default,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (h *Handler) ImportCarrierInvoice(
+	ctx context.Context,
+	input *invoice.ImportInput,
+) (*invoice.ReportOutput, error) {
+	mediaType, _, err := mime.ParseMediaType(input.ContentType)
+	if err != nil {
+		return nil, huma.Error415UnsupportedMediaType("content type must be text/csv or application/json")
+	}
+
+	var lines []invoice.Line
+	switch mediaType {
+	case "text/csv":
+		lines, err = invoice.ParseCSV(input.RawBody)
+	case "application/json":
+		lines, err = invoice.ParseJSON(input.RawBody)
+	default:
+		return nil, huma.Error415UnsupportedMediaType("content type must be text/csv or application/json")
+	}
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+
+	report, err := h.invoiceService.Import(ctx, &invoice.Invoice{
+		CarrierID:  input.ID,
+		Reference:  strings.TrimSpace(input.Reference),
+		PeriodFrom: input.PeriodFrom.UTC(),
+		PeriodTo:   input.PeriodTo.UTC(),
+		Lines:      lines,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, invoice.ErrInvalidPeriod):
+			return nil, huma.Error400BadRequest("period_to must not be before period_from")
+		case errors.Is(err, carrier.ErrCarrierNotFound):
+			return nil, huma.Error404NotFound("carrier not found")
+		case errors.Is(err, invoice.ErrInvoiceExists):
+			return nil, huma.Error409Conflict("invoice reference already imported for the carrier")
+		}
+		return nil, huma.Error500InternalServerError("failed to import invoice", err)
+	}
+
+	log.L().
+		Info("Imported carrier invoice", log.String("invoice_id", report.Invoice.ID.String()), log.Int("matched", len(report.Matched)), log.Int("disputed", len(report.Disputed)), log.Int("unexpected", len(report.Unexpected)), log.Int("missing", len(report.Missing)))
+	return &invoice.ReportOutput{
+		Body:   toReportOutputBody(*report),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) GetInvoiceReconciliation(
+	ctx context.Context,
+	input *invoice.GetReportInput,
+) (*invoice.ReportOutput, error) {
+	report, err := h.invoiceService.Reconcile(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, invoice.ErrInvoiceNotFound) {
+			return nil, huma.Error404NotFound("invoice not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to reconcile invoice", err)
+	}
+
+	return &invoice.ReportOutput{
+		Body:   toReportOutputBody(*report),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) ListCarrierInvoices(
+	ctx context.Context,
+	input *invoice.ListInvoicesInput,
+) (*invoice.ListInvoicesOutput, error) {
+	invoices, err := h.invoiceService.ListByCarrier(ctx, input.ID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to list invoices", err)
+	}
+
+	response := make([]invoice.InvoiceResponse, len(invoices))
+	for i, inv := range invoices {
+		response[i] = toInvoiceResponse(inv)
+	}
+
+	return &invoice.ListInvoicesOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toReportOutputBody(report invoice.Report) invoice.ReportOutputBody {
+	lines := func(results []invoice.LineResult) []invoice.LineResponse {
+		response := make([]invoice.LineResponse, len(results))
+		for i, r := range results {
+			response[i] = toInvoiceLineResponse(r)
+		}
+		return response
+	}
+
+	missing := make([]invoice.MissingChargeResponse, len(report.Missing))
+	for i, m := range report.Missing {
+		missing[i] = invoice.MissingChargeResponse{
+			ContractID:     m.ContractID,
+			OrderID:        m.OrderID,
+			TrackingCode:   m.TrackingCode,
+			ExpectedAmount: m.ExpectedAmount.StringFixed(2),
+			ContractedAt:   m.ContractedAt,
+		}
+	}
+
+	return invoice.ReportOutputBody{
+		Invoice:          toInvoiceResponse(report.Invoice),
+		Matched:          lines(report.Matched),
+		Disputed:         lines(report.Disputed),
+		Unexpected:       lines(report.Unexpected),
+		Missing:          missing,
+		InvoicedTotal:    report.InvoicedTotal.StringFixed(2),
+		ExpectedTotal:    report.ExpectedTotal.StringFixed(2),
+		DisputedAmount:   report.DisputedAmount.StringFixed(2),
+		UnexpectedAmount: report.UnexpectedAmount.StringFixed(2),
+		MissingAmount:    report.MissingAmount.StringFixed(2),
+	}
+}
+
+func toInvoiceResponse(inv invoice.Invoice) invoice.InvoiceResponse {
+	return invoice.InvoiceResponse{
+		ID:                inv.ID,
+		CarrierID:         inv.CarrierID,
+		Reference:         inv.Reference,
+		PeriodFrom:        inv.PeriodFrom.Format(time.DateOnly),
+		PeriodTo:          inv.PeriodTo.Format(time.DateOnly),
+		PriceTolerance:    inv.PriceTolerance.StringFixed(2),
+		WeightToleranceKg: inv.WeightToleranceKg.StringFixed(2),
+		CreatedAt:         inv.CreatedAt,
+	}
+}
+
+func toInvoiceLineResponse(r invoice.LineResult) invoice.LineResponse {
+	fixed := func(d *decimal.Decimal) *string {
+		if d == nil {
+			return nil
+		}
+		s := d.StringFixed(2)
+		return &s
+	}
+
+	return invoice.LineResponse{
+		Number:             r.Number,
+		TrackingCode:       r.TrackingCode,
+		OrderID:            r.OrderID,
+		Amount:             r.Amount.StringFixed(2),
+		WeightKg:           fixed(r.WeightKg),
+		ContractID:         r.ContractID,
+		ExpectedAmount:     fixed(r.ExpectedAmount),
+		AmountDifference:   r.AmountDifference.StringFixed(2),
+		ExpectedWeightKg:   fixed(r.ExpectedWeightKg),
+		WeightDifferenceKg: r.WeightDifferenceKg.StringFixed(2),
+		Reasons:            r.Reasons,
+	}
+}
+
+func (h *Handler) CreateManifest(
+	ctx context.Context,
+	input *manifest.CreateManifestInput,
+) (*manifest.ManifestOutput, error) {
+	pickupDate, err := time.Parse(time.DateOnly, input.Body.PickupDate)
+	if err != nil {
+		return nil, huma.Error400BadRequest("pickup_date must be formatted as YYYY-MM-DD")
+	}
+
+	m, err := h.manifestService.Create(ctx, input.ID, pickupDate)
+	if err != nil {
+		switch {
+		case errors.Is(err, carrier.ErrCarrierNotFound):
+			return nil, huma.Error404NotFound("carrier not found")
+		case errors.Is(err, manifest.ErrEmptyManifest):
+			return nil, huma.Error422UnprocessableEntity("no contracts awaiting pickup for the carrier")
+		case errors.Is(err, manifest.ErrManifestConflict):
+			return nil, huma.Error409Conflict("contracts were added to another manifest, try again")
+		}
+		return nil, huma.Error500InternalServerError("failed to create manifest", err)
+	}
+
+	log.L().
+		Info("Manifest created", log.String("manifest_id", m.ID.String()), log.Int("packages", len(m.Items)))
+	return &manifest.ManifestOutput{
+		Body:   toManifestResponse(*m),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) GetManifest(
+	ctx context.Context,
+	input *manifest.GetManifestInput,
+) (*manifest.ManifestOutput, error) {
+	m, err := h.manifestService.Get(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, manifest.ErrManifestNotFound) {
+			return nil, huma.Error404NotFound("manifest not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get manifest", err)
+	}
+
+	return &manifest.ManifestOutput{
+		Body:   toManifestResponse(*m),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) ListManifests(
+	ctx context.Context,
+	input *manifest.ListManifestsInput,
+) (*manifest.ListManifestsOutput, error) {
+	var pickupDate *time.Time
+	if !input.PickupDate.IsZero() {
+		pickupDate = &input.PickupDate
+	}
+
+	manifests, err := h.manifestService.ListByCarrier(ctx, input.ID, pickupDate)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to list manifests", err)
+	}
+
+	response := make([]manifest.ManifestSummaryResponse, len(manifests))
+	for i, m := range manifests {
+		response[i] = manifest.ManifestSummaryResponse{
+			ID:          m.ID,
+			CarrierID:   m.CarrierID,
+			PickupDate:  m.PickupDate.Format(time.DateOnly),
+			Status:      string(m.Status),
+			CreatedAt:   m.CreatedAt,
+			ConfirmedAt: m.ConfirmedAt,
+			CancelledAt: m.CancelledAt,
+		}
+	}
+
+	return &manifest.ListManifestsOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) ExportManifest(
+	ctx context.Context,
+	input *manifest.ExportManifestInput,
+) (*manifest.ExportManifestOutput, error) {
+	format, ok := manifest.Formats[input.Format]
+	if !ok {
+		return nil, huma.Error400BadRequest("invalid manifest format")
+	}
+
+	export, err := h.manifestService.Export(ctx, input.ID, format)
+	if err != nil {
+		if errors.Is(err, manifest.ErrManifestNotFound) {
+			return nil, huma.Error404NotFound("manifest not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to export manifest", err)
+	}
+
+	return &manifest.ExportManifestOutput{
+		ContentType:        export.ContentType,
+		ContentDisposition: `attachment; filename="` + export.Filename + `"`,
+		Body:               export.Content,
+	}, nil
+}
+
+func (h *Handler) ConfirmManifest(
+	ctx context.Context,
+	input *manifest.GetManifestInput,
+) (*manifest.ManifestOutput, error) {
+	m, err := h.manifestService.Confirm(ctx, input.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, manifest.ErrManifestNotFound):
+			return nil, huma.Error404NotFound("manifest not found")
+		case errors.Is(err, manifest.ErrManifestNotOpen):
+			return nil, huma.Error409Conflict("manifest is not open")
+		case errors.Is(err, manifest.ErrManifestOutdated):
+			return nil, huma.Error409Conflict("manifest has orders no longer awaiting pickup, cancel it and create a new one")
+		}
+		return nil, huma.Error500InternalServerError("failed to confirm manifest", err)
+	}
+
+	log.L().
+		Info("Manifest confirmed", log.String("manifest_id", m.ID.String()), log.Int("packages", len(m.Items)))
+	return &manifest.ManifestOutput{
+		Body:   toManifestResponse(*m),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) CancelManifest(
+	ctx context.Context,
+	input *manifest.GetManifestInput,
+) (*manifest.ManifestOutput, error) {
+	m, err := h.manifestService.Cancel(ctx, input.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, manifest.ErrManifestNotFound):
+			return nil, huma.Error404NotFound("manifest not found")
+		case errors.Is(err, manifest.ErrManifestNotOpen):
+			return nil, huma.Error409Conflict("manifest is not open")
+		}
+		return nil, huma.Error500InternalServerError("failed to cancel manifest", err)
+	}
+
+	log.L().Info("Manifest cancelled", log.String("manifest_id", m.ID.String()))
+	return &manifest.ManifestOutput{
+		Body:   toManifestResponse(*m),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toManifestResponse(m manifest.Manifest) manifest.ManifestResponse {
+	items := make([]manifest.ManifestItemResponse, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = manifest.ManifestItemResponse{
+			ContractID:    item.ContractID,
+			OrderID:       item.OrderID,
+			TrackingCode:  item.TrackingCode,
+			Product:       item.Product,
+			WeightKg:      item.WeightKg.StringFixed(2),
+			DestinationUF: item.DestinationUF,
+		}
+	}
+
+	return manifest.ManifestResponse{
+		ID:            m.ID,
+		CarrierID:     m.CarrierID,
+		CarrierName:   m.CarrierName,
+		PickupDate:    m.PickupDate.Format(time.DateOnly),
+		Status:        string(m.Status),
+		Packages:      len(m.Items),
+		TotalWeightKg: m.TotalWeightKg().StringFixed(2),
+		Items:         items,
+		CreatedAt:     m.CreatedAt,
+		ConfirmedAt:   m.ConfirmedAt,
+		CancelledAt:   m.CancelledAt,
+	}
+}
+
+func toContractOutputBody(contract shipping.Contract) shipping.ContractCarrierOutputBody {
+	var quoteID *string
+	if contract.QuoteID != nil {
+		id := contract.QuoteID.String()
+		quoteID = &id
+	}
+
+	var replacedBy *string
+	if contract.ReplacedBy != nil {
+		id := contract.ReplacedBy.String()
+		replacedBy = &id
+	}
+
+	var loadID *string
+	if contract.LoadID != nil {
+		id := contract.LoadID.String()
+		loadID = &id
+	}
+
+	return shipping.ContractCarrierOutputBody{
+		ID:                      contract.ID.String(),
+		OrderID:                 contract.OrderID.String(),
+		CarrierID:               contract.CarrierID.String(),
+		Price:                   contract.Price.StringFixed(2),
+		EstimatedDays:           contract.EstimatedDays,
+		Status:                  string(contract.Status),
+		QuoteID:                 quoteID,
+		TrackingCode:            contract.TrackingCode,
+		BookingStatus:           string(contract.BookingStatus),
+		BookingError:            contract.BookingError,
+		CancelReason:            contract.CancelReason,
+		CancelledAt:             contract.CancelledAt,
+		ReplacedBy:              replacedBy,
+		LoadID:                  loadID,
+		ContractedAt:            contract.ContractedAt,
+		CreatedAt:               contract.CreatedAt,
+		UpdatedAt:               contract.UpdatedAt,
+		FuelSurchargePercentage: contract.FuelSurchargePercentage.StringFixed(2),
+		FuelSurcharge:           contract.FuelSurcharge.StringFixed(2),
+		AdValorem:               contract.AdValorem.StringFixed(2),
+		GRIS:                    contract.GRIS.StringFixed(2),
+		Toll:                    contract.Toll.StringFixed(2),
+		TDE:                     contract.TDE.StringFixed(2),
+		Discount:                contract.Discount.StringFixed(2),
+		ICMSRate:                contract.ICMSRate.StringFixed(2),
+		ICMS:                    contract.ICMS.StringFixed(2),
+		TotalPrice:              contract.TotalPrice().StringFixed(2),
+		Breakdown:               toPriceLinesOutput(contract.Breakdown),
+		Explanation:             toPriceExplanationOutput(contract.Explanation),
+	}
+}
+
+func (h *Handler) CreatePricingRule(
+	ctx context.Context,
+	input *shipping.CreatePricingRuleInput,
+) (*shipping.PricingRuleResponseOutput, error) {
+	kind, ok := shipping.PricingRuleKinds[input.Body.Kind]
+	if !ok {
+		return nil, huma.Error400BadRequest("invalid pricing rule kind")
+	}
+
+	if input.Body.Value < 0 {
+		return nil, huma.Error400BadRequest("value must not be negative")
+	}
+
+	if kind == shipping.PricingRuleRounding && input.Body.Value == 0 {
+		return nil, huma.Error400BadRequest("rounding increment must be greater than zero")
+	}
+
+	created, err := h.shippingService.CreatePricingRule(ctx, &shipping.PricingRule{
+		CarrierID: input.Body.CarrierID,
+		Name:      input.Body.Name,
+		Kind:      kind,
+		Value:     decimal.NewFromFloat(input.Body.Value),
+		Position:  input.Body.Position,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		if errors.Is(err, carrier.ErrCarrierNotFound) {
+			return nil, huma.Error404NotFound("carrier not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to create pricing rule", err)
+	}
+
+	log.L().Info("Created pricing rule", log.String("pricing_rule_id", created.ID.String()))
+	return &shipping.PricingRuleResponseOutput{
+		Body:   toPricingRuleResponse(*created),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) ListPricingRules(
+	ctx context.Context,
+	_ *struct{},
+) (*shipping.ListPricingRulesOutput, error) {
+	rules, err := h.shippingService.ListPricingRules(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to list pricing rules", err)
+	}
+
+	response := make([]shipping.PricingRuleResponse, len(rules))
+	for i, r := range rules {
+		response[i] = toPricingRuleResponse(r)
+	}
+
+	return &shipping.ListPricingRulesOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toPricingRuleResponse(r shipping.PricingRule) shipping.PricingRuleResponse {
+	return shipping.PricingRuleResponse{
+		ID:        r.ID,
+		CarrierID: r.CarrierID,
+		Name:      r.Name,
+		Kind:      string(r.Kind),
+		Value:     r.Value.StringFixed(2),
+		Position:  r.Position,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (h *Handler) PlanLoad(
+	ctx context.Context,
+	input *shipping.PlanLoadInput,
+) (*shipping.LoadOutput, error) {
+	load, err := h.shippingService.PlanLoad(ctx, shipping.LoadRequest{
+		Region:    input.Body.Region,
+		CarrierID: input.Body.CarrierID,
+		OrderIDs:  input.Body.OrderIDs,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, shipping.ErrInvalidRegion):
+			return nil, huma.Error400BadRequest("invalid region")
+		case errors.Is(err, order.ErrOrderNotFound):
+			return nil, huma.Error404NotFound("order not found")
+		case errors.Is(err, shipping.ErrOrderNotLoadable):
+			return nil, huma.Error400BadRequest("only created orders can be loaded")
+		case errors.Is(err, shipping.ErrLoadRegionMismatch):
+			return nil, huma.Error400BadRequest("order destination is outside the load region")
+		case errors.Is(err, shipping.ErrOrderAlreadyLoaded):
+			return nil, huma.Error409Conflict("order already belongs to a planned load")
+		case errors.Is(err, shipping.ErrLoadTooSmall):
+			return nil, huma.Error422UnprocessableEntity("a load needs at least two eligible orders")
+		case errors.Is(err, shipping.ErrNoValidPolicy):
+			return nil, huma.Error400BadRequest("no valid policy for the carrier in the load region")
+		}
+		return nil, huma.Error500InternalServerError("failed to plan load", err)
+	}
+
+	log.L().Info("Load planned", log.String("load_id", load.ID.String()))
+	return &shipping.LoadOutput{
+		Body:   toLoadResponse(*load),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) GetLoad(
+	ctx context.Context,
+	input *shipping.LoadInput,
+) (*shipping.LoadOutput, error) {
+	load, err := h.shippingService.GetLoad(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, shipping.ErrLoadNotFound) {
+			return nil, huma.Error404NotFound("load not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get load", err)
+	}
+
+	return &shipping.LoadOutput{
+		Body:   toLoadResponse(*load),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) ContractLoad(
+	ctx context.Context,
+	input *shipping.LoadInput,
+) (*shipping.LoadOutput, error) {
+	load, err := h.shippingService.ContractLoad(ctx, input.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, shipping.ErrLoadNotFound):
+			return nil, huma.Error404NotFound("load not found")
+		case errors.Is(err, shipping.ErrLoadNotPlanned):
+			return nil, huma.Error409Conflict("load is not planned")
+		case errors.Is(err, shipping.ErrLoadExpired):
+			return nil, huma.Error410Gone("load price expired")
+		case errors.Is(err, shipping.ErrLoadOutdated):
+			return nil, huma.Error409Conflict("load orders changed since it was planned")
+		case errors.Is(err, shipping.ErrContractAlreadyExists):
+			return nil, huma.Error409Conflict("an order of the load already has an active contract")
+		}
+		return nil, huma.Error500InternalServerError("failed to contract load", err)
+	}
+
+	log.L().Info("Load contracted", log.String("load_id", load.ID.String()))
+	return &shipping.LoadOutput{
+		Body:   toLoadResponse(*load),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) CancelLoad(
+	ctx context.Context,
+	input *shipping.LoadInput,
+) (*shipping.LoadOutput, error) {
+	load, err := h.shippingService.CancelLoad(ctx, input.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, shipping.ErrLoadNotFound):
+			return nil, huma.Error404NotFound("load not found")
+		case errors.Is(err, shipping.ErrLoadNotPlanned):
+			return nil, huma.Error409Conflict("load is not planned")
+		}
+		return nil, huma.Error500InternalServerError("failed to cancel load", err)
+	}
+
+	return &shipping.LoadOutput{
+		Body:   toLoadResponse(*load),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toLoadResponse(load shipping.Load) shipping.LoadResponse {
+	orders := make([]shipping.LoadOrderResponse, len(load.Orders))
+	for i, o := range load.Orders {
+		var contractID *string
+		if o.ContractID != nil {
+			id := o.ContractID.String()
+			contractID = &id
+		}
+
+		orders[i] = shipping.LoadOrderResponse{
+			OrderID:       o.OrderID.String(),
+			DestinationUF: o.DestinationUF.Sigla,
+			WeightKg:      o.WeightKg.StringFixed(2),
+			Price:         o.Share.Price.StringFixed(2),
+			ICMSRate:      o.Share.ICMSRate.StringFixed(2),
+			ICMS:          o.Share.ICMS.StringFixed(2),
+			TotalPrice:    o.Share.Price.Add(o.Share.ICMS).StringFixed(2),
+			ContractID:    contractID,
+			Breakdown:     toPriceLinesOutput(o.Share.Breakdown),
+			Explanation:   toPriceExplanationOutput(o.Share.Explanation),
+		}
+	}
+
+	return shipping.LoadResponse{
+		ID:              load.ID.String(),
+		CarrierID:       load.CarrierID.String(),
+		CarrierName:     load.CarrierName,
+		Region:          load.Region,
+		DestinationUF:   load.DestinationUF.Sigla,
+		Status:          string(load.Status),
+		WeightKg:        load.WeightKg.StringFixed(2),
+		DeclaredValue:   load.DeclaredValue.StringFixed(2),
+		EstimatedDays:   load.EstimatedDays,
+		Price:           load.Price.StringFixed(2),
+		ICMS:            load.ICMS.StringFixed(2),
+		TotalPrice:      load.TotalPrice().StringFixed(2),
+		IndividualPrice: load.IndividualPrice.StringFixed(2),
+		Savings:         load.Savings().StringFixed(2),
+		Breakdown:       toPriceLinesOutput(load.Breakdown),
+		Explanation:     toPriceExplanationOutput(load.Explanation),
+		Orders:          orders,
+		ExpiresAt:       load.ExpiresAt,
+		ContractedAt:    load.ContractedAt,
+		CancelledAt:     load.CancelledAt,
+		CreatedAt:       load.CreatedAt,
+		UpdatedAt:       load.UpdatedAt,
+	}
+}
+
+func (h *Handler) CreateWebhookSubscription(
+	ctx context.Context,
+	input *subscription.CreateInput,
+) (*subscription.CreateOutput, error) {
+	created, err := h.subscriptionService.Create(ctx, input.Body.URL, input.Body.EventTypes, input.Body.Secret)
+	if err != nil {
+		switch {
+		case errors.Is(err, subscription.ErrInvalidURL),
+			errors.Is(err, subscription.ErrNoEventTypes),
+			errors.Is(err, subscription.ErrUnknownEventType):
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		return nil, huma.Error500InternalServerError("failed to create webhook subscription", err)
+	}
+
+	log.L().
+		Info("Created webhook subscription", log.String("subscription_id", created.ID.String()))
+	return &subscription.CreateOutput{
+		Body: subscription.CreateOutputBody{
+			SubscriptionResponse: toSubscriptionResponse(*created),
+			Secret:               created.Secret,
+		},
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (h *Handler) ListWebhookSubscriptions(
+	ctx context.Context,
+	_ *struct{},
+) (*subscription.ListOutput, error) {
+	subscriptions, err := h.subscriptionService.List(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to list webhook subscriptions", err)
+	}
+
+	response := make([]subscription.SubscriptionResponse, len(subscriptions))
+	for i, s := range subscriptions {
+		response[i] = toSubscriptionResponse(s)
+	}
+
+	return &subscription.ListOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) GetWebhookSubscription(
+	ctx context.Context,
+	input *subscription.SubscriptionInput,
+) (*subscription.SubscriptionOutput, error) {
+	s, err := h.subscriptionService.Get(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, subscription.ErrSubscriptionNotFound) {
+			return nil, huma.Error404NotFound("webhook subscription not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get webhook subscription", err)
+	}
+
+	return &subscription.SubscriptionOutput{
+		Body:   toSubscriptionResponse(*s),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) DeleteWebhookSubscription(
+	ctx context.Context,
+	input *subscription.SubscriptionInput,
+) (*subscription.DeleteOutput, error) {
+	if err := h.subscriptionService.Delete(ctx, input.ID); err != nil {
+		if errors.Is(err, subscription.ErrSubscriptionNotFound) {
+			return nil, huma.Error404NotFound("webhook subscription not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to delete webhook subscription", err)
+	}
+
+	log.L().
+		Info("Deleted webhook subscription", log.String("subscription_id", input.ID.String()))
+	return &subscription.DeleteOutput{Status: http.StatusNoContent}, nil
+}
+
+func (h *Handler) ListWebhookDeliveries(
+	ctx context.Context,
+	input *subscription.ListDeliveriesInput,
+) (*subscription.ListDeliveriesOutput, error) {
+	filter := subscription.DeliveryFilter{
+		SubscriptionID: input.ID,
+		Limit:          input.Limit,
+		Offset:         input.Offset,
+	}
+	if input.Status != "" {
+		status, ok := subscription.DeliveryStatusValues[input.Status]
+		if !ok {
+			return nil, huma.Error400BadRequest("invalid status")
+		}
+		filter.Status = &status
+	}
+
+	deliveries, err := h.subscriptionService.ListDeliveries(ctx, filter)
+	if err != nil {
+		if errors.Is(err, subscription.ErrSubscriptionNotFound) {
+			return nil, huma.Error404NotFound("webhook subscription not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to list webhook deliveries", err)
+	}
+
+	response := make([]subscription.DeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		response[i] = toDeliveryResponse(d)
+	}
+
+	return &subscription.ListDeliveriesOutput{
+		Body:   response,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) GetWebhookDelivery(
+	ctx context.Context,
+	input *subscription.DeliveryInput,
+) (*subscription.DeliveryOutput, error) {
+	delivery, err := h.subscriptionService.GetDelivery(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, subscription.ErrDeliveryNotFound) {
+			return nil, huma.Error404NotFound("webhook delivery not found")
+		}
+		return nil, huma.Error500InternalServerError("failed to get webhook delivery", err)
+	}
+
+	return &subscription.DeliveryOutput{
+		Body:   toDeliveryResponse(*delivery),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *Handler) RedeliverWebhook(
+	ctx context.Context,
+	input *subscription.DeliveryInput,
+) (*subscription.DeliveryOutput, error) {
+	delivery, err := h.subscriptionService.Redeliver(ctx, input.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, subscription.ErrDeliveryNotFound):
+			return nil, huma.Error404NotFound("webhook delivery not found")
+		case errors.Is(err, subscription.ErrInactive):
+			return nil, huma.Error409Conflict("webhook subscription is no longer active")
+		case errors.Is(err, subscription.ErrDeliveryConflict):
+			return nil, huma.Error409Conflict("webhook delivery is being retried, try again later")
+		}
+		return nil, huma.Error500InternalServerError("failed to redeliver webhook", err)
+	}
+
+	log.L().
+		Info("Redelivered webhook", log.String("delivery_id", delivery.ID.String()), log.String("status", string(delivery.Status)))
+	return &subscription.DeliveryOutput{
+		Body:   toDeliveryResponse(*delivery),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func toSubscriptionResponse(s subscription.Subscription) subscription.SubscriptionResponse {
+	eventTypes := make([]string, len(s.EventTypes))
+	for i, t := range s.EventTypes {
+		eventTypes[i] = string(t)
+	}
+
+	return subscription.SubscriptionResponse{
+		ID:         s.ID.String(),
+		URL:        s.URL,
+		EventTypes: eventTypes,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func toDeliveryResponse(d subscription.Delivery) subscription.DeliveryResponse {
+	var nextAttemptAt *time.Time
+	if d.Status == subscription.DeliveryStatusPending {
+		nextAttemptAt = &d.NextAttemptAt
+	}
+
+	attempts := make([]subscription.AttemptResponse, len(d.Log))
+	for i, a := range d.Log {
+		attempts[i] = subscription.AttemptResponse{
+			Number:      a.Number,
+			StatusCode:  a.StatusCode,
+			Error:       a.Error,
+			DurationMs:  a.Duration.Milliseconds(),
+			AttemptedAt: a.AttemptedAt,
+		}
+	}
+
+	return subscription.DeliveryResponse{
+		ID:             d.ID.String(),
+		SubscriptionID: d.SubscriptionID.String(),
+		EventID:        d.EventID.String(),
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  nextAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		Log:            attempts,
+	}
+}
@@ -1,21 +1,44 @@
 package server_test
 
 import (
+	"bufio"
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/danielgtaylor/huma/v2"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/victorvcruz/shipment-coordinator/cmd/server"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
 	carriermock "github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	webhookmock "github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+	invoicemock "github.com/victorvcruz/shipment-coordinator/internal/invoice/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/label"
+	labelmock "github.com/victorvcruz/shipment-coordinator/internal/label/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest"
+	manifestmock "github.com/victorvcruz/shipment-coordinator/internal/manifest/mocks"
 	"github.com/victorvcruz/shipment-coordinator/internal/order"
 	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
 	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
 	shippingmock "github.com/victorvcruz/shipment-coordinator/internal/shipping/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/stream"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription"
+	subscriptionmock "github.com/victorvcruz/shipment-coordinator/internal/subscription/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	trackingmock "github.com/victorvcruz/shipment-coordinator/internal/tracking/mocks"
 	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 )
 
 func TestHandler_CreateOrder_Success(t *testing.T) {
@@ -25,7 +48,12 @@ func TestHandler_CreateOrder_Success(t *testing.T) {
 			return o, nil
 		},
 	}
-	h := server.NewHandler(orderSvc, nil, nil)
+	shippingSvc := &shippingmock.ServiceMock{
+		AutoContractFunc: func(ctx context.Context, orderID uuid.UUID, requested *bool) (*shipping.Contract, error) {
+			return nil, nil
+		},
+	}
+	h := server.NewHandler(orderSvc, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
 	input := &order.CreateOrderInput{
 		Body: order.CreateOrderInputBody{
 			Product:       "Test",
@@ -40,8 +68,38 @@ func TestHandler_CreateOrder_Success(t *testing.T) {
 	assert.Equal(t, order.StatusCreated, resp.Body.Status)
 }
 
+func TestHandler_CreateOrder_AutoContract(t *testing.T) {
+	contractID := uuid.New()
+	orderSvc := &ordermock.ServiceMock{
+		CreateFunc: func(ctx context.Context, o *order.Order) (*order.Order, error) {
+			o.ID = uuid.New()
+			return o, nil
+		},
+	}
+	shippingSvc := &shippingmock.ServiceMock{
+		AutoContractFunc: func(ctx context.Context, orderID uuid.UUID, requested *bool) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: contractID, OrderID: orderID, UpdatedAt: time.Now().UTC()}, nil
+		},
+	}
+	h := server.NewHandler(orderSvc, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	autoContract := true
+	input := &order.CreateOrderInput{
+		Body: order.CreateOrderInputBody{
+			Product:       "Test",
+			WeightKg:      2.5,
+			DestinationUF: "SP",
+			AutoContract:  &autoContract,
+		},
+	}
+	resp, err := h.CreateOrder(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, order.StatusAwaitingPickup, resp.Body.Status)
+	assert.Equal(t, &contractID, resp.Body.ContractID)
+	assert.Equal(t, &autoContract, shippingSvc.AutoContractCalls()[0].Requested)
+}
+
 func TestHandler_CreateOrder_InvalidUF(t *testing.T) {
-	h := server.NewHandler(nil, nil, nil)
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	input := &order.CreateOrderInput{
 		Body: order.CreateOrderInputBody{
 			Product:       "Test",
@@ -67,7 +125,7 @@ func TestHandler_GetOrder_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := server.NewHandler(orderSvc, nil, nil)
+	h := server.NewHandler(orderSvc, nil, nil, nil, nil, nil, nil, nil, nil)
 	input := &order.GetOrderParams{ID: id}
 	resp, err := h.GetOrder(context.Background(), input)
 	assert.NoError(t, err)
@@ -80,7 +138,7 @@ func TestHandler_GetOrder_NotFound(t *testing.T) {
 			return nil, order.ErrOrderNotFound
 		},
 	}
-	h := server.NewHandler(orderSvc, nil, nil)
+	h := server.NewHandler(orderSvc, nil, nil, nil, nil, nil, nil, nil, nil)
 	input := &order.GetOrderParams{ID: uuid.New()}
 	resp, err := h.GetOrder(context.Background(), input)
 	assert.Nil(t, resp)
@@ -94,7 +152,7 @@ func TestHandler_UpdateOrderStatus_Success(t *testing.T) {
 			return nil
 		},
 	}
-	h := server.NewHandler(orderSvc, nil, nil)
+	h := server.NewHandler(orderSvc, nil, nil, nil, nil, nil, nil, nil, nil)
 	input := &order.UpdateOrderStatusInput{
 		ID: id,
 		Body: order.UpdateOrderStatusInputBody{
@@ -107,7 +165,7 @@ func TestHandler_UpdateOrderStatus_Success(t *testing.T) {
 }
 
 func TestHandler_UpdateOrderStatus_InvalidStatus(t *testing.T) {
-	h := server.NewHandler(nil, nil, nil)
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	input := &order.UpdateOrderStatusInput{
 		ID: uuid.New(),
 		Body: order.UpdateOrderStatusInputBody{
@@ -125,7 +183,7 @@ func TestHandler_UpdateOrderStatus_AlreadySet(t *testing.T) {
 			return order.ErrStatusAlreadySet
 		},
 	}
-	h := server.NewHandler(orderSvc, nil, nil)
+	h := server.NewHandler(orderSvc, nil, nil, nil, nil, nil, nil, nil, nil)
 	input := &order.UpdateOrderStatusInput{
 		ID: uuid.New(),
 		Body: order.UpdateOrderStatusInputBody{
@@ -144,7 +202,7 @@ func TestHandler_CreateCarrier_Success(t *testing.T) {
 			return c, nil
 		},
 	}
-	h := server.NewHandler(nil, carrierSvc, nil)
+	h := server.NewHandler(nil, carrierSvc, nil, nil, nil, nil, nil, nil, nil)
 	input := &carrier.CreateCarrierInput{
 		Body: carrier.CreateCarrierInputBody{
 			Name: "Carrier1",
@@ -160,7 +218,7 @@ func TestHandler_CreateCarrier_Success(t *testing.T) {
 }
 
 func TestHandler_CreateCarrier_InvalidRegion(t *testing.T) {
-	h := server.NewHandler(nil, &carriermock.ServiceMock{}, nil)
+	h := server.NewHandler(nil, &carriermock.ServiceMock{}, nil, nil, nil, nil, nil, nil, nil)
 	input := &carrier.CreateCarrierInput{
 		Body: carrier.CreateCarrierInputBody{
 			Name: "Carrier1",
@@ -174,10 +232,183 @@ func TestHandler_CreateCarrier_InvalidRegion(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestHandler_CreateCarrier_TDEOutsideRegion(t *testing.T) {
+	h := server.NewHandler(nil, &carriermock.ServiceMock{}, nil, nil, nil, nil, nil, nil, nil)
+	input := &carrier.CreateCarrierInput{
+		Body: carrier.CreateCarrierInputBody{
+			Name: "Carrier1",
+			Policies: []carrier.CarrierPolicyInput{
+				{
+					Region:        "Norte",
+					EstimatedDays: 8,
+					PricePerKg:    10.00,
+					TDEPercentage: 20,
+					TDEUFs:        []string{"SP"},
+				},
+			},
+		},
+	}
+	resp, err := h.CreateCarrier(context.Background(), input)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_GetCoverageByState_Success(t *testing.T) {
+	carrierSvc := &carriermock.ServiceMock{
+		ListByStateFunc: func(ctx context.Context, state states.State) ([]carrier.Carrier, error) {
+			return []carrier.Carrier{
+				{
+					ID:   uuid.New(),
+					Name: "CarrierN",
+					Policies: []carrier.Policy{
+						{Region: states.Norte, EstimatedDays: 12, PricePerKg: decimal.NewFromFloat(9.5)},
+					},
+				},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, carrierSvc, nil, nil, nil, nil, nil, nil, nil)
+	resp, err := h.GetCoverageByState(context.Background(), &carrier.GetCoverageByStateInput{UF: "rr"})
+	assert.NoError(t, err)
+	assert.Equal(t, "RR", resp.Body.UF)
+	assert.Equal(t, "Norte", resp.Body.Region)
+	assert.Len(t, resp.Body.Carriers, 1)
+	assert.Equal(t, "9.50", resp.Body.Carriers[0].PricePerKg)
+}
+
+func TestHandler_GetCoverageByState_InvalidUF(t *testing.T) {
+	h := server.NewHandler(nil, &carriermock.ServiceMock{}, nil, nil, nil, nil, nil, nil, nil)
+	resp, err := h.GetCoverageByState(context.Background(), &carrier.GetCoverageByStateInput{UF: "XX"})
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_GetCarrierCoverage_NotFound(t *testing.T) {
+	carrierSvc := &carriermock.ServiceMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return nil, carrier.ErrCarrierNotFound
+		},
+	}
+	h := server.NewHandler(nil, carrierSvc, nil, nil, nil, nil, nil, nil, nil)
+	resp, err := h.GetCarrierCoverage(context.Background(), &carrier.GetCarrierCoverageInput{ID: uuid.New()})
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_CreateFuelSurcharge_Success(t *testing.T) {
+	carrierSvc := &carriermock.ServiceMock{
+		CreateFuelSurchargeFunc: func(ctx context.Context, s *carrier.FuelSurcharge) (*carrier.FuelSurcharge, error) {
+			s.ID = uuid.New()
+			return s, nil
+		},
+	}
+	h := server.NewHandler(nil, carrierSvc, nil, nil, nil, nil, nil, nil, nil)
+	input := &carrier.CreateFuelSurchargeInput{
+		Body: carrier.CreateFuelSurchargeInputBody{
+			Percentage:    12.5,
+			EffectiveFrom: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	resp, err := h.CreateFuelSurcharge(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Equal(t, "12.50", resp.Body.Percentage)
+	assert.Nil(t, resp.Body.CarrierID)
+}
+
+func TestHandler_CreateFuelSurcharge_NegativePercentage(t *testing.T) {
+	h := server.NewHandler(nil, &carriermock.ServiceMock{}, nil, nil, nil, nil, nil, nil, nil)
+	input := &carrier.CreateFuelSurchargeInput{
+		Body: carrier.CreateFuelSurchargeInputBody{
+			Percentage:    -1,
+			EffectiveFrom: time.Now().UTC(),
+		},
+	}
+	resp, err := h.CreateFuelSurcharge(context.Background(), input)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_CreateCarrierDiscount_Success(t *testing.T) {
+	carrierSvc := &carriermock.ServiceMock{
+		CreateDiscountFunc: func(ctx context.Context, d *carrier.Discount) (*carrier.Discount, error) {
+			d.ID = uuid.New()
+			return d, nil
+		},
+	}
+	h := server.NewHandler(nil, carrierSvc, nil, nil, nil, nil, nil, nil, nil)
+	input := &carrier.CreateDiscountInput{
+		ID: uuid.New(),
+		Body: carrier.CreateDiscountInputBody{
+			Name:             "Q3 volume agreement",
+			Percentage:       7.5,
+			Region:           "Sudeste",
+			MinMonthlyVolume: 50,
+			ValidFrom:        time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	resp, err := h.CreateCarrierDiscount(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Equal(t, input.ID, resp.Body.CarrierID)
+	assert.Equal(t, "7.50", resp.Body.Percentage)
+	assert.Equal(t, "Sudeste", resp.Body.Region)
+	assert.Equal(t, 50, resp.Body.MinMonthlyVolume)
+}
+
+func TestHandler_CreateCarrierDiscount_InvalidPeriod(t *testing.T) {
+	h := server.NewHandler(nil, &carriermock.ServiceMock{}, nil, nil, nil, nil, nil, nil, nil)
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	until := from.Add(-time.Hour)
+	input := &carrier.CreateDiscountInput{
+		ID: uuid.New(),
+		Body: carrier.CreateDiscountInputBody{
+			Name:       "Q3 volume agreement",
+			Percentage: 7.5,
+			ValidFrom:  from,
+			ValidUntil: &until,
+		},
+	}
+	resp, err := h.CreateCarrierDiscount(context.Background(), input)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_CreatePricingRule_Success(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		CreatePricingRuleFunc: func(ctx context.Context, rule *shipping.PricingRule) (*shipping.PricingRule, error) {
+			rule.ID = uuid.New()
+			return rule, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.CreatePricingRuleInput{
+		Body: shipping.CreatePricingRuleInputBody{
+			Kind:  "minimum_price",
+			Value: 25,
+		},
+	}
+	resp, err := h.CreatePricingRule(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Equal(t, "minimum_price", resp.Body.Kind)
+	assert.Equal(t, "25.00", resp.Body.Value)
+}
+
+func TestHandler_CreatePricingRule_ZeroRounding(t *testing.T) {
+	h := server.NewHandler(nil, nil, &shippingmock.ServiceMock{}, nil, nil, nil, nil, nil, nil)
+	input := &shipping.CreatePricingRuleInput{
+		Body: shipping.CreatePricingRuleInputBody{Kind: "rounding"},
+	}
+	resp, err := h.CreatePricingRule(context.Background(), input)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
 func TestHandler_GetQuotes_Success(t *testing.T) {
 	orderID := uuid.New()
 	shippingSvc := &shippingmock.ServiceMock{
-		QuoteAllFunc: func(ctx context.Context, id uuid.UUID) ([]*shipping.Quote, error) {
+		QuoteAllFunc: func(ctx context.Context, id uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.Quote, error) {
 			return []*shipping.Quote{
 				{
 					CarrierID:     uuid.New(),
@@ -188,7 +419,7 @@ func TestHandler_GetQuotes_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := server.NewHandler(nil, nil, shippingSvc)
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
 	input := &shipping.GetQuotesInput{OrderID: orderID.String()}
 	resp, err := h.GetQuotes(context.Background(), input)
 	assert.NoError(t, err)
@@ -197,16 +428,55 @@ func TestHandler_GetQuotes_Success(t *testing.T) {
 }
 
 func TestHandler_GetQuotes_InvalidID(t *testing.T) {
-	h := server.NewHandler(nil, nil, &shippingmock.ServiceMock{})
+	h := server.NewHandler(nil, nil, &shippingmock.ServiceMock{}, nil, nil, nil, nil, nil, nil)
 	input := &shipping.GetQuotesInput{OrderID: "invalid-uuid"}
 	resp, err := h.GetQuotes(context.Background(), input)
 	assert.Nil(t, resp)
 	assert.NotNil(t, err)
 }
 
+func TestHandler_GetQuotes_InvalidSort(t *testing.T) {
+	h := server.NewHandler(nil, nil, &shippingmock.ServiceMock{}, nil, nil, nil, nil, nil, nil)
+	input := &shipping.GetQuotesInput{OrderID: uuid.New().String(), Sort: "random"}
+	resp, err := h.GetQuotes(context.Background(), input)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_GetBatchQuotes_Success(t *testing.T) {
+	quotedID := uuid.New()
+	missingID := uuid.New()
+	shippingSvc := &shippingmock.ServiceMock{
+		QuoteBatchFunc: func(ctx context.Context, ids []uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.OrderQuotes, error) {
+			assert.Equal(t, shipping.RankingFastest, strategy)
+			return []*shipping.OrderQuotes{
+				{
+					OrderID: quotedID,
+					Quotes: []*shipping.Quote{
+						{CarrierID: uuid.New(), CarrierName: "CarrierX", Price: decimal.NewFromFloat(20)},
+					},
+				},
+				{OrderID: missingID, Err: order.ErrOrderNotFound},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.BatchQuotesInput{
+		Sort: "fastest",
+		Body: shipping.BatchQuotesInputBody{OrderIDs: []uuid.UUID{quotedID, missingID}},
+	}
+	resp, err := h.GetBatchQuotes(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Body, 2)
+	assert.Equal(t, "CarrierX", resp.Body[0].Quotes[0].CarrierName)
+	assert.Empty(t, resp.Body[0].Error)
+	assert.Equal(t, "order not found", resp.Body[1].Error)
+	assert.Empty(t, resp.Body[1].Quotes)
+}
+
 func TestHandler_ContractCarrier_Success(t *testing.T) {
 	shippingSvc := &shippingmock.ServiceMock{
-		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID) (*shipping.Contract, error) {
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
 			return &shipping.Contract{
 				ID:            uuid.New(),
 				OrderID:       orderID,
@@ -219,7 +489,7 @@ func TestHandler_ContractCarrier_Success(t *testing.T) {
 			}, nil
 		},
 	}
-	h := server.NewHandler(nil, nil, shippingSvc)
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
 	input := &shipping.ContractCarrierInput{
 		Body: shipping.ContractCarrierInputBody{
 			OrderID:   uuid.New(),
@@ -233,11 +503,29 @@ func TestHandler_ContractCarrier_Success(t *testing.T) {
 
 func TestHandler_ContractCarrier_NoValidPolicy(t *testing.T) {
 	shippingSvc := &shippingmock.ServiceMock{
-		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID) (*shipping.Contract, error) {
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
 			return nil, shipping.ErrNoValidPolicy
 		},
 	}
-	h := server.NewHandler(nil, nil, shippingSvc)
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.ContractCarrierInput{
+		Body: shipping.ContractCarrierInputBody{
+			OrderID:   uuid.New(),
+			CarrierID: uuid.New(),
+		},
+	}
+	resp, err := h.ContractCarrier(context.Background(), input)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_ContractCarrier_AlreadyContracted(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
+			return nil, shipping.ErrContractAlreadyExists
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
 	input := &shipping.ContractCarrierInput{
 		Body: shipping.ContractCarrierInputBody{
 			OrderID:   uuid.New(),
@@ -246,5 +534,663 @@ func TestHandler_ContractCarrier_NoValidPolicy(t *testing.T) {
 	}
 	resp, err := h.ContractCarrier(context.Background(), input)
 	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHandler_ContractCarrier_PreviousContractNoLongerActive(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
+			return nil, shipping.ErrContractNotActive
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.ContractCarrierInput{
+		Body: shipping.ContractCarrierInputBody{
+			OrderID:   uuid.New(),
+			CarrierID: uuid.New(),
+		},
+	}
+	resp, err := h.ContractCarrier(context.Background(), input)
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHandler_ContractCarrier_QuoteExpired(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		ContractCarrierFunc: func(ctx context.Context, orderID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
+			return nil, shipping.ErrQuoteExpired
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	quoteID := uuid.New()
+	input := &shipping.ContractCarrierInput{
+		Body: shipping.ContractCarrierInputBody{
+			OrderID:   uuid.New(),
+			CarrierID: uuid.New(),
+			QuoteID:   &quoteID,
+		},
+	}
+	resp, err := h.ContractCarrier(context.Background(), input)
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusGone, statusErr.GetStatus())
+	assert.Equal(t, &quoteID, shippingSvc.ContractCarrierCalls()[0].QuoteID)
+}
+
+func TestHandler_GetContract_NotFound(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		GetContractFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return nil, shipping.ErrContractNotFound
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	resp, err := h.GetContract(context.Background(), &shipping.GetContractInput{ID: uuid.New()})
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_ListContracts_Filters(t *testing.T) {
+	carrierID := uuid.New()
+	shippingSvc := &shippingmock.ServiceMock{
+		ListContractsFunc: func(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error) {
+			return []shipping.Contract{{ID: uuid.New(), CarrierID: carrierID, Status: shipping.ContractStatusVoided}}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	resp, err := h.ListContracts(context.Background(), &shipping.ListContractsInput{
+		CarrierID: carrierID,
+		Status:    "voided",
+		Limit:     10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Body, 1)
+	assert.Equal(t, "voided", resp.Body[0].Status)
+
+	filter := shippingSvc.ListContractsCalls()[0].Filter
+	assert.Equal(t, carrierID, *filter.CarrierID)
+	assert.Nil(t, filter.OrderID)
+	assert.Equal(t, shipping.ContractStatusVoided, *filter.Status)
+	assert.Equal(t, 10, filter.Limit)
+}
+
+func TestHandler_CancelContract_NotActive(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		CancelContractFunc: func(ctx context.Context, id uuid.UUID, reason string) (*shipping.Contract, error) {
+			return nil, shipping.ErrContractNotActive
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	resp, err := h.CancelContract(context.Background(), &shipping.CancelContractInput{
+		ID:   uuid.New(),
+		Body: shipping.CancelContractInputBody{Reason: "duplicate"},
+	})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHandler_AppendTrackingEvent_Success(t *testing.T) {
+	contractID := uuid.New()
+	trackingSvc := &trackingmock.ServiceMock{
+		AppendEventFunc: func(ctx context.Context, id uuid.UUID, e *tracking.Event) (*tracking.Event, error) {
+			e.ID = uuid.New()
+			e.ContractID = id
+			return e, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, trackingSvc, nil, nil, nil, nil, nil)
+	resp, err := h.AppendTrackingEvent(context.Background(), &tracking.AppendEventInput{
+		ID: contractID,
+		Body: tracking.AppendEventInputBody{
+			Code:       "delivered",
+			Location:   "São Paulo - SP",
+			OccurredAt: time.Date(2025, 7, 3, 14, 0, 0, 0, time.UTC),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Equal(t, contractID, resp.Body.ContractID)
+	assert.Equal(t, "delivered", resp.Body.Code)
+}
+
+func TestHandler_GetTrackingTrail_OrderNotFound(t *testing.T) {
+	trackingSvc := &trackingmock.ServiceMock{
+		GetTrailFunc: func(ctx context.Context, id uuid.UUID) (*tracking.Trail, error) {
+			return nil, order.ErrOrderNotFound
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, trackingSvc, nil, nil, nil, nil, nil)
+	resp, err := h.GetTrackingTrail(context.Background(), &tracking.GetTrailInput{ID: uuid.New()})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.GetStatus())
+}
+
+func TestHandler_ReceiveCarrierWebhook_InvalidSignature(t *testing.T) {
+	webhookSvc := &webhookmock.ServiceMock{
+		ReceiveFunc: func(ctx context.Context, id uuid.UUID, s carrierwebhook.Signed) (*carrierwebhook.Delivery, error) {
+			return nil, carrierwebhook.ErrInvalidSignature
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, webhookSvc, nil, nil, nil, nil)
+	resp, err := h.ReceiveCarrierWebhook(context.Background(), &carrierwebhook.ReceiveInput{
+		ID:        uuid.New(),
+		Timestamp: time.Now().Unix(),
+		Nonce:     "nonce-123",
+		Signature: "sha256=00",
+		RawBody:   []byte(`{"events":[]}`),
+	})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusUnauthorized, statusErr.GetStatus())
+}
+
+func TestHandler_ReceiveCarrierWebhook_Accepted(t *testing.T) {
+	webhookSvc := &webhookmock.ServiceMock{
+		ReceiveFunc: func(ctx context.Context, id uuid.UUID, s carrierwebhook.Signed) (*carrierwebhook.Delivery, error) {
+			return &carrierwebhook.Delivery{ID: uuid.New(), CarrierID: id, Accepted: 2}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, webhookSvc, nil, nil, nil, nil)
+	resp, err := h.ReceiveCarrierWebhook(context.Background(), &carrierwebhook.ReceiveInput{
+		ID:        uuid.New(),
+		Timestamp: time.Now().Unix(),
+		Nonce:     "nonce-123",
+		Signature: "sha256=00",
+		RawBody:   []byte(`{"events":[]}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.Status)
+	assert.Equal(t, 2, resp.Body.Accepted)
+	assert.Equal(t, "nonce-123", webhookSvc.ReceiveCalls()[0].Signed.Nonce)
+}
+
+func TestHandler_GetShippingLabel_ZPL(t *testing.T) {
+	contractID := uuid.New()
+	labelSvc := &labelmock.ServiceMock{
+		RenderFunc: func(ctx context.Context, id uuid.UUID, format label.Format) (*label.Label, error) {
+			return &label.Label{
+				Format:      format,
+				ContentType: "application/zpl",
+				Filename:    "label-" + id.String() + ".zpl",
+				Content:     []byte("^XA^XZ\n"),
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, labelSvc, nil, nil, nil)
+	resp, err := h.GetShippingLabel(context.Background(), &label.RenderInput{ID: contractID, Format: "zpl"})
+	assert.NoError(t, err)
+	assert.Equal(t, "application/zpl", resp.ContentType)
+	assert.Equal(t, `inline; filename="label-`+contractID.String()+`.zpl"`, resp.ContentDisposition)
+	assert.Equal(t, []byte("^XA^XZ\n"), resp.Body)
+	assert.Equal(t, label.FormatZPL, labelSvc.RenderCalls()[0].Format)
+}
+
+func TestHandler_GetShippingLabel_MissingTrackingCode(t *testing.T) {
+	labelSvc := &labelmock.ServiceMock{
+		RenderFunc: func(ctx context.Context, id uuid.UUID, format label.Format) (*label.Label, error) {
+			return nil, label.ErrMissingTrackingCode
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, labelSvc, nil, nil, nil)
+	resp, err := h.GetShippingLabel(context.Background(), &label.RenderInput{ID: uuid.New(), Format: "pdf"})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHandler_SetLabelTemplate_Invalid(t *testing.T) {
+	labelSvc := &labelmock.ServiceMock{
+		SetTemplateFunc: func(ctx context.Context, template *label.Template) (*label.Template, error) {
+			return nil, label.ErrInvalidTemplate
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, labelSvc, nil, nil, nil)
+	resp, err := h.SetLabelTemplate(context.Background(), &label.SetTemplateInput{
+		ID:     uuid.New(),
+		Format: "zpl",
+		Body:   label.SetTemplateInputBody{Content: "{{.Unknown"},
+	})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.GetStatus())
+}
+
+func TestHandler_GetLabelTemplate_CarrierNotFound(t *testing.T) {
+	labelSvc := &labelmock.ServiceMock{
+		GetTemplateFunc: func(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error) {
+			return nil, carrier.ErrCarrierNotFound
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, labelSvc, nil, nil, nil)
+	resp, err := h.GetLabelTemplate(context.Background(), &label.TemplateInput{ID: uuid.New(), Format: "zpl"})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.GetStatus())
+}
+
+func TestHandler_ImportCarrierInvoice_CSV(t *testing.T) {
+	invoiceSvc := &invoicemock.ServiceMock{
+		ImportFunc: func(ctx context.Context, inv *invoice.Invoice) (*invoice.Report, error) {
+			inv.ID = uuid.New()
+			return &invoice.Report{
+				Invoice:       *inv,
+				InvoicedTotal: decimal.NewFromFloat(30.4),
+				Unexpected: []invoice.LineResult{
+					{Line: inv.Lines[0], Status: invoice.LineUnexpected, Reasons: []string{"no contract found for the line"}},
+				},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, invoiceSvc, nil, nil)
+	resp, err := h.ImportCarrierInvoice(context.Background(), &invoice.ImportInput{
+		ID:          uuid.New(),
+		Reference:   " FAT-2025-06 ",
+		PeriodFrom:  time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		PeriodTo:    time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		ContentType: "text/csv; charset=utf-8",
+		RawBody:     []byte("tracking_code,amount\nBR1,30.40\n"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Equal(t, "FAT-2025-06", resp.Body.Invoice.Reference)
+	assert.Equal(t, "2025-06-30", resp.Body.Invoice.PeriodTo)
+	assert.Equal(t, "30.40", resp.Body.InvoicedTotal)
+	assert.Equal(t, "BR1", *resp.Body.Unexpected[0].TrackingCode)
+	assert.Empty(t, resp.Body.Matched)
+}
+
+func TestHandler_ImportCarrierInvoice_UnsupportedContentType(t *testing.T) {
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, &invoicemock.ServiceMock{}, nil, nil)
+	resp, err := h.ImportCarrierInvoice(context.Background(), &invoice.ImportInput{
+		ID:          uuid.New(),
+		Reference:   "FAT-2025-06",
+		ContentType: "application/xml",
+		RawBody:     []byte("<invoice/>"),
+	})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusUnsupportedMediaType, statusErr.GetStatus())
+}
+
+func TestHandler_ImportCarrierInvoice_AlreadyImported(t *testing.T) {
+	invoiceSvc := &invoicemock.ServiceMock{
+		ImportFunc: func(ctx context.Context, inv *invoice.Invoice) (*invoice.Report, error) {
+			return nil, invoice.ErrInvoiceExists
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, invoiceSvc, nil, nil)
+	resp, err := h.ImportCarrierInvoice(context.Background(), &invoice.ImportInput{
+		ID:          uuid.New(),
+		Reference:   "FAT-2025-06",
+		ContentType: "application/json",
+		RawBody:     []byte(`{"lines":[{"tracking_code":"BR1","amount":"30.40"}]}`),
+	})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHandler_CreateManifest_InvalidDate(t *testing.T) {
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, &manifestmock.ServiceMock{}, nil)
+	resp, err := h.CreateManifest(context.Background(), &manifest.CreateManifestInput{
+		ID:   uuid.New(),
+		Body: manifest.CreateManifestInputBody{PickupDate: "01/07/2025"},
+	})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.GetStatus())
+}
+
+func TestHandler_CreateManifest_Success(t *testing.T) {
+	code := "BR123"
+	manifestSvc := &manifestmock.ServiceMock{
+		CreateFunc: func(ctx context.Context, carrierID uuid.UUID, pickupDate time.Time) (*manifest.Manifest, error) {
+			return &manifest.Manifest{
+				ID:         uuid.New(),
+				CarrierID:  carrierID,
+				PickupDate: pickupDate,
+				Status:     manifest.StatusOpen,
+				Items: []manifest.Item{
+					{ContractID: uuid.New(), OrderID: uuid.New(), TrackingCode: &code, WeightKg: decimal.NewFromFloat(2.5)},
+					{ContractID: uuid.New(), OrderID: uuid.New(), WeightKg: decimal.NewFromInt(4)},
+				},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, manifestSvc, nil)
+	resp, err := h.CreateManifest(context.Background(), &manifest.CreateManifestInput{
+		ID:   uuid.New(),
+		Body: manifest.CreateManifestInputBody{PickupDate: "2025-07-01"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Equal(t, "2025-07-01", resp.Body.PickupDate)
+	assert.Equal(t, 2, resp.Body.Packages)
+	assert.Equal(t, "6.50", resp.Body.TotalWeightKg)
+	assert.Equal(t, "BR123", *resp.Body.Items[0].TrackingCode)
+}
+
+func TestHandler_ConfirmManifest_Outdated(t *testing.T) {
+	manifestSvc := &manifestmock.ServiceMock{
+		ConfirmFunc: func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+			return nil, manifest.ErrManifestOutdated
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, manifestSvc, nil)
+	resp, err := h.ConfirmManifest(context.Background(), &manifest.GetManifestInput{ID: uuid.New()})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHandler_ExportManifest_PDF(t *testing.T) {
+	manifestSvc := &manifestmock.ServiceMock{
+		ExportFunc: func(ctx context.Context, id uuid.UUID, format manifest.Format) (*manifest.Export, error) {
+			return &manifest.Export{
+				Format:      format,
+				ContentType: "application/pdf",
+				Filename:    "manifest-" + id.String() + ".pdf",
+				Content:     []byte("%PDF-1.4"),
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, manifestSvc, nil)
+	id := uuid.New()
+	resp, err := h.ExportManifest(context.Background(), &manifest.ExportManifestInput{ID: id, Format: "pdf"})
+	assert.NoError(t, err)
+	assert.Equal(t, "application/pdf", resp.ContentType)
+	assert.Equal(t, `attachment; filename="manifest-`+id.String()+`.pdf"`, resp.ContentDisposition)
+	assert.Equal(t, manifest.FormatPDF, manifestSvc.ExportCalls()[0].Format)
+}
+
+func TestHandler_SimulateQuotes_FromCEP(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		SimulateFunc: func(ctx context.Context, sim shipping.Simulation, strategy shipping.RankingStrategy) ([]*shipping.Quote, error) {
+			return []*shipping.Quote{
+				{
+					CarrierID:     uuid.New(),
+					CarrierName:   "CarrierX",
+					Price:         decimal.NewFromFloat(30),
+					EstimatedDays: 8,
+					Recommended:   true,
+				},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.SimulateQuotesInput{
+		Body: shipping.SimulateQuotesInputBody{
+			WeightKg:       3,
+			DestinationCEP: "69301-000",
+		},
+	}
+	resp, err := h.SimulateQuotes(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, "RR", resp.Body.DestinationUF)
+	assert.Equal(t, "3.00", resp.Body.ChargeableWeightKg)
+	assert.Len(t, resp.Body.Quotes, 1)
+	assert.Equal(t, states.RR, shippingSvc.SimulateCalls()[0].Simulation.DestinationUF)
+}
+
+func TestHandler_SimulateQuotes_CEPMismatch(t *testing.T) {
+	h := server.NewHandler(nil, nil, &shippingmock.ServiceMock{}, nil, nil, nil, nil, nil, nil)
+	input := &shipping.SimulateQuotesInput{
+		Body: shipping.SimulateQuotesInputBody{
+			WeightKg:       3,
+			DestinationUF:  "SP",
+			DestinationCEP: "20040-020",
+		},
+	}
+	resp, err := h.SimulateQuotes(context.Background(), input)
+	assert.Nil(t, resp)
 	assert.NotNil(t, err)
 }
+
+func TestHandler_SimulateQuotes_MissingDestination(t *testing.T) {
+	h := server.NewHandler(nil, nil, &shippingmock.ServiceMock{}, nil, nil, nil, nil, nil, nil)
+	input := &shipping.SimulateQuotesInput{
+		Body: shipping.SimulateQuotesInputBody{WeightKg: 3},
+	}
+	resp, err := h.SimulateQuotes(context.Background(), input)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_PlanLoad_Success(t *testing.T) {
+	orderID := uuid.New()
+	shippingSvc := &shippingmock.ServiceMock{
+		PlanLoadFunc: func(ctx context.Context, request shipping.LoadRequest) (*shipping.Load, error) {
+			return &shipping.Load{
+				ID:              uuid.New(),
+				Region:          request.Region,
+				Status:          shipping.LoadStatusPlanned,
+				Price:           decimal.RequireFromString("10.00"),
+				ICMS:            decimal.RequireFromString("1.20"),
+				IndividualPrice: decimal.RequireFromString("15.00"),
+				Orders: []shipping.LoadOrder{{
+					OrderID: orderID,
+					Share:   shipping.PriceCalculation{Price: decimal.RequireFromString("10.00")},
+				}},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.PlanLoadInput{Body: shipping.PlanLoadInputBody{Region: "Sudeste"}}
+
+	resp, err := h.PlanLoad(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Equal(t, "11.20", resp.Body.TotalPrice)
+	assert.Equal(t, "3.80", resp.Body.Savings)
+	assert.Equal(t, orderID.String(), resp.Body.Orders[0].OrderID)
+}
+
+func TestHandler_PlanLoad_TooSmall(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		PlanLoadFunc: func(ctx context.Context, request shipping.LoadRequest) (*shipping.Load, error) {
+			return nil, shipping.ErrLoadTooSmall
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+
+	resp, err := h.PlanLoad(context.Background(), &shipping.PlanLoadInput{})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, statusErr.GetStatus())
+}
+
+func TestHandler_ContractLoad_Outdated(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		ContractLoadFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+			return nil, shipping.ErrLoadOutdated
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+
+	resp, err := h.ContractLoad(context.Background(), &shipping.LoadInput{ID: uuid.New()})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHandler_AllocateOrders_Infeasible(t *testing.T) {
+	shippingSvc := &shippingmock.ServiceMock{
+		AllocateFunc: func(ctx context.Context, request shipping.AllocationRequest) (*shipping.Allocation, error) {
+			return nil, shipping.ErrAllocationInfeasible
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.AllocateInput{Body: shipping.AllocateInputBody{OrderIDs: []uuid.UUID{uuid.New()}}}
+
+	resp, err := h.AllocateOrders(context.Background(), input)
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, statusErr.GetStatus())
+}
+
+func TestHandler_AllocateOrders_DuplicateCapacity(t *testing.T) {
+	carrierID := uuid.New()
+	h := server.NewHandler(nil, nil, &shippingmock.ServiceMock{}, nil, nil, nil, nil, nil, nil)
+	input := &shipping.AllocateInput{Body: shipping.AllocateInputBody{
+		OrderIDs: []uuid.UUID{uuid.New()},
+		Capacities: []shipping.CarrierCapacityInputBody{
+			{CarrierID: carrierID, MaxOrders: 1},
+			{CarrierID: carrierID, MaxOrders: 2},
+		},
+	}}
+
+	resp, err := h.AllocateOrders(context.Background(), input)
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.GetStatus())
+}
+
+func TestHandler_AllocateOrders_ReportsContractErrors(t *testing.T) {
+	contractID := uuid.New()
+	shippingSvc := &shippingmock.ServiceMock{
+		AllocateFunc: func(ctx context.Context, request shipping.AllocationRequest) (*shipping.Allocation, error) {
+			return &shipping.Allocation{
+				Assignments: []shipping.Assignment{
+					{OrderID: request.OrderIDs[0], ContractID: &contractID},
+					{OrderID: request.OrderIDs[1], Err: order.ErrInvalidStatusTransition},
+				},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, shippingSvc, nil, nil, nil, nil, nil, nil)
+	input := &shipping.AllocateInput{Body: shipping.AllocateInputBody{
+		OrderIDs: []uuid.UUID{uuid.New(), uuid.New()},
+		Contract: true,
+	}}
+
+	resp, err := h.AllocateOrders(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, contractID.String(), *resp.Body.Assignments[0].ContractID)
+	assert.Equal(t, "invalid order status for contracting", resp.Body.Assignments[1].Error)
+}
+
+func TestHandler_CreateWebhookSubscription_UnknownEventType(t *testing.T) {
+	subscriptionSvc := &subscriptionmock.ServiceMock{
+		CreateFunc: func(ctx context.Context, rawURL string, eventTypes []string, secret string) (*subscription.Subscription, error) {
+			return nil, subscription.ErrUnknownEventType
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, subscriptionSvc)
+
+	resp, err := h.CreateWebhookSubscription(context.Background(), &subscription.CreateInput{})
+	assert.Nil(t, resp)
+	var statusErr huma.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.GetStatus())
+}
+
+func TestHandler_GetWebhookDelivery_IncludesAttemptLog(t *testing.T) {
+	code := http.StatusServiceUnavailable
+	reason := "subscriber responded 503"
+	subscriptionSvc := &subscriptionmock.ServiceMock{
+		GetDeliveryFunc: func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+			return &subscription.Delivery{
+				ID:        id,
+				EventType: outbox.EventOrderStatusChanged,
+				Status:    subscription.DeliveryStatusDead,
+				Attempts:  1,
+				Log: []subscription.Attempt{
+					{Number: 1, StatusCode: &code, Error: &reason, Duration: 120 * time.Millisecond},
+				},
+			}, nil
+		},
+	}
+	h := server.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, subscriptionSvc)
+
+	resp, err := h.GetWebhookDelivery(context.Background(), &subscription.DeliveryInput{ID: uuid.New()})
+	assert.NoError(t, err)
+	assert.Equal(t, "dead", resp.Body.Status)
+	assert.Nil(t, resp.Body.NextAttemptAt)
+	assert.Len(t, resp.Body.Log, 1)
+	assert.Equal(t, int64(120), resp.Body.Log[0].DurationMs)
+	assert.Equal(t, http.StatusServiceUnavailable, *resp.Body.Log[0].StatusCode)
+}
+
+func TestOrderStreamHandler_InvalidRegion(t *testing.T) {
+	handler := server.NewOrderStreamHandler(stream.NewHub(&ordermock.RepositoryMock{}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, server.OrderStreamPath+"?region=Leste", nil))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "invalid region")
+}
+
+func TestOrderStreamHandler_RecordsSpan(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	handler := server.NewOrderStreamHandler(stream.NewHub(&ordermock.RepositoryMock{}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, server.OrderStreamPath+"?region=Leste", nil))
+
+	ended := spans.Ended()
+	assert.Len(t, ended, 1)
+	assert.Equal(t, "GET "+server.OrderStreamPath, ended[0].Name())
+	assert.Contains(t, ended[0].Attributes(), semconv.HTTPResponseStatusCode(http.StatusBadRequest))
+}
+
+func TestOrderStreamHandler_StreamsMatchingUpdates(t *testing.T) {
+	o := &order.Order{ID: uuid.New(), DestinationUF: states.PR, Status: order.StatusShipped}
+	hub := stream.NewHub(&ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return o, nil
+		},
+	})
+	srv := httptest.NewServer(server.NewOrderStreamHandler(hub))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + server.OrderStreamPath + "?order_id=" + o.ID.String() + "&status=shipped")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(line, "retry:"))
+
+	event, err := outbox.NewEvent(outbox.OrderStatusChanged{OrderID: o.ID, From: "picked_up", To: "shipped"}, time.Now())
+	assert.NoError(t, err)
+	assert.NoError(t, hub.Publish(context.Background(), event))
+
+	frame := []string{}
+	for len(frame) < 3 {
+		line, err := reader.ReadString('\n')
+		assert.NoError(t, err)
+		if line = strings.TrimSpace(line); line != "" {
+			frame = append(frame, line)
+		}
+	}
+	assert.Equal(t, "id: "+event.ID.String(), frame[0])
+	assert.Equal(t, "event: order.status_changed", frame[1])
+	assert.Contains(t, frame[2], `"status":"shipped"`)
+	assert.Contains(t, frame[2], `"region":"Sul"`)
+}
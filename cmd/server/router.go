@@ -27,10 +27,38 @@ func RouterSetup(cfg *config.AppConfig, handler *Handler) huma.API {
 	api := humafiber.New(app, humaConfig)
 
 	RegisterRoutes(api, handler)
+	documentOrderStream(api)
 
 	return api
 }
 
+func documentOrderStream(api huma.API) {
+	query := func(name, description string) *huma.Param {
+		return &huma.Param{Name: name, In: "query", Description: description, Schema: &huma.Schema{Type: huma.TypeString}}
+	}
+
+	api.OpenAPI().AddOperation(&huma.Operation{
+		OperationID: "stream-orders",
+		Method:      http.MethodGet,
+		Path:        OrderStreamPath,
+		Summary:     "Stream order status changes",
+		Description: "Streams order status and contract changes as Server-Sent Events as soon as they are committed",
+		Tags:        []string{"Orders"},
+		Parameters: []*huma.Param{
+			query("order_id", "Only stream changes for this order"),
+			query("status", "Only stream changes whose resulting status matches"),
+			query("region", "Only stream changes for orders destined to this region"),
+		},
+		Responses: map[string]*huma.Response{
+			"200": {
+				Description: "Event stream",
+				Content:     map[string]*huma.MediaType{"text/event-stream": {Schema: &huma.Schema{Type: huma.TypeString}}},
+			},
+			"400": {Description: "Invalid filter"},
+		},
+	})
+}
+
 func RegisterRoutes(api huma.API, handler *Handler) {
 	huma.Register(api, huma.Operation{
 		Method:        http.MethodPost,
@@ -72,23 +100,443 @@ func RegisterRoutes(api huma.API, handler *Handler) {
 		Errors:        []int{400, 500},
 	}, handler.CreateCarrier)
 
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/carriers/{id}/coverage",
+		Summary:       "Get carrier coverage",
+		Description:   "Lists every UF served by the carrier, expanded from its regional policies",
+		Tags:          []string{"Coverage"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetCarrierCoverage)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/coverage/{uf}",
+		Summary:       "Get coverage by state",
+		Description:   "Lists every carrier that delivers to the UF, with its policy and price per kg",
+		Tags:          []string{"Coverage"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 500},
+	}, handler.GetCoverageByState)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/fuel-surcharges",
+		Summary:       "Create a fuel surcharge",
+		Description:   "Registers a fuel surcharge percentage for a carrier, or for all carriers when no carrier is given",
+		Tags:          []string{"Carriers"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 404, 500},
+	}, handler.CreateFuelSurcharge)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/fuel-surcharges",
+		Summary:       "List fuel surcharges",
+		Description:   "Lists the fuel surcharge history, most recent first",
+		Tags:          []string{"Carriers"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{500},
+	}, handler.ListFuelSurcharges)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/carriers/{id}/discounts",
+		Summary:       "Create a carrier discount",
+		Description:   "Registers a negotiated discount for a carrier, optionally limited by region, weight range and monthly contract volume",
+		Tags:          []string{"Carriers"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 404, 500},
+	}, handler.CreateCarrierDiscount)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/carriers/{id}/discounts",
+		Summary:       "List carrier discounts",
+		Description:   "Lists the negotiated discounts of a carrier, most recent first",
+		Tags:          []string{"Carriers"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.ListCarrierDiscounts)
+
 	huma.Register(api, huma.Operation{
 		Method:        http.MethodGet,
 		Path:          "/api/v1/shipping/quotes/{order_id}",
 		Summary:       "Get shipping quotes",
-		Description:   "Retrieves shipping quotes for all carriers based on the order ID",
+		Description:   "Retrieves shipping quotes for all carriers based on the order ID, ranked by the chosen strategy",
 		Tags:          []string{"Shipping"},
 		DefaultStatus: http.StatusOK,
-		Errors:        []int{404, 500},
+		Errors:        []int{400, 404, 500},
 	}, handler.GetQuotes)
 
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/quotes/batch",
+		Summary:       "Get shipping quotes for many orders",
+		Description:   "Quotes every order in the list, loading carriers once per destination region",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 500},
+	}, handler.GetBatchQuotes)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/quotes/simulate",
+		Summary:       "Simulate shipping quotes",
+		Description:   "Prices a shipment by weight, destination and optional dimensions without creating an order",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 500},
+	}, handler.SimulateQuotes)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/pricing-rules",
+		Summary:       "Create a pricing rule",
+		Description:   "Adds a surcharge, discount, minimum or rounding rule to the pricing pipeline, for a carrier or for all carriers",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 404, 500},
+	}, handler.CreatePricingRule)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/pricing-rules",
+		Summary:       "List pricing rules",
+		Description:   "Lists the configured pricing rules",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{500},
+	}, handler.ListPricingRules)
+
 	huma.Register(api, huma.Operation{
 		Method:        http.MethodPost,
 		Path:          "/api/v1/shipping/contracts",
 		Summary:       "Create a shipping contract",
-		Description:   "Creates a shipping contract with the selected carrier for the specified order",
+		Description:   "Creates a shipping contract with the selected carrier for the specified order; contracting an order awaiting pickup with another carrier voids its active contract",
 		Tags:          []string{"Shipping"},
 		DefaultStatus: http.StatusOK,
-		Errors:        []int{400, 500},
+		Errors:        []int{400, 404, 409, 410, 500},
 	}, handler.ContractCarrier)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/shipping/contracts",
+		Summary:       "List shipping contracts",
+		Description:   "Lists contracts filtered by order, carrier, status and contract date, most recent first",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 500},
+	}, handler.ListContracts)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/shipping/contracts/{id}",
+		Summary:       "Get a shipping contract",
+		Description:   "Retrieves a contract, including cancelled and voided ones",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetContract)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/contracts/{id}/cancel",
+		Summary:       "Cancel a shipping contract",
+		Description:   "Cancels an active contract before pickup and returns the order to created",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 409, 500},
+	}, handler.CancelContract)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPut,
+		Path:          "/api/v1/shipping/contracts/{id}/tracking-code",
+		Summary:       "Set a contract tracking code",
+		Description:   "Stores the tracking code issued by the carrier for an active contract",
+		Tags:          []string{"Tracking"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 409, 500},
+	}, handler.SetTrackingCode)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/contracts/{id}/tracking-events",
+		Summary:       "Append a tracking event",
+		Description:   "Appends a carrier tracking event to an active contract; pickup, transit, delivery and loss events advance the order status",
+		Tags:          []string{"Tracking"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 404, 409, 500},
+	}, handler.AppendTrackingEvent)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/orders/{id}/tracking",
+		Summary:       "Get order tracking",
+		Description:   "Retrieves the tracking code and the full tracking event trail of an order",
+		Tags:          []string{"Tracking"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetTrackingTrail)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPut,
+		Path:          "/api/v1/carriers/{id}/webhook-secret",
+		Summary:       "Rotate a carrier webhook secret",
+		Description:   "Generates a new secret the carrier uses to sign webhook payloads; the previous secret stops working",
+		Tags:          []string{"Carriers"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.RotateCarrierWebhookSecret)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/webhooks/carriers/{id}",
+		Summary:       "Receive a carrier webhook",
+		Description:   "Receives tracking updates pushed by a carrier, signed with its webhook secret, and appends them to the matching contracts",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusAccepted,
+		Errors:        []int{400, 401, 409, 500},
+	}, handler.ReceiveCarrierWebhook)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/shipping/contracts/{id}/label",
+		Summary:       "Get a shipping label",
+		Description:   "Renders the label of an active contract with a tracking code as PDF or as ZPL for thermal printers, using the carrier template",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 409, 422, 500},
+	}, handler.GetShippingLabel)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPut,
+		Path:          "/api/v1/carriers/{id}/label-templates/{format}",
+		Summary:       "Set a carrier label template",
+		Description:   "Stores the label template used for the carrier contracts in the given format",
+		Tags:          []string{"Carriers"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 500},
+	}, handler.SetLabelTemplate)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/carriers/{id}/label-templates/{format}",
+		Summary:       "Get a carrier label template",
+		Description:   "Retrieves the carrier label template in the given format, or the built-in one when none is stored",
+		Tags:          []string{"Carriers"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 500},
+	}, handler.GetLabelTemplate)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/carriers/{id}/invoices",
+		Summary:       "Import a carrier invoice",
+		Description:   "Imports a carrier freight invoice as CSV (tracking_code, order_id, amount, weight_kg columns) or JSON ({\"lines\": [...]}) and reconciles it against the carrier contracts",
+		Tags:          []string{"Invoices"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 404, 409, 415, 500},
+	}, handler.ImportCarrierInvoice)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/carriers/{id}/invoices",
+		Summary:       "List carrier invoices",
+		Description:   "Lists the invoices imported for a carrier",
+		Tags:          []string{"Invoices"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{500},
+	}, handler.ListCarrierInvoices)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/invoices/{id}/reconciliation",
+		Summary:       "Get an invoice reconciliation report",
+		Description:   "Reconciles an imported invoice against the current contracts and reports matched, disputed, missing and unexpected charges",
+		Tags:          []string{"Invoices"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetInvoiceReconciliation)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/carriers/{id}/manifests",
+		Summary:       "Create a pickup manifest",
+		Description:   "Gathers every contract of the carrier awaiting pickup up to the pickup date that is not on another manifest",
+		Tags:          []string{"Manifests"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 404, 409, 422, 500},
+	}, handler.CreateManifest)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/carriers/{id}/manifests",
+		Summary:       "List carrier pickup manifests",
+		Description:   "Lists the pickup manifests of a carrier, optionally for a single pickup date",
+		Tags:          []string{"Manifests"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 500},
+	}, handler.ListManifests)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/manifests/{id}",
+		Summary:       "Get a pickup manifest",
+		Description:   "Retrieves a pickup manifest with its packages",
+		Tags:          []string{"Manifests"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetManifest)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/manifests/{id}/export",
+		Summary:       "Export a pickup manifest",
+		Description:   "Exports a pickup manifest as CSV or as a printable PDF with signature lines",
+		Tags:          []string{"Manifests"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 500},
+	}, handler.ExportManifest)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/manifests/{id}/confirm",
+		Summary:       "Confirm a pickup manifest",
+		Description:   "Confirms the pickup and moves every order on the manifest to picked_up in a single transaction",
+		Tags:          []string{"Manifests"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 409, 500},
+	}, handler.ConfirmManifest)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/manifests/{id}/cancel",
+		Summary:       "Cancel a pickup manifest",
+		Description:   "Cancels an open manifest so its contracts can be added to a new one",
+		Tags:          []string{"Manifests"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 409, 500},
+	}, handler.CancelManifest)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/loads",
+		Summary:       "Plan a consolidated load",
+		Description:   "Groups created orders of a region into a single load for one carrier, quoted on the combined weight with the cost split back to the orders by weight",
+		Tags:          []string{"Loads"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 404, 409, 422, 500},
+	}, handler.PlanLoad)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/shipping/loads/{id}",
+		Summary:       "Get a consolidated load",
+		Description:   "Retrieves a load with the share of the cost of each order",
+		Tags:          []string{"Loads"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetLoad)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/loads/{id}/contract",
+		Summary:       "Contract a consolidated load",
+		Description:   "Contracts every order of a planned load with its carrier as a unit, each at its share of the load price",
+		Tags:          []string{"Loads"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 409, 410, 500},
+	}, handler.ContractLoad)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/loads/{id}/cancel",
+		Summary:       "Cancel a consolidated load",
+		Description:   "Cancels a planned load so its orders can be loaded again",
+		Tags:          []string{"Loads"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 409, 500},
+	}, handler.CancelLoad)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/shipping/allocations",
+		Summary:       "Allocate orders to carriers",
+		Description:   "Assigns each order to a carrier with the lowest total cost that respects carrier capacities, a delivery days limit and required carriers, optionally contracting every assignment",
+		Tags:          []string{"Shipping"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 409, 422, 500},
+	}, handler.AllocateOrders)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/webhooks/subscriptions",
+		Summary:       "Create a webhook subscription",
+		Description:   "Registers an endpoint that receives the selected order and contract events, signed with the subscription secret",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusCreated,
+		Errors:        []int{400, 500},
+	}, handler.CreateWebhookSubscription)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/webhooks/subscriptions",
+		Summary:       "List webhook subscriptions",
+		Description:   "Lists the active webhook subscriptions",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{500},
+	}, handler.ListWebhookSubscriptions)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/webhooks/subscriptions/{id}",
+		Summary:       "Get a webhook subscription",
+		Description:   "Returns a webhook subscription without its secret",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetWebhookSubscription)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodDelete,
+		Path:          "/api/v1/webhooks/subscriptions/{id}",
+		Summary:       "Delete a webhook subscription",
+		Description:   "Stops delivering new events to the subscription; its delivery log is kept",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusNoContent,
+		Errors:        []int{404, 500},
+	}, handler.DeleteWebhookSubscription)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/webhooks/subscriptions/{id}/deliveries",
+		Summary:       "List webhook deliveries",
+		Description:   "Lists the deliveries of a subscription, newest first",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{400, 404, 500},
+	}, handler.ListWebhookDeliveries)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodGet,
+		Path:          "/api/v1/webhooks/deliveries/{id}",
+		Summary:       "Get a webhook delivery",
+		Description:   "Returns a delivery with the log of every attempt",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 500},
+	}, handler.GetWebhookDelivery)
+
+	huma.Register(api, huma.Operation{
+		Method:        http.MethodPost,
+		Path:          "/api/v1/webhooks/deliveries/{id}/redeliver",
+		Summary:       "Redeliver a webhook",
+		Description:   "Sends the delivery again right away, including dead and already delivered ones, and records the attempt",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: http.StatusOK,
+		Errors:        []int{404, 409, 500},
+	}, handler.RedeliverWebhook)
 }
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/stream"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+	log "go.uber.org/zap"
+)
+
+const (
+	OrderStreamPath  = "/api/v1/stream/orders"
+	streamHeartbeat  = 15 * time.Second
+	streamRetryDelay = 3 * time.Second
+	streamTracerName = "github.com/victorvcruz/shipment-coordinator/cmd/server"
+)
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func instrumentStream(next http.Handler) http.Handler {
+	tracer := otel.Tracer(streamTracerName)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, r.Method+" "+OrderStreamPath,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.HTTPRoute(OrderStreamPath),
+				semconv.URLPath(r.URL.Path),
+				semconv.ClientAddress(r.RemoteAddr),
+			),
+		)
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.status))
+		}
+
+		fields := []log.Field{
+			log.Int("status", recorder.status),
+			log.Duration("latency", time.Since(start)),
+			log.String("method", r.Method),
+			log.String("url", r.URL.RequestURI()),
+			log.String("ip", r.RemoteAddr),
+		}
+		switch {
+		case recorder.status >= http.StatusInternalServerError:
+			log.L().Error("Server error", fields...)
+		case recorder.status >= http.StatusBadRequest:
+			log.L().Warn("Client error", fields...)
+		default:
+			log.L().Info("Success", fields...)
+		}
+	})
+}
+
+func NewOrderStreamHandler(hub *stream.Hub) http.Handler {
+	return instrumentStream(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeStreamError(w, huma.NewError(http.StatusMethodNotAllowed, "method not allowed"))
+			return
+		}
+
+		filter, err := parseStreamFilter(r)
+		if err != nil {
+			writeStreamError(w, err)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			writeStreamError(w, huma.Error500InternalServerError("streaming not supported"))
+			return
+		}
+
+		subscriber := hub.Subscribe(filter)
+		defer hub.Unsubscribe(subscriber)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "retry: %d\n\n", streamRetryDelay.Milliseconds())
+		flusher.Flush()
+
+		heartbeat := time.NewTicker(streamHeartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+					return
+				}
+				flusher.Flush()
+			case update, ok := <-subscriber.C:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(update)
+				if err != nil {
+					log.L().
+						Error("failed to encode stream update", log.Error(err))
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", update.EventID, update.Type, data); err != nil {
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	}))
+}
+
+func parseStreamFilter(r *http.Request) (stream.Filter, error) {
+	var filter stream.Filter
+	query := r.URL.Query()
+
+	if raw := query.Get("order_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return stream.Filter{}, huma.Error400BadRequest("invalid order ID")
+		}
+		filter.OrderID = id
+	}
+
+	if raw := query.Get("status"); raw != "" {
+		status, ok := order.StatusValues[raw]
+		if !ok {
+			return stream.Filter{}, huma.Error400BadRequest("invalid status")
+		}
+		filter.Status = &status
+	}
+
+	if raw := query.Get("region"); raw != "" {
+		region, ok := states.Regions[raw]
+		if !ok {
+			return stream.Filter{}, huma.Error400BadRequest("invalid region")
+		}
+		filter.Region = region.Name
+	}
+
+	return filter, nil
+}
+
+func writeStreamError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if se, ok := err.(huma.StatusError); ok {
+		status = se.GetStatus()
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(err) //nolint:errcheck
+}
@@ -16,9 +16,15 @@ type CreateCarrierInputBody struct {
 }
 
 type CarrierPolicyInput struct {
-	Region        string  `json:"region"         required:"true" doc:"Region name"             example:"Nordeste"`
-	EstimatedDays int     `json:"estimated_days" required:"true" doc:"Estimated delivery days" example:"5"`
-	PricePerKg    float64 `json:"price_per_kg"   required:"true" doc:"Price per kg in BRL"     example:"10.50"`
+	Region              string   `json:"region"                          required:"true"  doc:"Region name"                                    example:"Nordeste"`
+	EstimatedDays       int      `json:"estimated_days"                  required:"true"  doc:"Estimated delivery days"                        example:"5"`
+	PricePerKg          float64  `json:"price_per_kg"                    required:"true"  doc:"Price per kg in BRL"                            example:"10.50"`
+	AdValoremPercentage float64  `json:"ad_valorem_percentage,omitempty" required:"false" doc:"Frete-valor percentage over the declared value" example:"0.3"`
+	GRISPercentage      float64  `json:"gris_percentage,omitempty"       required:"false" doc:"GRIS percentage over the declared value"        example:"0.2"`
+	GRISMinimum         float64  `json:"gris_minimum,omitempty"          required:"false" doc:"Minimum GRIS in BRL"                            example:"5.00"`
+	TollPerFraction     float64  `json:"toll_per_fraction,omitempty"     required:"false" doc:"Pedágio in BRL per started 100 kg"              example:"4.50"`
+	TDEPercentage       float64  `json:"tde_percentage,omitempty"        required:"false" doc:"TDE percentage over base freight"               example:"20"`
+	TDEUFs              []string `json:"tde_ufs,omitempty"               required:"false" doc:"UFs in the region considered hard to deliver"   example:"[\"AM\"]"`
 }
 
 type CarrierResponseOutput struct {
@@ -35,9 +41,133 @@ type CarrierResponseOutputBody struct {
 }
 
 type CarrierPolicyResponse struct {
-	Region        string    `json:"region"         doc:"Region name"                                 example:"Nordeste"`
-	EstimatedDays int       `json:"estimated_days" doc:"Estimated delivery days"                     example:"5"`
-	PricePerKg    string    `json:"price_per_kg"   doc:"Price per kg (string for decimal precision)" example:"10.50"`
-	CreatedAt     time.Time `json:"created_at"     doc:"Carrier creation date"                       example:"2023-10-01T12:00:00Z"`
-	UpdatedAt     time.Time `json:"updated_at"     doc:"Carrier last update date"                    example:"2023-10-01T12:00:00Z"`
+	Region              string    `json:"region"                doc:"Region name"                                    example:"Nordeste"`
+	EstimatedDays       int       `json:"estimated_days"        doc:"Estimated delivery days"                        example:"5"`
+	PricePerKg          string    `json:"price_per_kg"          doc:"Price per kg (string for decimal precision)"    example:"10.50"`
+	AdValoremPercentage string    `json:"ad_valorem_percentage" doc:"Frete-valor percentage over the declared value" example:"0.30"`
+	GRISPercentage      string    `json:"gris_percentage"       doc:"GRIS percentage over the declared value"        example:"0.20"`
+	GRISMinimum         string    `json:"gris_minimum"          doc:"Minimum GRIS in BRL"                            example:"5.00"`
+	TollPerFraction     string    `json:"toll_per_fraction"     doc:"Pedágio in BRL per started 100 kg"              example:"4.50"`
+	TDEPercentage       string    `json:"tde_percentage"        doc:"TDE percentage over base freight"               example:"20.00"`
+	TDEUFs              []string  `json:"tde_ufs"               doc:"UFs in the region considered hard to deliver"   example:"[\"AM\"]"`
+	CreatedAt           time.Time `json:"created_at"            doc:"Carrier creation date"                          example:"2023-10-01T12:00:00Z"`
+	UpdatedAt           time.Time `json:"updated_at"            doc:"Carrier last update date"                       example:"2023-10-01T12:00:00Z"`
+}
+
+type GetCoverageByStateInput struct {
+	UF string `path:"uf" doc:"Destination UF" example:"RR"`
+}
+
+type CoverageByStateOutput struct {
+	Status int
+	Body   CoverageByStateOutputBody
+}
+
+type CoverageByStateOutputBody struct {
+	UF       string                    `json:"uf"       doc:"State UF"                        example:"RR"`
+	State    string                    `json:"state"    doc:"State name"                      example:"Roraima"`
+	Region   string                    `json:"region"   doc:"Region name"                     example:"Norte"`
+	Carriers []CoverageCarrierResponse `json:"carriers" doc:"Carriers that deliver to the UF"`
+}
+
+type CoverageCarrierResponse struct {
+	CarrierID     uuid.UUID `json:"carrier_id"     doc:"Carrier ID"              example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierName   string    `json:"carrier_name"   doc:"Carrier name"            example:"Fast Delivery"`
+	EstimatedDays int       `json:"estimated_days" doc:"Estimated delivery days" example:"5"`
+	PricePerKg    string    `json:"price_per_kg"   doc:"Price per kg in BRL"     example:"10.50"`
+}
+
+type GetCarrierCoverageInput struct {
+	ID uuid.UUID `path:"id" doc:"Carrier ID"`
+}
+
+type CarrierCoverageOutput struct {
+	Status int
+	Body   CarrierCoverageOutputBody
+}
+
+type CarrierCoverageOutputBody struct {
+	CarrierID   uuid.UUID               `json:"carrier_id"   doc:"Carrier ID"                example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierName string                  `json:"carrier_name" doc:"Carrier name"              example:"Fast Delivery"`
+	States      []CoverageStateResponse `json:"states"       doc:"UFs served by the carrier"`
+}
+
+type CoverageStateResponse struct {
+	UF            string `json:"uf"             doc:"State UF"                example:"RR"`
+	State         string `json:"state"          doc:"State name"              example:"Roraima"`
+	Region        string `json:"region"         doc:"Region name"             example:"Norte"`
+	EstimatedDays int    `json:"estimated_days" doc:"Estimated delivery days" example:"5"`
+	PricePerKg    string `json:"price_per_kg"   doc:"Price per kg in BRL"     example:"10.50"`
+}
+
+type CreateFuelSurchargeInput struct {
+	Body CreateFuelSurchargeInputBody
+}
+
+type CreateFuelSurchargeInputBody struct {
+	CarrierID     *uuid.UUID `json:"carrier_id,omitempty" required:"false" doc:"Carrier ID, omit for a global surcharge" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Percentage    float64    `json:"percentage"           required:"true"  doc:"Surcharge percentage over base freight"  example:"12.5"`
+	EffectiveFrom time.Time  `json:"effective_from"       required:"true"  doc:"Date the surcharge takes effect"         example:"2025-07-01T00:00:00Z"`
+}
+
+type FuelSurchargeResponseOutput struct {
+	Status int
+	Body   FuelSurchargeResponse
+}
+
+type ListFuelSurchargesOutput struct {
+	Status int
+	Body   []FuelSurchargeResponse
+}
+
+type FuelSurchargeResponse struct {
+	ID            uuid.UUID  `json:"id"                   doc:"Fuel surcharge ID"                       example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierID     *uuid.UUID `json:"carrier_id,omitempty" doc:"Carrier ID, empty for global surcharges" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Percentage    string     `json:"percentage"           doc:"Surcharge percentage"                    example:"12.50"`
+	EffectiveFrom time.Time  `json:"effective_from"       doc:"Date the surcharge takes effect"         example:"2025-07-01T00:00:00Z"`
+	CreatedAt     time.Time  `json:"created_at"           doc:"Creation date"                           example:"2025-06-28T15:04:05Z"`
+}
+
+type CreateDiscountInput struct {
+	ID   uuid.UUID `path:"id" doc:"Carrier ID"`
+	Body CreateDiscountInputBody
+}
+
+type CreateDiscountInputBody struct {
+	Name             string     `json:"name"                         required:"true"  doc:"Description shown on the price breakdown"                example:"Q3 volume agreement"`
+	Percentage       float64    `json:"percentage"                   required:"true"  doc:"Discount percentage over the freight"                    example:"7.5"`
+	Region           string     `json:"region,omitempty"             required:"false" doc:"Destination region the discount is limited to"           example:"Sudeste"`
+	MinWeightKg      float64    `json:"min_weight_kg,omitempty"      required:"false" doc:"Minimum chargeable weight in kg"                         example:"100"`
+	MaxWeightKg      float64    `json:"max_weight_kg,omitempty"      required:"false" doc:"Maximum chargeable weight in kg, omit for no limit"      example:"1000"`
+	MinMonthlyVolume int        `json:"min_monthly_volume,omitempty" required:"false" doc:"Contracts with the carrier this month needed to qualify" example:"50"`
+	ValidFrom        time.Time  `json:"valid_from"                   required:"true"  doc:"Date the discount starts"                                example:"2025-07-01T00:00:00Z"`
+	ValidUntil       *time.Time `json:"valid_until,omitempty"        required:"false" doc:"Date the discount ends, omit for no end"                 example:"2025-09-30T23:59:59Z"`
+}
+
+type GetDiscountsInput struct {
+	ID uuid.UUID `path:"id" doc:"Carrier ID"`
+}
+
+type DiscountResponseOutput struct {
+	Status int
+	Body   DiscountResponse
+}
+
+type ListDiscountsOutput struct {
+	Status int
+	Body   []DiscountResponse
+}
+
+type DiscountResponse struct {
+	ID               uuid.UUID  `json:"id"                    doc:"Discount ID"                                             example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierID        uuid.UUID  `json:"carrier_id"            doc:"Carrier ID"                                              example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name             string     `json:"name"                  doc:"Description shown on the price breakdown"                example:"Q3 volume agreement"`
+	Percentage       string     `json:"percentage"            doc:"Discount percentage"                                     example:"7.50"`
+	Region           string     `json:"region,omitempty"      doc:"Destination region, empty for any region"                example:"Sudeste"`
+	MinWeightKg      string     `json:"min_weight_kg"         doc:"Minimum chargeable weight in kg"                         example:"100.00"`
+	MaxWeightKg      string     `json:"max_weight_kg"         doc:"Maximum chargeable weight in kg, 0.00 for no limit"      example:"1000.00"`
+	MinMonthlyVolume int        `json:"min_monthly_volume"    doc:"Contracts with the carrier this month needed to qualify" example:"50"`
+	ValidFrom        time.Time  `json:"valid_from"            doc:"Date the discount starts"                                example:"2025-07-01T00:00:00Z"`
+	ValidUntil       *time.Time `json:"valid_until,omitempty" doc:"Date the discount ends"                                  example:"2025-09-30T23:59:59Z"`
+	CreatedAt        time.Time  `json:"created_at"            doc:"Creation date"                                           example:"2025-06-28T15:04:05Z"`
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
 	"sync"
+	"time"
 )
 
 // Ensure, that RepositoryMock does implement carrier.Repository.
@@ -23,6 +24,12 @@ var _ carrier.Repository = &RepositoryMock{}
 //			CreateFunc: func(ctx context.Context, carrierMoqParam *carrier.Carrier) (uuid.UUID, error) {
 //				panic("mock out the Create method")
 //			},
+//			CreateDiscountFunc: func(ctx context.Context, discount *carrier.Discount) (uuid.UUID, error) {
+//				panic("mock out the CreateDiscount method")
+//			},
+//			CreateFuelSurchargeFunc: func(ctx context.Context, surcharge *carrier.FuelSurcharge) (uuid.UUID, error) {
+//				panic("mock out the CreateFuelSurcharge method")
+//			},
 //			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
 //				panic("mock out the GetByID method")
 //			},
@@ -32,6 +39,18 @@ var _ carrier.Repository = &RepositoryMock{}
 //			ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
 //				panic("mock out the ListAllByRegion method")
 //			},
+//			ListDiscountsFunc: func(ctx context.Context, carrierID uuid.UUID) ([]carrier.Discount, error) {
+//				panic("mock out the ListDiscounts method")
+//			},
+//			ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+//				panic("mock out the ListDiscountsInEffect method")
+//			},
+//			ListFuelSurchargesFunc: func(ctx context.Context) ([]carrier.FuelSurcharge, error) {
+//				panic("mock out the ListFuelSurcharges method")
+//			},
+//			ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+//				panic("mock out the ListFuelSurchargesInEffect method")
+//			},
 //		}
 //
 //		// use mockedRepository in code that requires carrier.Repository
@@ -42,6 +61,12 @@ type RepositoryMock struct {
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, carrierMoqParam *carrier.Carrier) (uuid.UUID, error)
 
+	// CreateDiscountFunc mocks the CreateDiscount method.
+	CreateDiscountFunc func(ctx context.Context, discount *carrier.Discount) (uuid.UUID, error)
+
+	// CreateFuelSurchargeFunc mocks the CreateFuelSurcharge method.
+	CreateFuelSurchargeFunc func(ctx context.Context, surcharge *carrier.FuelSurcharge) (uuid.UUID, error)
+
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error)
 
@@ -51,6 +76,18 @@ type RepositoryMock struct {
 	// ListAllByRegionFunc mocks the ListAllByRegion method.
 	ListAllByRegionFunc func(ctx context.Context, region string) ([]carrier.Carrier, error)
 
+	// ListDiscountsFunc mocks the ListDiscounts method.
+	ListDiscountsFunc func(ctx context.Context, carrierID uuid.UUID) ([]carrier.Discount, error)
+
+	// ListDiscountsInEffectFunc mocks the ListDiscountsInEffect method.
+	ListDiscountsInEffectFunc func(ctx context.Context, at time.Time) ([]carrier.Discount, error)
+
+	// ListFuelSurchargesFunc mocks the ListFuelSurcharges method.
+	ListFuelSurchargesFunc func(ctx context.Context) ([]carrier.FuelSurcharge, error)
+
+	// ListFuelSurchargesInEffectFunc mocks the ListFuelSurchargesInEffect method.
+	ListFuelSurchargesInEffectFunc func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Create holds details about calls to the Create method.
@@ -60,6 +97,20 @@ type RepositoryMock struct {
 			// CarrierMoqParam is the carrierMoqParam argument value.
 			CarrierMoqParam *carrier.Carrier
 		}
+		// CreateDiscount holds details about calls to the CreateDiscount method.
+		CreateDiscount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Discount is the discount argument value.
+			Discount *carrier.Discount
+		}
+		// CreateFuelSurcharge holds details about calls to the CreateFuelSurcharge method.
+		CreateFuelSurcharge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Surcharge is the surcharge argument value.
+			Surcharge *carrier.FuelSurcharge
+		}
 		// GetByID holds details about calls to the GetByID method.
 		GetByID []struct {
 			// Ctx is the ctx argument value.
@@ -79,11 +130,43 @@ type RepositoryMock struct {
 			// Region is the region argument value.
 			Region string
 		}
+		// ListDiscounts holds details about calls to the ListDiscounts method.
+		ListDiscounts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+		}
+		// ListDiscountsInEffect holds details about calls to the ListDiscountsInEffect method.
+		ListDiscountsInEffect []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// At is the at argument value.
+			At time.Time
+		}
+		// ListFuelSurcharges holds details about calls to the ListFuelSurcharges method.
+		ListFuelSurcharges []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListFuelSurchargesInEffect holds details about calls to the ListFuelSurchargesInEffect method.
+		ListFuelSurchargesInEffect []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// At is the at argument value.
+			At time.Time
+		}
 	}
-	lockCreate          sync.RWMutex
-	lockGetByID         sync.RWMutex
-	lockListAll         sync.RWMutex
-	lockListAllByRegion sync.RWMutex
+	lockCreate                     sync.RWMutex
+	lockCreateDiscount             sync.RWMutex
+	lockCreateFuelSurcharge        sync.RWMutex
+	lockGetByID                    sync.RWMutex
+	lockListAll                    sync.RWMutex
+	lockListAllByRegion            sync.RWMutex
+	lockListDiscounts              sync.RWMutex
+	lockListDiscountsInEffect      sync.RWMutex
+	lockListFuelSurcharges         sync.RWMutex
+	lockListFuelSurchargesInEffect sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -122,6 +205,78 @@ func (mock *RepositoryMock) CreateCalls() []struct {
 	return calls
 }
 
+// CreateDiscount calls CreateDiscountFunc.
+func (mock *RepositoryMock) CreateDiscount(ctx context.Context, discount *carrier.Discount) (uuid.UUID, error) {
+	if mock.CreateDiscountFunc == nil {
+		panic("RepositoryMock.CreateDiscountFunc: method is nil but Repository.CreateDiscount was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Discount *carrier.Discount
+	}{
+		Ctx:      ctx,
+		Discount: discount,
+	}
+	mock.lockCreateDiscount.Lock()
+	mock.calls.CreateDiscount = append(mock.calls.CreateDiscount, callInfo)
+	mock.lockCreateDiscount.Unlock()
+	return mock.CreateDiscountFunc(ctx, discount)
+}
+
+// CreateDiscountCalls gets all the calls that were made to CreateDiscount.
+// Check the length with:
+//
+//	len(mockedRepository.CreateDiscountCalls())
+func (mock *RepositoryMock) CreateDiscountCalls() []struct {
+	Ctx      context.Context
+	Discount *carrier.Discount
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Discount *carrier.Discount
+	}
+	mock.lockCreateDiscount.RLock()
+	calls = mock.calls.CreateDiscount
+	mock.lockCreateDiscount.RUnlock()
+	return calls
+}
+
+// CreateFuelSurcharge calls CreateFuelSurchargeFunc.
+func (mock *RepositoryMock) CreateFuelSurcharge(ctx context.Context, surcharge *carrier.FuelSurcharge) (uuid.UUID, error) {
+	if mock.CreateFuelSurchargeFunc == nil {
+		panic("RepositoryMock.CreateFuelSurchargeFunc: method is nil but Repository.CreateFuelSurcharge was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Surcharge *carrier.FuelSurcharge
+	}{
+		Ctx:       ctx,
+		Surcharge: surcharge,
+	}
+	mock.lockCreateFuelSurcharge.Lock()
+	mock.calls.CreateFuelSurcharge = append(mock.calls.CreateFuelSurcharge, callInfo)
+	mock.lockCreateFuelSurcharge.Unlock()
+	return mock.CreateFuelSurchargeFunc(ctx, surcharge)
+}
+
+// CreateFuelSurchargeCalls gets all the calls that were made to CreateFuelSurcharge.
+// Check the length with:
+//
+//	len(mockedRepository.CreateFuelSurchargeCalls())
+func (mock *RepositoryMock) CreateFuelSurchargeCalls() []struct {
+	Ctx       context.Context
+	Surcharge *carrier.FuelSurcharge
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Surcharge *carrier.FuelSurcharge
+	}
+	mock.lockCreateFuelSurcharge.RLock()
+	calls = mock.calls.CreateFuelSurcharge
+	mock.lockCreateFuelSurcharge.RUnlock()
+	return calls
+}
+
 // GetByID calls GetByIDFunc.
 func (mock *RepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
 	if mock.GetByIDFunc == nil {
@@ -225,3 +380,143 @@ func (mock *RepositoryMock) ListAllByRegionCalls() []struct {
 	mock.lockListAllByRegion.RUnlock()
 	return calls
 }
+
+// ListDiscounts calls ListDiscountsFunc.
+func (mock *RepositoryMock) ListDiscounts(ctx context.Context, carrierID uuid.UUID) ([]carrier.Discount, error) {
+	if mock.ListDiscountsFunc == nil {
+		panic("RepositoryMock.ListDiscountsFunc: method is nil but Repository.ListDiscounts was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+	}
+	mock.lockListDiscounts.Lock()
+	mock.calls.ListDiscounts = append(mock.calls.ListDiscounts, callInfo)
+	mock.lockListDiscounts.Unlock()
+	return mock.ListDiscountsFunc(ctx, carrierID)
+}
+
+// ListDiscountsCalls gets all the calls that were made to ListDiscounts.
+// Check the length with:
+//
+//	len(mockedRepository.ListDiscountsCalls())
+func (mock *RepositoryMock) ListDiscountsCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}
+	mock.lockListDiscounts.RLock()
+	calls = mock.calls.ListDiscounts
+	mock.lockListDiscounts.RUnlock()
+	return calls
+}
+
+// ListDiscountsInEffect calls ListDiscountsInEffectFunc.
+func (mock *RepositoryMock) ListDiscountsInEffect(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+	if mock.ListDiscountsInEffectFunc == nil {
+		panic("RepositoryMock.ListDiscountsInEffectFunc: method is nil but Repository.ListDiscountsInEffect was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		At  time.Time
+	}{
+		Ctx: ctx,
+		At:  at,
+	}
+	mock.lockListDiscountsInEffect.Lock()
+	mock.calls.ListDiscountsInEffect = append(mock.calls.ListDiscountsInEffect, callInfo)
+	mock.lockListDiscountsInEffect.Unlock()
+	return mock.ListDiscountsInEffectFunc(ctx, at)
+}
+
+// ListDiscountsInEffectCalls gets all the calls that were made to ListDiscountsInEffect.
+// Check the length with:
+//
+//	len(mockedRepository.ListDiscountsInEffectCalls())
+func (mock *RepositoryMock) ListDiscountsInEffectCalls() []struct {
+	Ctx context.Context
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		At  time.Time
+	}
+	mock.lockListDiscountsInEffect.RLock()
+	calls = mock.calls.ListDiscountsInEffect
+	mock.lockListDiscountsInEffect.RUnlock()
+	return calls
+}
+
+// ListFuelSurcharges calls ListFuelSurchargesFunc.
+func (mock *RepositoryMock) ListFuelSurcharges(ctx context.Context) ([]carrier.FuelSurcharge, error) {
+	if mock.ListFuelSurchargesFunc == nil {
+		panic("RepositoryMock.ListFuelSurchargesFunc: method is nil but Repository.ListFuelSurcharges was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListFuelSurcharges.Lock()
+	mock.calls.ListFuelSurcharges = append(mock.calls.ListFuelSurcharges, callInfo)
+	mock.lockListFuelSurcharges.Unlock()
+	return mock.ListFuelSurchargesFunc(ctx)
+}
+
+// ListFuelSurchargesCalls gets all the calls that were made to ListFuelSurcharges.
+// Check the length with:
+//
+//	len(mockedRepository.ListFuelSurchargesCalls())
+func (mock *RepositoryMock) ListFuelSurchargesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListFuelSurcharges.RLock()
+	calls = mock.calls.ListFuelSurcharges
+	mock.lockListFuelSurcharges.RUnlock()
+	return calls
+}
+
+// ListFuelSurchargesInEffect calls ListFuelSurchargesInEffectFunc.
+func (mock *RepositoryMock) ListFuelSurchargesInEffect(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+	if mock.ListFuelSurchargesInEffectFunc == nil {
+		panic("RepositoryMock.ListFuelSurchargesInEffectFunc: method is nil but Repository.ListFuelSurchargesInEffect was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		At  time.Time
+	}{
+		Ctx: ctx,
+		At:  at,
+	}
+	mock.lockListFuelSurchargesInEffect.Lock()
+	mock.calls.ListFuelSurchargesInEffect = append(mock.calls.ListFuelSurchargesInEffect, callInfo)
+	mock.lockListFuelSurchargesInEffect.Unlock()
+	return mock.ListFuelSurchargesInEffectFunc(ctx, at)
+}
+
+// ListFuelSurchargesInEffectCalls gets all the calls that were made to ListFuelSurchargesInEffect.
+// Check the length with:
+//
+//	len(mockedRepository.ListFuelSurchargesInEffectCalls())
+func (mock *RepositoryMock) ListFuelSurchargesInEffectCalls() []struct {
+	Ctx context.Context
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		At  time.Time
+	}
+	mock.lockListFuelSurchargesInEffect.RLock()
+	calls = mock.calls.ListFuelSurchargesInEffect
+	mock.lockListFuelSurchargesInEffect.RUnlock()
+	return calls
+}
@@ -5,7 +5,9 @@ package mocks
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
 	"sync"
 )
 
@@ -22,6 +24,24 @@ var _ carrier.Service = &ServiceMock{}
 //			CreateFunc: func(ctx context.Context, carrierMoqParam *carrier.Carrier) (*carrier.Carrier, error) {
 //				panic("mock out the Create method")
 //			},
+//			CreateDiscountFunc: func(ctx context.Context, discount *carrier.Discount) (*carrier.Discount, error) {
+//				panic("mock out the CreateDiscount method")
+//			},
+//			CreateFuelSurchargeFunc: func(ctx context.Context, surcharge *carrier.FuelSurcharge) (*carrier.FuelSurcharge, error) {
+//				panic("mock out the CreateFuelSurcharge method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			ListByStateFunc: func(ctx context.Context, state states.State) ([]carrier.Carrier, error) {
+//				panic("mock out the ListByState method")
+//			},
+//			ListDiscountsFunc: func(ctx context.Context, carrierID uuid.UUID) ([]carrier.Discount, error) {
+//				panic("mock out the ListDiscounts method")
+//			},
+//			ListFuelSurchargesFunc: func(ctx context.Context) ([]carrier.FuelSurcharge, error) {
+//				panic("mock out the ListFuelSurcharges method")
+//			},
 //		}
 //
 //		// use mockedService in code that requires carrier.Service
@@ -32,6 +52,24 @@ type ServiceMock struct {
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, carrierMoqParam *carrier.Carrier) (*carrier.Carrier, error)
 
+	// CreateDiscountFunc mocks the CreateDiscount method.
+	CreateDiscountFunc func(ctx context.Context, discount *carrier.Discount) (*carrier.Discount, error)
+
+	// CreateFuelSurchargeFunc mocks the CreateFuelSurcharge method.
+	CreateFuelSurchargeFunc func(ctx context.Context, surcharge *carrier.FuelSurcharge) (*carrier.FuelSurcharge, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error)
+
+	// ListByStateFunc mocks the ListByState method.
+	ListByStateFunc func(ctx context.Context, state states.State) ([]carrier.Carrier, error)
+
+	// ListDiscountsFunc mocks the ListDiscounts method.
+	ListDiscountsFunc func(ctx context.Context, carrierID uuid.UUID) ([]carrier.Discount, error)
+
+	// ListFuelSurchargesFunc mocks the ListFuelSurcharges method.
+	ListFuelSurchargesFunc func(ctx context.Context) ([]carrier.FuelSurcharge, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Create holds details about calls to the Create method.
@@ -41,8 +79,54 @@ type ServiceMock struct {
 			// CarrierMoqParam is the carrierMoqParam argument value.
 			CarrierMoqParam *carrier.Carrier
 		}
+		// CreateDiscount holds details about calls to the CreateDiscount method.
+		CreateDiscount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Discount is the discount argument value.
+			Discount *carrier.Discount
+		}
+		// CreateFuelSurcharge holds details about calls to the CreateFuelSurcharge method.
+		CreateFuelSurcharge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Surcharge is the surcharge argument value.
+			Surcharge *carrier.FuelSurcharge
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// ListByState holds details about calls to the ListByState method.
+		ListByState []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// State is the state argument value.
+			State states.State
+		}
+		// ListDiscounts holds details about calls to the ListDiscounts method.
+		ListDiscounts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+		}
+		// ListFuelSurcharges holds details about calls to the ListFuelSurcharges method.
+		ListFuelSurcharges []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 	}
-	lockCreate sync.RWMutex
+	lockCreate              sync.RWMutex
+	lockCreateDiscount      sync.RWMutex
+	lockCreateFuelSurcharge sync.RWMutex
+	lockGetByID             sync.RWMutex
+	lockListByState         sync.RWMutex
+	lockListDiscounts       sync.RWMutex
+	lockListFuelSurcharges  sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -80,3 +164,215 @@ func (mock *ServiceMock) CreateCalls() []struct {
 	mock.lockCreate.RUnlock()
 	return calls
 }
+
+// CreateDiscount calls CreateDiscountFunc.
+func (mock *ServiceMock) CreateDiscount(ctx context.Context, discount *carrier.Discount) (*carrier.Discount, error) {
+	if mock.CreateDiscountFunc == nil {
+		panic("ServiceMock.CreateDiscountFunc: method is nil but Service.CreateDiscount was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Discount *carrier.Discount
+	}{
+		Ctx:      ctx,
+		Discount: discount,
+	}
+	mock.lockCreateDiscount.Lock()
+	mock.calls.CreateDiscount = append(mock.calls.CreateDiscount, callInfo)
+	mock.lockCreateDiscount.Unlock()
+	return mock.CreateDiscountFunc(ctx, discount)
+}
+
+// CreateDiscountCalls gets all the calls that were made to CreateDiscount.
+// Check the length with:
+//
+//	len(mockedService.CreateDiscountCalls())
+func (mock *ServiceMock) CreateDiscountCalls() []struct {
+	Ctx      context.Context
+	Discount *carrier.Discount
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Discount *carrier.Discount
+	}
+	mock.lockCreateDiscount.RLock()
+	calls = mock.calls.CreateDiscount
+	mock.lockCreateDiscount.RUnlock()
+	return calls
+}
+
+// CreateFuelSurcharge calls CreateFuelSurchargeFunc.
+func (mock *ServiceMock) CreateFuelSurcharge(ctx context.Context, surcharge *carrier.FuelSurcharge) (*carrier.FuelSurcharge, error) {
+	if mock.CreateFuelSurchargeFunc == nil {
+		panic("ServiceMock.CreateFuelSurchargeFunc: method is nil but Service.CreateFuelSurcharge was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Surcharge *carrier.FuelSurcharge
+	}{
+		Ctx:       ctx,
+		Surcharge: surcharge,
+	}
+	mock.lockCreateFuelSurcharge.Lock()
+	mock.calls.CreateFuelSurcharge = append(mock.calls.CreateFuelSurcharge, callInfo)
+	mock.lockCreateFuelSurcharge.Unlock()
+	return mock.CreateFuelSurchargeFunc(ctx, surcharge)
+}
+
+// CreateFuelSurchargeCalls gets all the calls that were made to CreateFuelSurcharge.
+// Check the length with:
+//
+//	len(mockedService.CreateFuelSurchargeCalls())
+func (mock *ServiceMock) CreateFuelSurchargeCalls() []struct {
+	Ctx       context.Context
+	Surcharge *carrier.FuelSurcharge
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Surcharge *carrier.FuelSurcharge
+	}
+	mock.lockCreateFuelSurcharge.RLock()
+	calls = mock.calls.CreateFuelSurcharge
+	mock.lockCreateFuelSurcharge.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *ServiceMock) GetByID(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+	if mock.GetByIDFunc == nil {
+		panic("ServiceMock.GetByIDFunc: method is nil but Service.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedService.GetByIDCalls())
+func (mock *ServiceMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// ListByState calls ListByStateFunc.
+func (mock *ServiceMock) ListByState(ctx context.Context, state states.State) ([]carrier.Carrier, error) {
+	if mock.ListByStateFunc == nil {
+		panic("ServiceMock.ListByStateFunc: method is nil but Service.ListByState was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		State states.State
+	}{
+		Ctx:   ctx,
+		State: state,
+	}
+	mock.lockListByState.Lock()
+	mock.calls.ListByState = append(mock.calls.ListByState, callInfo)
+	mock.lockListByState.Unlock()
+	return mock.ListByStateFunc(ctx, state)
+}
+
+// ListByStateCalls gets all the calls that were made to ListByState.
+// Check the length with:
+//
+//	len(mockedService.ListByStateCalls())
+func (mock *ServiceMock) ListByStateCalls() []struct {
+	Ctx   context.Context
+	State states.State
+} {
+	var calls []struct {
+		Ctx   context.Context
+		State states.State
+	}
+	mock.lockListByState.RLock()
+	calls = mock.calls.ListByState
+	mock.lockListByState.RUnlock()
+	return calls
+}
+
+// ListDiscounts calls ListDiscountsFunc.
+func (mock *ServiceMock) ListDiscounts(ctx context.Context, carrierID uuid.UUID) ([]carrier.Discount, error) {
+	if mock.ListDiscountsFunc == nil {
+		panic("ServiceMock.ListDiscountsFunc: method is nil but Service.ListDiscounts was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+	}
+	mock.lockListDiscounts.Lock()
+	mock.calls.ListDiscounts = append(mock.calls.ListDiscounts, callInfo)
+	mock.lockListDiscounts.Unlock()
+	return mock.ListDiscountsFunc(ctx, carrierID)
+}
+
+// ListDiscountsCalls gets all the calls that were made to ListDiscounts.
+// Check the length with:
+//
+//	len(mockedService.ListDiscountsCalls())
+func (mock *ServiceMock) ListDiscountsCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}
+	mock.lockListDiscounts.RLock()
+	calls = mock.calls.ListDiscounts
+	mock.lockListDiscounts.RUnlock()
+	return calls
+}
+
+// ListFuelSurcharges calls ListFuelSurchargesFunc.
+func (mock *ServiceMock) ListFuelSurcharges(ctx context.Context) ([]carrier.FuelSurcharge, error) {
+	if mock.ListFuelSurchargesFunc == nil {
+		panic("ServiceMock.ListFuelSurchargesFunc: method is nil but Service.ListFuelSurcharges was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListFuelSurcharges.Lock()
+	mock.calls.ListFuelSurcharges = append(mock.calls.ListFuelSurcharges, callInfo)
+	mock.lockListFuelSurcharges.Unlock()
+	return mock.ListFuelSurchargesFunc(ctx)
+}
+
+// ListFuelSurchargesCalls gets all the calls that were made to ListFuelSurcharges.
+// Check the length with:
+//
+//	len(mockedService.ListFuelSurchargesCalls())
+func (mock *ServiceMock) ListFuelSurchargesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListFuelSurcharges.RLock()
+	calls = mock.calls.ListFuelSurcharges
+	mock.lockListFuelSurcharges.RUnlock()
+	return calls
+}
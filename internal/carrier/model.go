@@ -1,6 +1,8 @@
 package carrier
 
 import (
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,11 +19,118 @@ type Carrier struct {
 }
 
 type Policy struct {
+	ID                  uuid.UUID       `json:"id"`
+	CarrierID           uuid.UUID       `json:"carrier_id"`
+	Region              states.Region   `json:"region"`
+	EstimatedDays       int             `json:"estimated_days"`
+	PricePerKg          decimal.Decimal `json:"price_per_kg"`
+	AdValoremPercentage decimal.Decimal `json:"ad_valorem_percentage"`
+	GRISPercentage      decimal.Decimal `json:"gris_percentage"`
+	GRISMinimum         decimal.Decimal `json:"gris_minimum"`
+	TollPerFraction     decimal.Decimal `json:"toll_per_fraction"`
+	TDEPercentage       decimal.Decimal `json:"tde_percentage"`
+	TDEUFs              []string        `json:"tde_ufs"`
+	Version             int             `json:"version"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
+
+func (p Policy) AppliesTDE(state states.State) bool {
+	return slices.Contains(p.TDEUFs, state.Sigla)
+}
+
+type Coverage struct {
+	State  states.State `json:"state"`
+	Policy Policy       `json:"policy"`
+}
+
+func (c Carrier) Coverage() []Coverage {
+	var coverage []Coverage
+	for _, p := range c.Policies {
+		for _, state := range p.Region.States {
+			coverage = append(coverage, Coverage{State: state, Policy: p})
+		}
+	}
+
+	sort.Slice(coverage, func(i, j int) bool {
+		return coverage[i].State.Sigla < coverage[j].State.Sigla
+	})
+
+	return coverage
+}
+
+type FuelSurcharge struct {
 	ID            uuid.UUID       `json:"id"`
-	CarrierID     uuid.UUID       `json:"carrier_id"`
-	Region        states.Region   `json:"region"`
-	EstimatedDays int             `json:"estimated_days"`
-	PricePerKg    decimal.Decimal `json:"price_per_kg"`
+	CarrierID     *uuid.UUID      `json:"carrier_id"`
+	Percentage    decimal.Decimal `json:"percentage"`
+	EffectiveFrom time.Time       `json:"effective_from"`
 	CreatedAt     time.Time       `json:"created_at"`
-	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+func FuelSurchargeFor(surcharges []FuelSurcharge, carrierID uuid.UUID) decimal.Decimal {
+	global := decimal.Zero
+	for _, s := range surcharges {
+		if s.CarrierID == nil {
+			global = s.Percentage
+			continue
+		}
+		if *s.CarrierID == carrierID {
+			return s.Percentage
+		}
+	}
+	return global
+}
+
+type Discount struct {
+	ID               uuid.UUID       `json:"id"`
+	CarrierID        uuid.UUID       `json:"carrier_id"`
+	Name             string          `json:"name"`
+	Percentage       decimal.Decimal `json:"percentage"`
+	Region           string          `json:"region"`
+	MinWeightKg      decimal.Decimal `json:"min_weight_kg"`
+	MaxWeightKg      decimal.Decimal `json:"max_weight_kg"`
+	MinMonthlyVolume int             `json:"min_monthly_volume"`
+	ValidFrom        time.Time       `json:"valid_from"`
+	ValidUntil       *time.Time      `json:"valid_until"`
+	CreatedAt        time.Time       `json:"created_at"`
+}
+
+func (d Discount) Eligible(region string, weightKg decimal.Decimal, monthlyVolume int, at time.Time) bool {
+	if at.Before(d.ValidFrom) || (d.ValidUntil != nil && !at.Before(*d.ValidUntil)) {
+		return false
+	}
+	if d.Region != "" && d.Region != region {
+		return false
+	}
+	if weightKg.LessThan(d.MinWeightKg) {
+		return false
+	}
+	if d.MaxWeightKg.IsPositive() && weightKg.GreaterThan(d.MaxWeightKg) {
+		return false
+	}
+	return monthlyVolume >= d.MinMonthlyVolume
+}
+
+func BestDiscount(
+	discounts []Discount,
+	carrierID uuid.UUID,
+	region string,
+	weightKg decimal.Decimal,
+	monthlyVolume int,
+	at time.Time,
+) (Discount, bool) {
+	var (
+		best  Discount
+		found bool
+	)
+	for _, d := range discounts {
+		if d.CarrierID != carrierID || !d.Eligible(region, weightKg, monthlyVolume, at) {
+			continue
+		}
+		if !found || d.Percentage.GreaterThan(best.Percentage) {
+			best = d
+			found = true
+		}
+	}
+	return best, found
 }
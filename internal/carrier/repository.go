@@ -20,6 +20,12 @@ type Repository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*Carrier, error)
 	ListAll(ctx context.Context) ([]Carrier, error)
 	ListAllByRegion(ctx context.Context, region string) ([]Carrier, error)
+	CreateFuelSurcharge(ctx context.Context, surcharge *FuelSurcharge) (uuid.UUID, error)
+	ListFuelSurcharges(ctx context.Context) ([]FuelSurcharge, error)
+	ListFuelSurchargesInEffect(ctx context.Context, at time.Time) ([]FuelSurcharge, error)
+	CreateDiscount(ctx context.Context, discount *Discount) (uuid.UUID, error)
+	ListDiscounts(ctx context.Context, carrierID uuid.UUID) ([]Discount, error)
+	ListDiscountsInEffect(ctx context.Context, at time.Time) ([]Discount, error)
 }
 
 type repository struct {
@@ -38,31 +44,80 @@ const (
 `
 	queryGetCarrierByID = `
 	SELECT c.id, c.name, c.created_at, c.updated_at,
-		   p.id, p.carrier_id, p.region, p.estimated_days, p.price_per_kg, p.created_at, p.updated_at
+		   p.id, p.carrier_id, p.region, p.estimated_days, p.price_per_kg, p.version, p.created_at, p.updated_at,
+		   COALESCE(p.ad_valorem_percentage, 0), COALESCE(p.gris_percentage, 0), COALESCE(p.gris_minimum, 0),
+		   COALESCE(p.toll_per_fraction, 0), COALESCE(p.tde_percentage, 0), COALESCE(p.tde_ufs, '{}')
 	FROM carriers c
 	LEFT JOIN carrier_policies p ON c.id = p.carrier_id
 	WHERE c.id = $1
 `
 
 	queryInsertCarrierPolicy = `
-	INSERT INTO carrier_policies (carrier_id, region, estimated_days, price_per_kg)
-	VALUES ($1, $2, $3, $4)
+	INSERT INTO carrier_policies (carrier_id, region, estimated_days, price_per_kg,
+	                              ad_valorem_percentage, gris_percentage, gris_minimum,
+	                              toll_per_fraction, tde_percentage, tde_ufs)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, '{}'))
 `
 
 	queryListCarriersWithPolicies = `
 	SELECT c.id, c.name, c.created_at, c.updated_at,
-		   p.region, p.estimated_days, p.price_per_kg
+		   p.id, p.region, p.estimated_days, p.price_per_kg, p.version,
+		   COALESCE(p.ad_valorem_percentage, 0), COALESCE(p.gris_percentage, 0), COALESCE(p.gris_minimum, 0),
+		   COALESCE(p.toll_per_fraction, 0), COALESCE(p.tde_percentage, 0), COALESCE(p.tde_ufs, '{}')
 	FROM carriers c
 	LEFT JOIN carrier_policies p ON c.id = p.carrier_id
 `
 
 	queryListCarriersByRegion = `
 	SELECT c.id, c.name, c.created_at, c.updated_at,
-		   p.region, p.estimated_days, p.price_per_kg
+		   p.id, p.region, p.estimated_days, p.price_per_kg, p.version,
+		   p.ad_valorem_percentage, p.gris_percentage, p.gris_minimum,
+		   p.toll_per_fraction, p.tde_percentage, p.tde_ufs
 	FROM carriers c
 	INNER JOIN carrier_policies p ON c.id = p.carrier_id
 	WHERE p.region = $1
 `
+
+	queryInsertFuelSurcharge = `
+	INSERT INTO fuel_surcharges (carrier_id, percentage, effective_from, created_at)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id
+`
+
+	queryListFuelSurcharges = `
+	SELECT id, carrier_id, percentage, effective_from, created_at
+	FROM fuel_surcharges
+	ORDER BY effective_from DESC
+`
+
+	queryListFuelSurchargesInEffect = `
+	SELECT DISTINCT ON (carrier_id) id, carrier_id, percentage, effective_from, created_at
+	FROM fuel_surcharges
+	WHERE effective_from <= $1
+	ORDER BY carrier_id, effective_from DESC
+`
+
+	queryInsertDiscount = `
+	INSERT INTO carrier_discounts (carrier_id, name, percentage, region, min_weight_kg, max_weight_kg,
+	                               min_monthly_volume, valid_from, valid_until, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	RETURNING id
+`
+
+	queryListDiscounts = `
+	SELECT id, carrier_id, name, percentage, region, min_weight_kg, max_weight_kg,
+	       min_monthly_volume, valid_from, valid_until, created_at
+	FROM carrier_discounts
+	WHERE carrier_id = $1
+	ORDER BY valid_from DESC
+`
+
+	queryListDiscountsInEffect = `
+	SELECT id, carrier_id, name, percentage, region, min_weight_kg, max_weight_kg,
+	       min_monthly_volume, valid_from, valid_until, created_at
+	FROM carrier_discounts
+	WHERE valid_from <= $1 AND (valid_until IS NULL OR valid_until > $1)
+`
 )
 
 func (r *repository) Create(ctx context.Context, carrier *Carrier) (uuid.UUID, error) {
@@ -84,6 +139,12 @@ func (r *repository) Create(ctx context.Context, carrier *Carrier) (uuid.UUID, e
 			p.Region.Name,
 			p.EstimatedDays,
 			p.PricePerKg.String(),
+			p.AdValoremPercentage,
+			p.GRISPercentage,
+			p.GRISMinimum,
+			p.TollPerFraction,
+			p.TDEPercentage,
+			p.TDEUFs,
 		)
 		if err != nil {
 			return uuid.Nil, fmt.Errorf("error inserting policy for region %s: %w", p.Region, err)
@@ -104,19 +165,24 @@ func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*Carrier, error
 
 	for rows.Next() {
 		var (
-			cID, policyID, policyCarrierID     uuid.UUID
+			cID                                uuid.UUID
+			policyID, policyCarrierID          *uuid.UUID
 			name                               string
 			carrierCreatedAt, carrierUpdatedAt time.Time
 
 			region                           *string
 			estimatedDays                    *int
 			priceStr                         *string
+			version                          *int
 			policyCreatedAt, policyUpdatedAt *time.Time
+			components                       Policy
 		)
 
 		if err := rows.Scan(
 			&cID, &name, &carrierCreatedAt, &carrierUpdatedAt,
-			&policyID, &policyCarrierID, &region, &estimatedDays, &priceStr, &policyCreatedAt, &policyUpdatedAt,
+			&policyID, &policyCarrierID, &region, &estimatedDays, &priceStr, &version, &policyCreatedAt, &policyUpdatedAt,
+			&components.AdValoremPercentage, &components.GRISPercentage, &components.GRISMinimum,
+			&components.TollPerFraction, &components.TDEPercentage, &components.TDEUFs,
 		); err != nil {
 			return nil, err
 		}
@@ -137,12 +203,15 @@ func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*Carrier, error
 				return nil, fmt.Errorf("invalid decimal price_per_kg: %w", err)
 			}
 
-			policy := Policy{
-				ID:            policyID,
-				CarrierID:     policyCarrierID,
-				Region:        states.Regions[*region],
-				EstimatedDays: *estimatedDays,
-				PricePerKg:    priceDec,
+			policy := components
+			policy.ID = *policyID
+			policy.CarrierID = *policyCarrierID
+			policy.Region = states.Regions[*region]
+			policy.EstimatedDays = *estimatedDays
+			policy.PricePerKg = priceDec
+
+			if version != nil {
+				policy.Version = *version
 			}
 
 			if policyCreatedAt != nil {
@@ -182,12 +251,20 @@ func (r *repository) ListAll(ctx context.Context) ([]Carrier, error) {
 			name          string
 			createdAt     time.Time
 			updatedAt     time.Time
+			policyID      *uuid.UUID
 			region        *string
 			estimatedDays *int
 			priceStr      *string
+			version       *int
+			components    Policy
 		)
 
-		err := rows.Scan(&id, &name, &createdAt, &updatedAt, &region, &estimatedDays, &priceStr)
+		err := rows.Scan(
+			&id, &name, &createdAt, &updatedAt,
+			&policyID, &region, &estimatedDays, &priceStr, &version,
+			&components.AdValoremPercentage, &components.GRISPercentage, &components.GRISMinimum,
+			&components.TollPerFraction, &components.TDEPercentage, &components.TDEUFs,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -210,10 +287,16 @@ func (r *repository) ListAll(ctx context.Context) ([]Carrier, error) {
 				return nil, fmt.Errorf("invalid decimal price_per_kg: %w", err)
 			}
 
-			policy := Policy{
-				Region:        states.Regions[*region],
-				EstimatedDays: *estimatedDays,
-				PricePerKg:    priceDec,
+			policy := components
+			policy.CarrierID = id
+			policy.Region = states.Regions[*region]
+			policy.EstimatedDays = *estimatedDays
+			policy.PricePerKg = priceDec
+			if policyID != nil {
+				policy.ID = *policyID
+			}
+			if version != nil {
+				policy.Version = *version
 			}
 			carrier.Policies = append(carrier.Policies, policy)
 		}
@@ -246,12 +329,20 @@ func (r *repository) ListAllByRegion(ctx context.Context, region string) ([]Carr
 			name          string
 			createdAt     time.Time
 			updatedAt     time.Time
+			policyID      uuid.UUID
 			regionStr     string
 			estimatedDays int
 			priceStr      string
+			version       int
+			components    Policy
 		)
 
-		err := rows.Scan(&id, &name, &createdAt, &updatedAt, &regionStr, &estimatedDays, &priceStr)
+		err := rows.Scan(
+			&id, &name, &createdAt, &updatedAt,
+			&policyID, &regionStr, &estimatedDays, &priceStr, &version,
+			&components.AdValoremPercentage, &components.GRISPercentage, &components.GRISMinimum,
+			&components.TollPerFraction, &components.TDEPercentage, &components.TDEUFs,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -273,11 +364,13 @@ func (r *repository) ListAllByRegion(ctx context.Context, region string) ([]Carr
 			return nil, fmt.Errorf("invalid decimal price_per_kg: %w", err)
 		}
 
-		policy := Policy{
-			Region:        states.Regions[regionStr],
-			EstimatedDays: estimatedDays,
-			PricePerKg:    priceDec,
-		}
+		policy := components
+		policy.ID = policyID
+		policy.CarrierID = id
+		policy.Region = states.Regions[regionStr]
+		policy.EstimatedDays = estimatedDays
+		policy.PricePerKg = priceDec
+		policy.Version = version
 
 		carrier.Policies = append(carrier.Policies, policy)
 	}
@@ -293,3 +386,116 @@ func (r *repository) ListAllByRegion(ctx context.Context, region string) ([]Carr
 
 	return carriers, nil
 }
+
+func (r *repository) CreateFuelSurcharge(
+	ctx context.Context,
+	surcharge *FuelSurcharge,
+) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, queryInsertFuelSurcharge,
+		surcharge.CarrierID,
+		surcharge.Percentage,
+		surcharge.EffectiveFrom,
+		surcharge.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to insert fuel surcharge: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repository) ListFuelSurcharges(ctx context.Context) ([]FuelSurcharge, error) {
+	return r.queryFuelSurcharges(ctx, queryListFuelSurcharges)
+}
+
+func (r *repository) ListFuelSurchargesInEffect(
+	ctx context.Context,
+	at time.Time,
+) ([]FuelSurcharge, error) {
+	return r.queryFuelSurcharges(ctx, queryListFuelSurchargesInEffect, at)
+}
+
+func (r *repository) queryFuelSurcharges(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]FuelSurcharge, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	surcharges := []FuelSurcharge{}
+	for rows.Next() {
+		var s FuelSurcharge
+		if err := rows.Scan(
+			&s.ID, &s.CarrierID, &s.Percentage, &s.EffectiveFrom, &s.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		surcharges = append(surcharges, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return surcharges, nil
+}
+
+func (r *repository) CreateDiscount(ctx context.Context, discount *Discount) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, queryInsertDiscount,
+		discount.CarrierID,
+		discount.Name,
+		discount.Percentage,
+		discount.Region,
+		discount.MinWeightKg,
+		discount.MaxWeightKg,
+		discount.MinMonthlyVolume,
+		discount.ValidFrom,
+		discount.ValidUntil,
+		discount.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to insert discount: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repository) ListDiscounts(ctx context.Context, carrierID uuid.UUID) ([]Discount, error) {
+	return r.queryDiscounts(ctx, queryListDiscounts, carrierID)
+}
+
+func (r *repository) ListDiscountsInEffect(ctx context.Context, at time.Time) ([]Discount, error) {
+	return r.queryDiscounts(ctx, queryListDiscountsInEffect, at)
+}
+
+func (r *repository) queryDiscounts(ctx context.Context, query string, args ...any) ([]Discount, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	discounts := []Discount{}
+	for rows.Next() {
+		var d Discount
+		if err := rows.Scan(
+			&d.ID, &d.CarrierID, &d.Name, &d.Percentage, &d.Region, &d.MinWeightKg, &d.MaxWeightKg,
+			&d.MinMonthlyVolume, &d.ValidFrom, &d.ValidUntil, &d.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return discounts, nil
+}
@@ -2,13 +2,22 @@ package carrier
 
 import (
 	"context"
+	"sort"
 
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
 	log "go.uber.org/zap"
 )
 
 //go:generate moq -pkg mocks -out mocks/service.go . Service
 type Service interface {
 	Create(ctx context.Context, carrier *Carrier) (*Carrier, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Carrier, error)
+	ListByState(ctx context.Context, state states.State) ([]Carrier, error)
+	CreateFuelSurcharge(ctx context.Context, surcharge *FuelSurcharge) (*FuelSurcharge, error)
+	ListFuelSurcharges(ctx context.Context) ([]FuelSurcharge, error)
+	CreateDiscount(ctx context.Context, discount *Discount) (*Discount, error)
+	ListDiscounts(ctx context.Context, carrierID uuid.UUID) ([]Discount, error)
 }
 
 type service struct {
@@ -30,3 +39,94 @@ func (s *service) Create(ctx context.Context, carrier *Carrier) (*Carrier, error
 	carrier.ID = id
 	return carrier, nil
 }
+
+func (s *service) GetByID(ctx context.Context, id uuid.UUID) (*Carrier, error) {
+	found, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		log.L().
+			Error("failed to get carrier by ID", log.String("carrier_id", id.String()), log.Error(err))
+		return nil, err
+	}
+	return found, nil
+}
+
+func (s *service) ListByState(ctx context.Context, state states.State) ([]Carrier, error) {
+	carriers, err := s.repo.ListAllByRegion(ctx, state.Region)
+	if err != nil {
+		log.L().
+			Error("failed to list carriers by region", log.String("uf", state.Sigla), log.String("region", state.Region), log.Error(err))
+		return nil, err
+	}
+
+	sort.Slice(carriers, func(i, j int) bool {
+		return carriers[i].Name < carriers[j].Name
+	})
+
+	return carriers, nil
+}
+
+func (s *service) CreateFuelSurcharge(
+	ctx context.Context,
+	surcharge *FuelSurcharge,
+) (*FuelSurcharge, error) {
+	if surcharge.CarrierID != nil {
+		if _, err := s.repo.GetByID(ctx, *surcharge.CarrierID); err != nil {
+			log.L().
+				Error("failed to get carrier for fuel surcharge", log.String("carrier_id", surcharge.CarrierID.String()), log.Error(err))
+			return nil, err
+		}
+	}
+
+	id, err := s.repo.CreateFuelSurcharge(ctx, surcharge)
+	if err != nil {
+		log.L().
+			Error("failed to create fuel surcharge", log.String("percentage", surcharge.Percentage.String()), log.Error(err))
+		return nil, err
+	}
+
+	surcharge.ID = id
+	return surcharge, nil
+}
+
+func (s *service) ListFuelSurcharges(ctx context.Context) ([]FuelSurcharge, error) {
+	surcharges, err := s.repo.ListFuelSurcharges(ctx)
+	if err != nil {
+		log.L().Error("failed to list fuel surcharges", log.Error(err))
+		return nil, err
+	}
+	return surcharges, nil
+}
+
+func (s *service) CreateDiscount(ctx context.Context, discount *Discount) (*Discount, error) {
+	if _, err := s.repo.GetByID(ctx, discount.CarrierID); err != nil {
+		log.L().
+			Error("failed to get carrier for discount", log.String("carrier_id", discount.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	id, err := s.repo.CreateDiscount(ctx, discount)
+	if err != nil {
+		log.L().
+			Error("failed to create discount", log.String("carrier_id", discount.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	discount.ID = id
+	return discount, nil
+}
+
+func (s *service) ListDiscounts(ctx context.Context, carrierID uuid.UUID) ([]Discount, error) {
+	if _, err := s.repo.GetByID(ctx, carrierID); err != nil {
+		log.L().
+			Error("failed to get carrier for discounts", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	discounts, err := s.repo.ListDiscounts(ctx, carrierID)
+	if err != nil {
+		log.L().
+			Error("failed to list discounts", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return nil, err
+	}
+	return discounts, nil
+}
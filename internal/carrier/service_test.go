@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
 )
 
 func TestService_Create_Success(t *testing.T) {
@@ -44,3 +47,158 @@ func TestService_Create_Error(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, created)
 }
+
+func TestService_CreateFuelSurcharge_Success(t *testing.T) {
+	ctx := context.Background()
+	expectedID := uuid.New()
+	carrierID := uuid.New()
+
+	repo := &mocks.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return &carrier.Carrier{ID: id}, nil
+		},
+		CreateFuelSurchargeFunc: func(ctx context.Context, s *carrier.FuelSurcharge) (uuid.UUID, error) {
+			return expectedID, nil
+		},
+	}
+
+	svc := carrier.NewService(repo)
+	created, err := svc.CreateFuelSurcharge(ctx, &carrier.FuelSurcharge{
+		CarrierID:  &carrierID,
+		Percentage: decimal.NewFromFloat(12.5),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedID, created.ID)
+}
+
+func TestService_CreateFuelSurcharge_CarrierNotFound(t *testing.T) {
+	ctx := context.Background()
+	carrierID := uuid.New()
+
+	repo := &mocks.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return nil, carrier.ErrCarrierNotFound
+		},
+	}
+
+	svc := carrier.NewService(repo)
+	created, err := svc.CreateFuelSurcharge(ctx, &carrier.FuelSurcharge{
+		CarrierID:  &carrierID,
+		Percentage: decimal.NewFromFloat(12.5),
+	})
+	assert.ErrorIs(t, err, carrier.ErrCarrierNotFound)
+	assert.Nil(t, created)
+	assert.Empty(t, repo.CreateFuelSurchargeCalls())
+}
+
+func TestFuelSurchargeFor_PrefersCarrierSpecific(t *testing.T) {
+	carrierID := uuid.New()
+	surcharges := []carrier.FuelSurcharge{
+		{Percentage: decimal.NewFromFloat(8)},
+		{CarrierID: &carrierID, Percentage: decimal.NewFromFloat(11)},
+	}
+
+	assert.True(t, decimal.NewFromFloat(11).Equal(carrier.FuelSurchargeFor(surcharges, carrierID)))
+	assert.True(t, decimal.NewFromFloat(8).Equal(carrier.FuelSurchargeFor(surcharges, uuid.New())))
+	assert.True(t, carrier.FuelSurchargeFor(nil, carrierID).IsZero())
+}
+
+func TestService_CreateDiscount_CarrierNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &mocks.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return nil, carrier.ErrCarrierNotFound
+		},
+	}
+
+	svc := carrier.NewService(repo)
+	created, err := svc.CreateDiscount(ctx, &carrier.Discount{
+		CarrierID:  uuid.New(),
+		Percentage: decimal.NewFromFloat(5),
+	})
+	assert.ErrorIs(t, err, carrier.ErrCarrierNotFound)
+	assert.Nil(t, created)
+	assert.Empty(t, repo.CreateDiscountCalls())
+}
+
+func TestDiscount_Eligible(t *testing.T) {
+	now := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+	until := now.Add(24 * time.Hour)
+	d := carrier.Discount{
+		Region:           states.Sudeste.Name,
+		MinWeightKg:      decimal.NewFromFloat(100),
+		MaxWeightKg:      decimal.NewFromFloat(500),
+		MinMonthlyVolume: 10,
+		ValidFrom:        now.Add(-24 * time.Hour),
+		ValidUntil:       &until,
+	}
+	weight := decimal.NewFromFloat(200)
+
+	assert.True(t, d.Eligible(states.Sudeste.Name, weight, 10, now))
+	assert.False(t, d.Eligible(states.Norte.Name, weight, 10, now))
+	assert.False(t, d.Eligible(states.Sudeste.Name, decimal.NewFromFloat(50), 10, now))
+	assert.False(t, d.Eligible(states.Sudeste.Name, decimal.NewFromFloat(600), 10, now))
+	assert.False(t, d.Eligible(states.Sudeste.Name, weight, 9, now))
+	assert.False(t, d.Eligible(states.Sudeste.Name, weight, 10, until))
+}
+
+func TestBestDiscount_PicksHighestEligible(t *testing.T) {
+	now := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+	carrierID := uuid.New()
+	discounts := []carrier.Discount{
+		{CarrierID: carrierID, Name: "base", Percentage: decimal.NewFromFloat(3), ValidFrom: now.AddDate(0, -1, 0)},
+		{
+			CarrierID:        carrierID,
+			Name:             "volume",
+			Percentage:       decimal.NewFromFloat(8),
+			MinMonthlyVolume: 20,
+			ValidFrom:        now.AddDate(0, -1, 0),
+		},
+		{CarrierID: uuid.New(), Name: "other", Percentage: decimal.NewFromFloat(15), ValidFrom: now.AddDate(0, -1, 0)},
+	}
+
+	d, ok := carrier.BestDiscount(discounts, carrierID, states.Sudeste.Name, decimal.NewFromInt(1), 5, now)
+	assert.True(t, ok)
+	assert.Equal(t, "base", d.Name)
+
+	d, ok = carrier.BestDiscount(discounts, carrierID, states.Sudeste.Name, decimal.NewFromInt(1), 20, now)
+	assert.True(t, ok)
+	assert.Equal(t, "volume", d.Name)
+
+	_, ok = carrier.BestDiscount(discounts, uuid.New(), states.Sudeste.Name, decimal.NewFromInt(1), 20, now)
+	assert.False(t, ok)
+}
+
+func TestService_ListByState_SortedByName(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &mocks.RepositoryMock{
+		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
+			return []carrier.Carrier{{Name: "Zeta"}, {Name: "Alpha"}}, nil
+		},
+	}
+
+	svc := carrier.NewService(repo)
+	carriers, err := svc.ListByState(ctx, states.RR)
+	assert.NoError(t, err)
+	assert.Len(t, carriers, 2)
+	assert.Equal(t, "Alpha", carriers[0].Name)
+	assert.Equal(t, states.Norte.Name, repo.ListAllByRegionCalls()[0].Region)
+}
+
+func TestCarrier_Coverage_ExpandsRegions(t *testing.T) {
+	c := carrier.Carrier{
+		Policies: []carrier.Policy{
+			{Region: states.Sul, EstimatedDays: 4},
+			{Region: states.Sudeste, EstimatedDays: 2},
+		},
+	}
+
+	coverage := c.Coverage()
+	assert.Len(t, coverage, len(states.Sul.States)+len(states.Sudeste.States))
+	assert.Equal(t, "ES", coverage[0].State.Sigla)
+	for _, cov := range coverage {
+		assert.Equal(t, cov.State.Region, cov.Policy.Region.Name)
+	}
+}
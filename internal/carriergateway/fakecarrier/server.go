@@ -0,0 +1,168 @@
+package fakecarrier
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	mathrand "math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway"
+)
+
+type Config struct {
+	APIKey        string
+	BasePrice     decimal.Decimal
+	PricePerKg    decimal.Decimal
+	EstimatedDays int
+	ServedUFs     []string
+	Latency       time.Duration
+	FailureRate   float64
+}
+
+type server struct {
+	config    Config
+	served    map[string]bool
+	mu        sync.Mutex
+	booked    map[string]string
+	cancelled map[string]bool
+}
+
+func NewHandler(config Config) http.Handler {
+	s := &server{
+		config:    config,
+		served:    map[string]bool{},
+		booked:    map[string]string{},
+		cancelled: map[string]bool{},
+	}
+	for _, uf := range config.ServedUFs {
+		s.served[strings.ToUpper(uf)] = true
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /rates", s.rate)
+	mux.HandleFunc("POST /bookings", s.book)
+	mux.HandleFunc("DELETE /bookings/{contract_id}", s.cancel)
+
+	return s.middleware(mux)
+}
+
+func (s *server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.config.APIKey != "" && r.Header.Get("Authorization") != "Bearer "+s.config.APIKey {
+			writeError(w, http.StatusUnauthorized, "invalid api key")
+			return
+		}
+
+		if s.config.Latency > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(s.config.Latency):
+			}
+		}
+
+		if s.config.FailureRate > 0 && mathrand.Float64() < s.config.FailureRate {
+			writeError(w, http.StatusServiceUnavailable, "carrier temporarily unavailable")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *server) rate(w http.ResponseWriter, r *http.Request) {
+	var request carriergateway.RateRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid rate request")
+		return
+	}
+	if !request.WeightKg.IsPositive() {
+		writeError(w, http.StatusBadRequest, "weight_kg must be positive")
+		return
+	}
+	if len(s.served) > 0 && !s.served[strings.ToUpper(request.DestinationUF)] {
+		writeError(w, http.StatusNotFound, "destination not served")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, carriergateway.Rate{
+		Reference:     "RT-" + randomHex(6),
+		Price:         s.config.BasePrice.Add(s.config.PricePerKg.Mul(request.WeightKg)).Round(2),
+		EstimatedDays: s.config.EstimatedDays,
+	})
+}
+
+func (s *server) book(w http.ResponseWriter, r *http.Request) {
+	var request carriergateway.BookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid booking request")
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+
+	s.mu.Lock()
+	if s.cancelled[key] {
+		s.mu.Unlock()
+		writeError(w, http.StatusConflict, "booking was cancelled")
+		return
+	}
+	code, ok := s.booked[key]
+	if !ok {
+		code = trackingCode()
+		if key != "" {
+			s.booked[key] = code
+		}
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, carriergateway.Booking{TrackingCode: code})
+}
+
+func (s *server) cancel(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("contract_id")
+
+	s.mu.Lock()
+	_, booked := s.booked[key]
+	if booked {
+		delete(s.booked, key)
+		s.cancelled[key] = true
+	}
+	cancelled := s.cancelled[key]
+	s.mu.Unlock()
+
+	if !cancelled {
+		writeError(w, http.StatusNotFound, "booking not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+func trackingCode() string {
+	n, _ := rand.Int(rand.Reader, big.NewInt(1_000_000_000))
+	return fmt.Sprintf("FK%09dBR", n.Int64())
+}
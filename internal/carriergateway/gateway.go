@@ -0,0 +1,56 @@
+package carriergateway
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrCircuitOpen     = errors.New("carrier gateway circuit is open")
+	ErrRateUnavailable = errors.New("carrier does not serve the route")
+	ErrRejected        = errors.New("carrier rejected the request")
+)
+
+type RateRequest struct {
+	OrderID       uuid.UUID       `json:"order_id"`
+	OriginUF      string          `json:"origin_uf"`
+	DestinationUF string          `json:"destination_uf"`
+	WeightKg      decimal.Decimal `json:"weight_kg"`
+	DeclaredValue decimal.Decimal `json:"declared_value"`
+}
+
+type Rate struct {
+	Reference     string          `json:"reference"`
+	Price         decimal.Decimal `json:"price"`
+	EstimatedDays int             `json:"estimated_days"`
+}
+
+type BookingRequest struct {
+	ContractID    uuid.UUID       `json:"contract_id"`
+	OrderID       uuid.UUID       `json:"order_id"`
+	Reference     string          `json:"reference,omitempty"`
+	OriginUF      string          `json:"origin_uf"`
+	DestinationUF string          `json:"destination_uf"`
+	WeightKg      decimal.Decimal `json:"weight_kg"`
+}
+
+type Booking struct {
+	TrackingCode string `json:"tracking_code"`
+}
+
+//go:generate moq -pkg mocks -out mocks/gateway.go . CarrierGateway
+type CarrierGateway interface {
+	Rate(ctx context.Context, request RateRequest) (*Rate, error)
+	Book(ctx context.Context, request BookingRequest) (*Booking, error)
+	Cancel(ctx context.Context, contractID uuid.UUID) error
+}
+
+type Registry map[uuid.UUID]CarrierGateway
+
+func (r Registry) For(carrierID uuid.UUID) (CarrierGateway, bool) {
+	g, ok := r[carrierID]
+	return g, ok
+}
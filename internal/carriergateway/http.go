@@ -0,0 +1,199 @@
+package carriergateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+const (
+	defaultTimeout          = 2 * time.Second
+	defaultRetries          = 2
+	defaultBackoff          = 100 * time.Millisecond
+	defaultFailureThreshold = 5
+	defaultCooldown         = 30 * time.Second
+)
+
+type HTTPConfig struct {
+	BaseURL          string
+	APIKey           string
+	Timeout          time.Duration
+	Retries          int
+	Backoff          time.Duration
+	FailureThreshold int
+	Cooldown         time.Duration
+}
+
+type httpGateway struct {
+	config  HTTPConfig
+	client  *http.Client
+	breaker *breaker
+}
+
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("carrier responded %d: %s", e.code, e.body)
+}
+
+func NewHTTPGateway(config HTTPConfig, client *http.Client) CarrierGateway {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.Retries == 0 {
+		config.Retries = defaultRetries
+	}
+	if config.Retries < 0 {
+		config.Retries = 0
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = defaultBackoff
+	}
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaultFailureThreshold
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = defaultCooldown
+	}
+	if client == nil {
+		client = &http.Client{}
+	}
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+
+	return &httpGateway{
+		config:  config,
+		client:  client,
+		breaker: newBreaker(config.FailureThreshold, config.Cooldown),
+	}
+}
+
+func (g *httpGateway) Rate(ctx context.Context, request RateRequest) (*Rate, error) {
+	var rate Rate
+	if err := g.do(ctx, http.MethodPost, "/rates", "", request, &rate); err != nil {
+		var status *statusError
+		if errors.As(err, &status) && status.code == http.StatusNotFound {
+			return nil, ErrRateUnavailable
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (g *httpGateway) Book(ctx context.Context, request BookingRequest) (*Booking, error) {
+	var booking Booking
+	if err := g.do(ctx, http.MethodPost, "/bookings", request.ContractID.String(), request, &booking); err != nil {
+		return nil, err
+	}
+	return &booking, nil
+}
+
+func (g *httpGateway) Cancel(ctx context.Context, contractID uuid.UUID) error {
+	key := contractID.String()
+	if err := g.do(ctx, http.MethodDelete, "/bookings/"+key, key, nil, nil); err != nil {
+		var status *statusError
+		if errors.As(err, &status) && status.code == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (g *httpGateway) do(ctx context.Context, method, path, idempotencyKey string, in, out any) error {
+	if !g.breaker.allow() {
+		return ErrCircuitOpen
+	}
+
+	var payload []byte
+	if in != nil {
+		var err error
+		if payload, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= g.config.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				g.breaker.failure()
+				return ctx.Err()
+			case <-time.After(g.config.Backoff << (attempt - 1)):
+			}
+		}
+
+		lastErr = g.attempt(ctx, method, path, idempotencyKey, payload, out)
+		if lastErr == nil {
+			g.breaker.success()
+			return nil
+		}
+
+		var status *statusError
+		if errors.As(lastErr, &status) && !retryable(status.code) {
+			g.breaker.success()
+			return fmt.Errorf("%w: %w", ErrRejected, lastErr)
+		}
+	}
+
+	g.breaker.failure()
+	return fmt.Errorf("carrier gateway request failed: %w", lastErr)
+}
+
+func (g *httpGateway) attempt(ctx context.Context, method, path, idempotencyKey string, payload []byte, out any) error {
+	ctx, cancel := context.WithTimeout(ctx, g.config.Timeout)
+	defer cancel()
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, g.config.BaseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if g.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+g.config.APIKey)
+	}
+	if idempotencyKey != "" {
+		req.Header.Set(idempotencyKeyHeader, idempotencyKey)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &statusError{code: resp.StatusCode, body: strings.TrimSpace(string(raw))}
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func retryable(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
@@ -0,0 +1,228 @@
+package carriergateway_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway/fakecarrier"
+)
+
+func rateRequest(uf string) carriergateway.RateRequest {
+	return carriergateway.RateRequest{
+		OrderID:       uuid.New(),
+		OriginUF:      "SP",
+		DestinationUF: uf,
+		WeightKg:      decimal.NewFromInt(4),
+	}
+}
+
+func fakeGateway(t *testing.T, config fakecarrier.Config, apiKey string) carriergateway.CarrierGateway {
+	srv := httptest.NewServer(fakecarrier.NewHandler(config))
+	t.Cleanup(srv.Close)
+	return carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+		BaseURL: srv.URL,
+		APIKey:  apiKey,
+		Backoff: time.Millisecond,
+	}, srv.Client())
+}
+
+func countingServer(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		status := statuses[len(statuses)-1]
+		if int(n) <= len(statuses) {
+			status = statuses[n-1]
+		}
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"reference":"R1","price":"12.00","estimated_days":2}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestHTTPGateway_RateFromFakeCarrier(t *testing.T) {
+	gateway := fakeGateway(t, fakecarrier.Config{
+		APIKey:        "secret",
+		BasePrice:     decimal.NewFromInt(5),
+		PricePerKg:    decimal.RequireFromString("2.5"),
+		EstimatedDays: 2,
+	}, "secret")
+
+	rate, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.NoError(t, err)
+	assert.Equal(t, "15.00", rate.Price.StringFixed(2))
+	assert.Equal(t, 2, rate.EstimatedDays)
+	assert.NotEmpty(t, rate.Reference)
+}
+
+func TestHTTPGateway_RateUnavailableForUnservedUF(t *testing.T) {
+	gateway := fakeGateway(t, fakecarrier.Config{ServedUFs: []string{"SP"}}, "")
+
+	_, err := gateway.Rate(context.Background(), rateRequest("AM"))
+	assert.ErrorIs(t, err, carriergateway.ErrRateUnavailable)
+}
+
+func TestHTTPGateway_RejectsWrongAPIKeyWithoutRetrying(t *testing.T) {
+	gateway := fakeGateway(t, fakecarrier.Config{APIKey: "secret"}, "wrong")
+
+	_, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.ErrorIs(t, err, carriergateway.ErrRejected)
+}
+
+func TestHTTPGateway_BookAndCancel(t *testing.T) {
+	gateway := fakeGateway(t, fakecarrier.Config{}, "")
+	request := carriergateway.BookingRequest{
+		ContractID:    uuid.New(),
+		OrderID:       uuid.New(),
+		DestinationUF: "RJ",
+		WeightKg:      decimal.NewFromInt(1),
+	}
+
+	booking, err := gateway.Book(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Regexp(t, `^FK\d{9}BR$`, booking.TrackingCode)
+
+	assert.NoError(t, gateway.Cancel(context.Background(), request.ContractID))
+	assert.NoError(t, gateway.Cancel(context.Background(), request.ContractID))
+
+	_, err = gateway.Book(context.Background(), request)
+	assert.ErrorIs(t, err, carriergateway.ErrRejected)
+}
+
+func TestHTTPGateway_CancelUnknownBooking(t *testing.T) {
+	gateway := fakeGateway(t, fakecarrier.Config{}, "")
+
+	assert.NoError(t, gateway.Cancel(context.Background(), uuid.New()))
+}
+
+func TestHTTPGateway_BookRetriesWithIdempotencyKey(t *testing.T) {
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"tracking_code":"FK000000001BR"}`))
+	}))
+	t.Cleanup(srv.Close)
+	gateway := carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+		BaseURL: srv.URL,
+		Retries: 1,
+		Backoff: time.Millisecond,
+	}, srv.Client())
+
+	contractID := uuid.New()
+	booking, err := gateway.Book(context.Background(), carriergateway.BookingRequest{ContractID: contractID})
+	assert.NoError(t, err)
+	assert.Equal(t, "FK000000001BR", booking.TrackingCode)
+	assert.Equal(t, []string{contractID.String(), contractID.String()}, keys)
+}
+
+func TestHTTPGateway_BookIsIdempotentPerContract(t *testing.T) {
+	gateway := fakeGateway(t, fakecarrier.Config{}, "")
+	request := carriergateway.BookingRequest{ContractID: uuid.New(), OrderID: uuid.New(), DestinationUF: "RJ"}
+
+	first, err := gateway.Book(context.Background(), request)
+	assert.NoError(t, err)
+	second, err := gateway.Book(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, first.TrackingCode, second.TrackingCode)
+
+	request.ContractID = uuid.New()
+	other, err := gateway.Book(context.Background(), request)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.TrackingCode, other.TrackingCode)
+}
+
+func TestHTTPGateway_RetriesServerErrors(t *testing.T) {
+	srv, calls := countingServer(t, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+	gateway := carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+		BaseURL: srv.URL,
+		Retries: 2,
+		Backoff: time.Millisecond,
+	}, srv.Client())
+
+	rate, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.NoError(t, err)
+	assert.Equal(t, "12.00", rate.Price.StringFixed(2))
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestHTTPGateway_DefaultsRetriesWhenUnset(t *testing.T) {
+	srv, calls := countingServer(t, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+	gateway := carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+		BaseURL: srv.URL,
+		Backoff: time.Millisecond,
+	}, srv.Client())
+
+	_, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestHTTPGateway_NegativeRetriesDisablesRetry(t *testing.T) {
+	srv, calls := countingServer(t, http.StatusServiceUnavailable, http.StatusOK)
+	gateway := carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+		BaseURL: srv.URL,
+		Retries: -1,
+		Backoff: time.Millisecond,
+	}, srv.Client())
+
+	_, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestHTTPGateway_TimesOutSlowCarrier(t *testing.T) {
+	srv := httptest.NewServer(fakecarrier.NewHandler(fakecarrier.Config{Latency: 300 * time.Millisecond}))
+	t.Cleanup(srv.Close)
+	gateway := carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+		BaseURL: srv.URL,
+		Timeout: 20 * time.Millisecond,
+		Retries: 1,
+		Backoff: time.Millisecond,
+	}, srv.Client())
+
+	start := time.Now()
+	_, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 250*time.Millisecond)
+}
+
+func TestHTTPGateway_CircuitOpensAndRecovers(t *testing.T) {
+	srv, calls := countingServer(t,
+		http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK)
+	gateway := carriergateway.NewHTTPGateway(carriergateway.HTTPConfig{
+		BaseURL:          srv.URL,
+		Retries:          1,
+		Backoff:          time.Millisecond,
+		FailureThreshold: 1,
+		Cooldown:         50 * time.Millisecond,
+	}, srv.Client())
+
+	_, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+
+	_, err = gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.ErrorIs(t, err, carriergateway.ErrCircuitOpen)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+
+	time.Sleep(60 * time.Millisecond)
+	rate, err := gateway.Rate(context.Background(), rateRequest("RJ"))
+	assert.NoError(t, err)
+	assert.NotNil(t, rate)
+}
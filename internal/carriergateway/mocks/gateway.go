@@ -0,0 +1,183 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway"
+	"sync"
+)
+
+// Ensure, that CarrierGatewayMock does implement carriergateway.CarrierGateway.
+// If this is not the case, regenerate this file with moq.
+var _ carriergateway.CarrierGateway = &CarrierGatewayMock{}
+
+// CarrierGatewayMock is a mock implementation of carriergateway.CarrierGateway.
+//
+//	func TestSomethingThatUsesCarrierGateway(t *testing.T) {
+//
+//		// make and configure a mocked carriergateway.CarrierGateway
+//		mockedCarrierGateway := &CarrierGatewayMock{
+//			BookFunc: func(ctx context.Context, request carriergateway.BookingRequest) (*carriergateway.Booking, error) {
+//				panic("mock out the Book method")
+//			},
+//			CancelFunc: func(ctx context.Context, contractID uuid.UUID) error {
+//				panic("mock out the Cancel method")
+//			},
+//			RateFunc: func(ctx context.Context, request carriergateway.RateRequest) (*carriergateway.Rate, error) {
+//				panic("mock out the Rate method")
+//			},
+//		}
+//
+//		// use mockedCarrierGateway in code that requires carriergateway.CarrierGateway
+//		// and then make assertions.
+//
+//	}
+type CarrierGatewayMock struct {
+	// BookFunc mocks the Book method.
+	BookFunc func(ctx context.Context, request carriergateway.BookingRequest) (*carriergateway.Booking, error)
+
+	// CancelFunc mocks the Cancel method.
+	CancelFunc func(ctx context.Context, contractID uuid.UUID) error
+
+	// RateFunc mocks the Rate method.
+	RateFunc func(ctx context.Context, request carriergateway.RateRequest) (*carriergateway.Rate, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Book holds details about calls to the Book method.
+		Book []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request carriergateway.BookingRequest
+		}
+		// Cancel holds details about calls to the Cancel method.
+		Cancel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ContractID is the contractID argument value.
+			ContractID uuid.UUID
+		}
+		// Rate holds details about calls to the Rate method.
+		Rate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request carriergateway.RateRequest
+		}
+	}
+	lockBook   sync.RWMutex
+	lockCancel sync.RWMutex
+	lockRate   sync.RWMutex
+}
+
+// Book calls BookFunc.
+func (mock *CarrierGatewayMock) Book(ctx context.Context, request carriergateway.BookingRequest) (*carriergateway.Booking, error) {
+	if mock.BookFunc == nil {
+		panic("CarrierGatewayMock.BookFunc: method is nil but CarrierGateway.Book was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request carriergateway.BookingRequest
+	}{
+		Ctx:     ctx,
+		Request: request,
+	}
+	mock.lockBook.Lock()
+	mock.calls.Book = append(mock.calls.Book, callInfo)
+	mock.lockBook.Unlock()
+	return mock.BookFunc(ctx, request)
+}
+
+// BookCalls gets all the calls that were made to Book.
+// Check the length with:
+//
+//	len(mockedCarrierGateway.BookCalls())
+func (mock *CarrierGatewayMock) BookCalls() []struct {
+	Ctx     context.Context
+	Request carriergateway.BookingRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request carriergateway.BookingRequest
+	}
+	mock.lockBook.RLock()
+	calls = mock.calls.Book
+	mock.lockBook.RUnlock()
+	return calls
+}
+
+// Cancel calls CancelFunc.
+func (mock *CarrierGatewayMock) Cancel(ctx context.Context, contractID uuid.UUID) error {
+	if mock.CancelFunc == nil {
+		panic("CarrierGatewayMock.CancelFunc: method is nil but CarrierGateway.Cancel was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+	}{
+		Ctx:        ctx,
+		ContractID: contractID,
+	}
+	mock.lockCancel.Lock()
+	mock.calls.Cancel = append(mock.calls.Cancel, callInfo)
+	mock.lockCancel.Unlock()
+	return mock.CancelFunc(ctx, contractID)
+}
+
+// CancelCalls gets all the calls that were made to Cancel.
+// Check the length with:
+//
+//	len(mockedCarrierGateway.CancelCalls())
+func (mock *CarrierGatewayMock) CancelCalls() []struct {
+	Ctx        context.Context
+	ContractID uuid.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+	}
+	mock.lockCancel.RLock()
+	calls = mock.calls.Cancel
+	mock.lockCancel.RUnlock()
+	return calls
+}
+
+// Rate calls RateFunc.
+func (mock *CarrierGatewayMock) Rate(ctx context.Context, request carriergateway.RateRequest) (*carriergateway.Rate, error) {
+	if mock.RateFunc == nil {
+		panic("CarrierGatewayMock.RateFunc: method is nil but CarrierGateway.Rate was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request carriergateway.RateRequest
+	}{
+		Ctx:     ctx,
+		Request: request,
+	}
+	mock.lockRate.Lock()
+	mock.calls.Rate = append(mock.calls.Rate, callInfo)
+	mock.lockRate.Unlock()
+	return mock.RateFunc(ctx, request)
+}
+
+// RateCalls gets all the calls that were made to Rate.
+// Check the length with:
+//
+//	len(mockedCarrierGateway.RateCalls())
+func (mock *CarrierGatewayMock) RateCalls() []struct {
+	Ctx     context.Context
+	Request carriergateway.RateRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request carriergateway.RateRequest
+	}
+	mock.lockRate.RLock()
+	calls = mock.calls.Rate
+	mock.lockRate.RUnlock()
+	return calls
+}
@@ -0,0 +1,38 @@
+package carrierwebhook
+
+import (
+	"github.com/google/uuid"
+)
+
+type RotateSecretInput struct {
+	ID uuid.UUID `path:"id" doc:"Carrier ID"`
+}
+
+type RotateSecretOutput struct {
+	Status int
+	Body   RotateSecretOutputBody
+}
+
+type RotateSecretOutputBody struct {
+	CarrierID uuid.UUID `json:"carrier_id" doc:"Carrier ID"                                                          example:"123e4567-e89b-12d3-a456-426614174000"`
+	Secret    string    `json:"secret"     doc:"New webhook secret, shown only once; the previous one stops working" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+}
+
+type ReceiveInput struct {
+	ID        uuid.UUID `path:"id"                      doc:"Carrier ID"`
+	Timestamp int64     `header:"X-Webhook-Timestamp"   required:"true"  doc:"Unix time in seconds when the payload was signed"`
+	Nonce     string    `header:"X-Webhook-Nonce"       required:"true"  doc:"Unique value per delivery, used to reject replays"               minLength:"8" maxLength:"128"`
+	Signature string    `header:"X-Webhook-Signature"   required:"true"  doc:"sha256= followed by the hex HMAC-SHA256 of timestamp.nonce.body"`
+	RawBody   []byte    `contentType:"application/json"`
+}
+
+type ReceiveOutput struct {
+	Status int
+	Body   ReceiveOutputBody
+}
+
+type ReceiveOutputBody struct {
+	DeliveryID uuid.UUID `json:"delivery_id" doc:"Stored delivery ID"                                    example:"123e4567-e89b-12d3-a456-426614174000"`
+	Accepted   int       `json:"accepted"    doc:"Events appended to contracts"                          example:"2"`
+	Unmatched  int       `json:"unmatched"   doc:"Events whose tracking code matched no active contract" example:"0"`
+}
@@ -0,0 +1,246 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	"sync"
+	"time"
+)
+
+// Ensure, that RepositoryMock does implement carrierwebhook.Repository.
+// If this is not the case, regenerate this file with moq.
+var _ carrierwebhook.Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of carrierwebhook.Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked carrierwebhook.Repository
+//		mockedRepository := &RepositoryMock{
+//			CompleteDeliveryFunc: func(ctx context.Context, delivery *carrierwebhook.Delivery) error {
+//				panic("mock out the CompleteDelivery method")
+//			},
+//			GetSecretFunc: func(ctx context.Context, carrierID uuid.UUID) (string, error) {
+//				panic("mock out the GetSecret method")
+//			},
+//			InsertDeliveryFunc: func(ctx context.Context, delivery *carrierwebhook.Delivery) (uuid.UUID, error) {
+//				panic("mock out the InsertDelivery method")
+//			},
+//			SetSecretFunc: func(ctx context.Context, carrierID uuid.UUID, secret string, at time.Time) error {
+//				panic("mock out the SetSecret method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires carrierwebhook.Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// CompleteDeliveryFunc mocks the CompleteDelivery method.
+	CompleteDeliveryFunc func(ctx context.Context, delivery *carrierwebhook.Delivery) error
+
+	// GetSecretFunc mocks the GetSecret method.
+	GetSecretFunc func(ctx context.Context, carrierID uuid.UUID) (string, error)
+
+	// InsertDeliveryFunc mocks the InsertDelivery method.
+	InsertDeliveryFunc func(ctx context.Context, delivery *carrierwebhook.Delivery) (uuid.UUID, error)
+
+	// SetSecretFunc mocks the SetSecret method.
+	SetSecretFunc func(ctx context.Context, carrierID uuid.UUID, secret string, at time.Time) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CompleteDelivery holds details about calls to the CompleteDelivery method.
+		CompleteDelivery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Delivery is the delivery argument value.
+			Delivery *carrierwebhook.Delivery
+		}
+		// GetSecret holds details about calls to the GetSecret method.
+		GetSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+		}
+		// InsertDelivery holds details about calls to the InsertDelivery method.
+		InsertDelivery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Delivery is the delivery argument value.
+			Delivery *carrierwebhook.Delivery
+		}
+		// SetSecret holds details about calls to the SetSecret method.
+		SetSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// Secret is the secret argument value.
+			Secret string
+			// At is the at argument value.
+			At time.Time
+		}
+	}
+	lockCompleteDelivery sync.RWMutex
+	lockGetSecret        sync.RWMutex
+	lockInsertDelivery   sync.RWMutex
+	lockSetSecret        sync.RWMutex
+}
+
+// CompleteDelivery calls CompleteDeliveryFunc.
+func (mock *RepositoryMock) CompleteDelivery(ctx context.Context, delivery *carrierwebhook.Delivery) error {
+	if mock.CompleteDeliveryFunc == nil {
+		panic("RepositoryMock.CompleteDeliveryFunc: method is nil but Repository.CompleteDelivery was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Delivery *carrierwebhook.Delivery
+	}{
+		Ctx:      ctx,
+		Delivery: delivery,
+	}
+	mock.lockCompleteDelivery.Lock()
+	mock.calls.CompleteDelivery = append(mock.calls.CompleteDelivery, callInfo)
+	mock.lockCompleteDelivery.Unlock()
+	return mock.CompleteDeliveryFunc(ctx, delivery)
+}
+
+// CompleteDeliveryCalls gets all the calls that were made to CompleteDelivery.
+// Check the length with:
+//
+//	len(mockedRepository.CompleteDeliveryCalls())
+func (mock *RepositoryMock) CompleteDeliveryCalls() []struct {
+	Ctx      context.Context
+	Delivery *carrierwebhook.Delivery
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Delivery *carrierwebhook.Delivery
+	}
+	mock.lockCompleteDelivery.RLock()
+	calls = mock.calls.CompleteDelivery
+	mock.lockCompleteDelivery.RUnlock()
+	return calls
+}
+
+// GetSecret calls GetSecretFunc.
+func (mock *RepositoryMock) GetSecret(ctx context.Context, carrierID uuid.UUID) (string, error) {
+	if mock.GetSecretFunc == nil {
+		panic("RepositoryMock.GetSecretFunc: method is nil but Repository.GetSecret was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+	}
+	mock.lockGetSecret.Lock()
+	mock.calls.GetSecret = append(mock.calls.GetSecret, callInfo)
+	mock.lockGetSecret.Unlock()
+	return mock.GetSecretFunc(ctx, carrierID)
+}
+
+// GetSecretCalls gets all the calls that were made to GetSecret.
+// Check the length with:
+//
+//	len(mockedRepository.GetSecretCalls())
+func (mock *RepositoryMock) GetSecretCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}
+	mock.lockGetSecret.RLock()
+	calls = mock.calls.GetSecret
+	mock.lockGetSecret.RUnlock()
+	return calls
+}
+
+// InsertDelivery calls InsertDeliveryFunc.
+func (mock *RepositoryMock) InsertDelivery(ctx context.Context, delivery *carrierwebhook.Delivery) (uuid.UUID, error) {
+	if mock.InsertDeliveryFunc == nil {
+		panic("RepositoryMock.InsertDeliveryFunc: method is nil but Repository.InsertDelivery was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Delivery *carrierwebhook.Delivery
+	}{
+		Ctx:      ctx,
+		Delivery: delivery,
+	}
+	mock.lockInsertDelivery.Lock()
+	mock.calls.InsertDelivery = append(mock.calls.InsertDelivery, callInfo)
+	mock.lockInsertDelivery.Unlock()
+	return mock.InsertDeliveryFunc(ctx, delivery)
+}
+
+// InsertDeliveryCalls gets all the calls that were made to InsertDelivery.
+// Check the length with:
+//
+//	len(mockedRepository.InsertDeliveryCalls())
+func (mock *RepositoryMock) InsertDeliveryCalls() []struct {
+	Ctx      context.Context
+	Delivery *carrierwebhook.Delivery
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Delivery *carrierwebhook.Delivery
+	}
+	mock.lockInsertDelivery.RLock()
+	calls = mock.calls.InsertDelivery
+	mock.lockInsertDelivery.RUnlock()
+	return calls
+}
+
+// SetSecret calls SetSecretFunc.
+func (mock *RepositoryMock) SetSecret(ctx context.Context, carrierID uuid.UUID, secret string, at time.Time) error {
+	if mock.SetSecretFunc == nil {
+		panic("RepositoryMock.SetSecretFunc: method is nil but Repository.SetSecret was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Secret    string
+		At        time.Time
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+		Secret:    secret,
+		At:        at,
+	}
+	mock.lockSetSecret.Lock()
+	mock.calls.SetSecret = append(mock.calls.SetSecret, callInfo)
+	mock.lockSetSecret.Unlock()
+	return mock.SetSecretFunc(ctx, carrierID, secret, at)
+}
+
+// SetSecretCalls gets all the calls that were made to SetSecret.
+// Check the length with:
+//
+//	len(mockedRepository.SetSecretCalls())
+func (mock *RepositoryMock) SetSecretCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+	Secret    string
+	At        time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Secret    string
+		At        time.Time
+	}
+	mock.lockSetSecret.RLock()
+	calls = mock.calls.SetSecret
+	mock.lockSetSecret.RUnlock()
+	return calls
+}
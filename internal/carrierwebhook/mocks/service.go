@@ -0,0 +1,139 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	"sync"
+)
+
+// Ensure, that ServiceMock does implement carrierwebhook.Service.
+// If this is not the case, regenerate this file with moq.
+var _ carrierwebhook.Service = &ServiceMock{}
+
+// ServiceMock is a mock implementation of carrierwebhook.Service.
+//
+//	func TestSomethingThatUsesService(t *testing.T) {
+//
+//		// make and configure a mocked carrierwebhook.Service
+//		mockedService := &ServiceMock{
+//			ReceiveFunc: func(ctx context.Context, carrierID uuid.UUID, signed carrierwebhook.Signed) (*carrierwebhook.Delivery, error) {
+//				panic("mock out the Receive method")
+//			},
+//			RotateSecretFunc: func(ctx context.Context, carrierID uuid.UUID) (string, error) {
+//				panic("mock out the RotateSecret method")
+//			},
+//		}
+//
+//		// use mockedService in code that requires carrierwebhook.Service
+//		// and then make assertions.
+//
+//	}
+type ServiceMock struct {
+	// ReceiveFunc mocks the Receive method.
+	ReceiveFunc func(ctx context.Context, carrierID uuid.UUID, signed carrierwebhook.Signed) (*carrierwebhook.Delivery, error)
+
+	// RotateSecretFunc mocks the RotateSecret method.
+	RotateSecretFunc func(ctx context.Context, carrierID uuid.UUID) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Receive holds details about calls to the Receive method.
+		Receive []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// Signed is the signed argument value.
+			Signed carrierwebhook.Signed
+		}
+		// RotateSecret holds details about calls to the RotateSecret method.
+		RotateSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+		}
+	}
+	lockReceive      sync.RWMutex
+	lockRotateSecret sync.RWMutex
+}
+
+// Receive calls ReceiveFunc.
+func (mock *ServiceMock) Receive(ctx context.Context, carrierID uuid.UUID, signed carrierwebhook.Signed) (*carrierwebhook.Delivery, error) {
+	if mock.ReceiveFunc == nil {
+		panic("ServiceMock.ReceiveFunc: method is nil but Service.Receive was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Signed    carrierwebhook.Signed
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+		Signed:    signed,
+	}
+	mock.lockReceive.Lock()
+	mock.calls.Receive = append(mock.calls.Receive, callInfo)
+	mock.lockReceive.Unlock()
+	return mock.ReceiveFunc(ctx, carrierID, signed)
+}
+
+// ReceiveCalls gets all the calls that were made to Receive.
+// Check the length with:
+//
+//	len(mockedService.ReceiveCalls())
+func (mock *ServiceMock) ReceiveCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+	Signed    carrierwebhook.Signed
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Signed    carrierwebhook.Signed
+	}
+	mock.lockReceive.RLock()
+	calls = mock.calls.Receive
+	mock.lockReceive.RUnlock()
+	return calls
+}
+
+// RotateSecret calls RotateSecretFunc.
+func (mock *ServiceMock) RotateSecret(ctx context.Context, carrierID uuid.UUID) (string, error) {
+	if mock.RotateSecretFunc == nil {
+		panic("ServiceMock.RotateSecretFunc: method is nil but Service.RotateSecret was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+	}
+	mock.lockRotateSecret.Lock()
+	mock.calls.RotateSecret = append(mock.calls.RotateSecret, callInfo)
+	mock.lockRotateSecret.Unlock()
+	return mock.RotateSecretFunc(ctx, carrierID)
+}
+
+// RotateSecretCalls gets all the calls that were made to RotateSecret.
+// Check the length with:
+//
+//	len(mockedService.RotateSecretCalls())
+func (mock *ServiceMock) RotateSecretCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}
+	mock.lockRotateSecret.RLock()
+	calls = mock.calls.RotateSecret
+	mock.lockRotateSecret.RUnlock()
+	return calls
+}
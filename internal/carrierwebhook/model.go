@@ -0,0 +1,70 @@
+package carrierwebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const signaturePrefix = "sha256="
+
+type Payload struct {
+	Events []PayloadEvent `json:"events"`
+}
+
+type PayloadEvent struct {
+	TrackingCode string    `json:"tracking_code"`
+	Code         string    `json:"code"`
+	Description  string    `json:"description"`
+	Location     string    `json:"location"`
+	OccurredAt   time.Time `json:"occurred_at"`
+}
+
+type Delivery struct {
+	ID          uuid.UUID  `json:"id"`
+	CarrierID   uuid.UUID  `json:"carrier_id"`
+	Nonce       string     `json:"nonce"`
+	SignedAt    time.Time  `json:"signed_at"`
+	Signature   string     `json:"signature"`
+	Payload     []byte     `json:"payload"`
+	Accepted    int        `json:"accepted"`
+	Unmatched   int        `json:"unmatched"`
+	Error       *string    `json:"error"`
+	ReceivedAt  time.Time  `json:"received_at"`
+	ProcessedAt *time.Time `json:"processed_at"`
+}
+
+type Signed struct {
+	Timestamp int64
+	Nonce     string
+	Signature string
+	Body      []byte
+}
+
+func Sign(secret string, timestamp int64, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s Signed) Verify(secret string) bool {
+	if !strings.HasPrefix(s.Signature, signaturePrefix) {
+		return false
+	}
+	expected := Sign(secret, s.Timestamp, s.Nonce, s.Body)
+	return hmac.Equal([]byte(expected), []byte(s.Signature))
+}
+
+func (s Signed) Fresh(now time.Time, tolerance time.Duration) bool {
+	signedAt := time.Unix(s.Timestamp, 0)
+	return signedAt.After(now.Add(-tolerance)) && signedAt.Before(now.Add(tolerance))
+}
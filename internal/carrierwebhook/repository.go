@@ -0,0 +1,119 @@
+package carrierwebhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrSecretNotFound   = errors.New("carrier has no webhook secret")
+	ErrReplayedDelivery = errors.New("webhook nonce already received")
+)
+
+const (
+	uniqueViolationCode = "23505"
+	uniqueWebhookNonce  = "unique_carrier_webhook_nonce"
+)
+
+const (
+	queryUpsertSecret = `
+	INSERT INTO carrier_webhook_secrets (carrier_id, secret, created_at)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (carrier_id) DO UPDATE SET secret = EXCLUDED.secret, created_at = EXCLUDED.created_at
+`
+
+	querySelectSecret = `
+	SELECT secret
+	FROM carrier_webhook_secrets
+	WHERE carrier_id = $1
+`
+
+	queryInsertDelivery = `
+	INSERT INTO carrier_webhook_deliveries (carrier_id, nonce, signed_at, signature, payload, received_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id
+`
+
+	queryCompleteDelivery = `
+	UPDATE carrier_webhook_deliveries
+	SET accepted = $2, unmatched = $3, error = $4, processed_at = $5
+	WHERE id = $1
+`
+)
+
+//go:generate moq -pkg mocks -out mocks/repository.go . Repository
+type Repository interface {
+	SetSecret(ctx context.Context, carrierID uuid.UUID, secret string, at time.Time) error
+	GetSecret(ctx context.Context, carrierID uuid.UUID) (string, error)
+	InsertDelivery(ctx context.Context, delivery *Delivery) (uuid.UUID, error)
+	CompleteDelivery(ctx context.Context, delivery *Delivery) error
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) Repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) SetSecret(ctx context.Context, carrierID uuid.UUID, secret string, at time.Time) error {
+	if _, err := r.pool.Exec(ctx, queryUpsertSecret, carrierID, secret, at); err != nil {
+		return fmt.Errorf("failed to set webhook secret: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetSecret(ctx context.Context, carrierID uuid.UUID) (string, error) {
+	var secret string
+	err := r.pool.QueryRow(ctx, querySelectSecret, carrierID).Scan(&secret)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrSecretNotFound
+		}
+		return "", fmt.Errorf("failed to get webhook secret: %w", err)
+	}
+	return secret, nil
+}
+
+func (r *repository) InsertDelivery(ctx context.Context, delivery *Delivery) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, queryInsertDelivery,
+		delivery.CarrierID,
+		delivery.Nonce,
+		delivery.SignedAt,
+		delivery.Signature,
+		string(delivery.Payload),
+		delivery.ReceivedAt,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode &&
+			pgErr.ConstraintName == uniqueWebhookNonce {
+			return uuid.Nil, ErrReplayedDelivery
+		}
+		return uuid.Nil, fmt.Errorf("failed to insert webhook delivery: %w", err)
+	}
+	return id, nil
+}
+
+func (r *repository) CompleteDelivery(ctx context.Context, delivery *Delivery) error {
+	_, err := r.pool.Exec(ctx, queryCompleteDelivery,
+		delivery.ID,
+		delivery.Accepted,
+		delivery.Unmatched,
+		delivery.Error,
+		delivery.ProcessedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to complete webhook delivery: %w", err)
+	}
+	return nil
+}
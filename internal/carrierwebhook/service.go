@@ -0,0 +1,189 @@
+package carrierwebhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	log "go.uber.org/zap"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrStaleTimestamp   = errors.New("webhook timestamp outside the accepted window")
+	ErrInvalidPayload   = errors.New("invalid webhook payload")
+)
+
+const (
+	defaultTolerance = 5 * time.Minute
+	secretBytes      = 32
+)
+
+type Config struct {
+	Tolerance time.Duration
+}
+
+//go:generate moq -pkg mocks -out mocks/service.go . Service
+type Service interface {
+	RotateSecret(ctx context.Context, carrierID uuid.UUID) (string, error)
+	Receive(ctx context.Context, carrierID uuid.UUID, signed Signed) (*Delivery, error)
+}
+
+type service struct {
+	repo              Repository
+	carrierRepository carrier.Repository
+	contracts         shipping.Repository
+	trackingService   tracking.Service
+	config            Config
+}
+
+func NewService(
+	repo Repository,
+	carrierRepository carrier.Repository,
+	contracts shipping.Repository,
+	trackingService tracking.Service,
+	config Config,
+) Service {
+	if config.Tolerance <= 0 {
+		config.Tolerance = defaultTolerance
+	}
+
+	return &service{
+		repo:              repo,
+		carrierRepository: carrierRepository,
+		contracts:         contracts,
+		trackingService:   trackingService,
+		config:            config,
+	}
+}
+
+func (s *service) RotateSecret(ctx context.Context, carrierID uuid.UUID) (string, error) {
+	if _, err := s.carrierRepository.GetByID(ctx, carrierID); err != nil {
+		log.L().
+			Error("failed to get carrier for webhook secret", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return "", err
+	}
+
+	raw := make([]byte, secretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	secret := hex.EncodeToString(raw)
+
+	if err := s.repo.SetSecret(ctx, carrierID, secret, time.Now().UTC()); err != nil {
+		log.L().
+			Error("failed to set webhook secret", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return "", err
+	}
+
+	return secret, nil
+}
+
+func (s *service) Receive(ctx context.Context, carrierID uuid.UUID, signed Signed) (*Delivery, error) {
+	secret, err := s.repo.GetSecret(ctx, carrierID)
+	if err != nil {
+		log.L().
+			Warn("failed to get webhook secret", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if !signed.Fresh(now, s.config.Tolerance) {
+		log.L().
+			Warn("stale webhook timestamp", log.String("carrier_id", carrierID.String()), log.Int64("timestamp", signed.Timestamp))
+		return nil, ErrStaleTimestamp
+	}
+
+	if !signed.Verify(secret) {
+		log.L().
+			Warn("invalid webhook signature", log.String("carrier_id", carrierID.String()))
+		return nil, ErrInvalidSignature
+	}
+
+	delivery := &Delivery{
+		CarrierID:  carrierID,
+		Nonce:      signed.Nonce,
+		SignedAt:   time.Unix(signed.Timestamp, 0).UTC(),
+		Signature:  signed.Signature,
+		Payload:    signed.Body,
+		ReceivedAt: now,
+	}
+
+	delivery.ID, err = s.repo.InsertDelivery(ctx, delivery)
+	if err != nil {
+		log.L().
+			Warn("failed to store webhook delivery", log.String("carrier_id", carrierID.String()), log.String("nonce", signed.Nonce), log.Error(err))
+		return nil, err
+	}
+
+	processErr := s.process(ctx, delivery)
+
+	processedAt := time.Now().UTC()
+	delivery.ProcessedAt = &processedAt
+	if processErr != nil {
+		message := processErr.Error()
+		delivery.Error = &message
+	}
+
+	if err := s.repo.CompleteDelivery(ctx, delivery); err != nil {
+		log.L().
+			Error("failed to complete webhook delivery", log.String("delivery_id", delivery.ID.String()), log.Error(err))
+		return nil, err
+	}
+
+	if processErr != nil {
+		return nil, processErr
+	}
+	return delivery, nil
+}
+
+func (s *service) process(ctx context.Context, delivery *Delivery) error {
+	var payload Payload
+	if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+		return ErrInvalidPayload
+	}
+
+	codes := make([]tracking.EventCode, len(payload.Events))
+	for i, e := range payload.Events {
+		code, ok := tracking.EventCodes[e.Code]
+		if !ok || e.TrackingCode == "" || e.OccurredAt.IsZero() {
+			return ErrInvalidPayload
+		}
+		codes[i] = code
+	}
+
+	for i, e := range payload.Events {
+		contract, err := s.contracts.GetContractByTrackingCode(ctx, delivery.CarrierID, e.TrackingCode)
+		if errors.Is(err, shipping.ErrContractNotFound) {
+			delivery.Unmatched++
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = s.trackingService.AppendEvent(ctx, contract.ID, &tracking.Event{
+			Code:        codes[i],
+			Description: e.Description,
+			Location:    e.Location,
+			OccurredAt:  e.OccurredAt.UTC(),
+		})
+		if errors.Is(err, shipping.ErrContractNotActive) {
+			delivery.Unmatched++
+			continue
+		}
+		if err != nil && !errors.Is(err, tracking.ErrDuplicateEvent) {
+			return err
+		}
+		delivery.Accepted++
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package carrierwebhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	carriermock "github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	shippingmock "github.com/victorvcruz/shipment-coordinator/internal/shipping/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	trackingmock "github.com/victorvcruz/shipment-coordinator/internal/tracking/mocks"
+)
+
+const secret = "s3cr3t"
+
+func signed(timestamp int64, nonce, body string) carrierwebhook.Signed {
+	return carrierwebhook.Signed{
+		Timestamp: timestamp,
+		Nonce:     nonce,
+		Signature: carrierwebhook.Sign(secret, timestamp, nonce, []byte(body)),
+		Body:      []byte(body),
+	}
+}
+
+func webhookRepo() *mocks.RepositoryMock {
+	return &mocks.RepositoryMock{
+		GetSecretFunc: func(ctx context.Context, carrierID uuid.UUID) (string, error) {
+			return secret, nil
+		},
+		InsertDeliveryFunc: func(ctx context.Context, d *carrierwebhook.Delivery) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+		CompleteDeliveryFunc: func(ctx context.Context, d *carrierwebhook.Delivery) error {
+			return nil
+		},
+	}
+}
+
+func TestSigned_Verify(t *testing.T) {
+	s := signed(1751200000, "nonce-123", `{"events":[]}`)
+	assert.True(t, s.Verify(secret))
+	assert.False(t, s.Verify("other"))
+
+	s.Body = []byte(`{"events":[{}]}`)
+	assert.False(t, s.Verify(secret))
+}
+
+func TestService_Receive_AppendsMatchedEvents(t *testing.T) {
+	carrierID := uuid.New()
+	contractID := uuid.New()
+	body := `{"events":[
+		{"tracking_code":"BR1","code":"in_transit","location":"Campinas - SP","occurred_at":"2025-06-29T10:00:00Z"},
+		{"tracking_code":"UNKNOWN","code":"delivered","occurred_at":"2025-06-29T11:00:00Z"}
+	]}`
+
+	repo := webhookRepo()
+	contracts := &shippingmock.RepositoryMock{
+		GetContractByTrackingCodeFunc: func(ctx context.Context, id uuid.UUID, code string) (*shipping.Contract, error) {
+			if code == "BR1" {
+				return &shipping.Contract{ID: contractID, CarrierID: id}, nil
+			}
+			return nil, shipping.ErrContractNotFound
+		},
+	}
+	trackingSvc := &trackingmock.ServiceMock{
+		AppendEventFunc: func(ctx context.Context, id uuid.UUID, e *tracking.Event) (*tracking.Event, error) {
+			return e, nil
+		},
+	}
+
+	svc := carrierwebhook.NewService(repo, &carriermock.RepositoryMock{}, contracts, trackingSvc, carrierwebhook.Config{})
+	delivery, err := svc.Receive(context.Background(), carrierID, signed(time.Now().Unix(), "nonce-123", body))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivery.Accepted)
+	assert.Equal(t, 1, delivery.Unmatched)
+	assert.Equal(t, contractID, trackingSvc.AppendEventCalls()[0].ContractID)
+	assert.Equal(t, tracking.EventInTransit, trackingSvc.AppendEventCalls()[0].Event.Code)
+	assert.Len(t, repo.CompleteDeliveryCalls(), 1)
+	assert.Equal(t, []byte(body), repo.InsertDeliveryCalls()[0].Delivery.Payload)
+}
+
+func TestService_Receive_InvalidSignature(t *testing.T) {
+	repo := webhookRepo()
+	s := signed(time.Now().Unix(), "nonce-123", `{"events":[]}`)
+	s.Signature = carrierwebhook.Sign("forged", s.Timestamp, s.Nonce, s.Body)
+
+	svc := carrierwebhook.NewService(repo, nil, nil, nil, carrierwebhook.Config{})
+	delivery, err := svc.Receive(context.Background(), uuid.New(), s)
+	assert.ErrorIs(t, err, carrierwebhook.ErrInvalidSignature)
+	assert.Nil(t, delivery)
+	assert.Empty(t, repo.InsertDeliveryCalls())
+}
+
+func TestService_Receive_StaleTimestamp(t *testing.T) {
+	repo := webhookRepo()
+	old := time.Now().Add(-time.Hour).Unix()
+
+	svc := carrierwebhook.NewService(repo, nil, nil, nil, carrierwebhook.Config{Tolerance: 5 * time.Minute})
+	_, err := svc.Receive(context.Background(), uuid.New(), signed(old, "nonce-123", `{"events":[]}`))
+	assert.ErrorIs(t, err, carrierwebhook.ErrStaleTimestamp)
+	assert.Empty(t, repo.InsertDeliveryCalls())
+}
+
+func TestService_Receive_Replay(t *testing.T) {
+	repo := webhookRepo()
+	repo.InsertDeliveryFunc = func(ctx context.Context, d *carrierwebhook.Delivery) (uuid.UUID, error) {
+		return uuid.Nil, carrierwebhook.ErrReplayedDelivery
+	}
+
+	svc := carrierwebhook.NewService(repo, nil, nil, nil, carrierwebhook.Config{})
+	_, err := svc.Receive(context.Background(), uuid.New(), signed(time.Now().Unix(), "nonce-123", `{"events":[]}`))
+	assert.ErrorIs(t, err, carrierwebhook.ErrReplayedDelivery)
+	assert.Empty(t, repo.CompleteDeliveryCalls())
+}
+
+func TestService_Receive_InvalidPayloadIsStored(t *testing.T) {
+	repo := webhookRepo()
+	body := `{"events":[{"tracking_code":"BR1","code":"teleported","occurred_at":"2025-06-29T10:00:00Z"}]}`
+
+	svc := carrierwebhook.NewService(repo, nil, nil, nil, carrierwebhook.Config{})
+	_, err := svc.Receive(context.Background(), uuid.New(), signed(time.Now().Unix(), "nonce-123", body))
+	assert.ErrorIs(t, err, carrierwebhook.ErrInvalidPayload)
+	assert.Len(t, repo.InsertDeliveryCalls(), 1)
+	assert.NotNil(t, repo.CompleteDeliveryCalls()[0].Delivery.Error)
+}
+
+func TestService_Receive_RedeliveredEventIsAccepted(t *testing.T) {
+	body := `{"events":[
+		{"tracking_code":"BR1","code":"in_transit","occurred_at":"2025-06-29T10:00:00Z"},
+		{"tracking_code":"BR1","code":"delivered","occurred_at":"2025-06-29T11:00:00Z"}
+	]}`
+
+	repo := webhookRepo()
+	contracts := &shippingmock.RepositoryMock{
+		GetContractByTrackingCodeFunc: func(ctx context.Context, id uuid.UUID, code string) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: uuid.New(), CarrierID: id}, nil
+		},
+	}
+	trackingSvc := &trackingmock.ServiceMock{
+		AppendEventFunc: func(ctx context.Context, id uuid.UUID, e *tracking.Event) (*tracking.Event, error) {
+			if e.Code == tracking.EventInTransit {
+				return nil, tracking.ErrDuplicateEvent
+			}
+			return e, nil
+		},
+	}
+
+	svc := carrierwebhook.NewService(repo, &carriermock.RepositoryMock{}, contracts, trackingSvc, carrierwebhook.Config{})
+	delivery, err := svc.Receive(context.Background(), uuid.New(), signed(time.Now().Unix(), "nonce-123", body))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, delivery.Accepted)
+	assert.Len(t, trackingSvc.AppendEventCalls(), 2)
+	assert.Nil(t, repo.CompleteDeliveryCalls()[0].Delivery.Error)
+}
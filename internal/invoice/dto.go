@@ -0,0 +1,80 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImportInput struct {
+	ID          uuid.UUID `path:"id"              doc:"Carrier ID"`
+	Reference   string    `query:"reference"      doc:"Carrier invoice number"         required:"true" minLength:"1"           maxLength:"64"`
+	PeriodFrom  time.Time `query:"period_from"    doc:"First day of the billed period" required:"true" timeFormat:"2006-01-02"`
+	PeriodTo    time.Time `query:"period_to"      doc:"Last day of the billed period"  required:"true" timeFormat:"2006-01-02"`
+	ContentType string    `header:"Content-Type"  doc:"text/csv or application/json"`
+	RawBody     []byte    `contentType:"text/csv"`
+}
+
+type GetReportInput struct {
+	ID uuid.UUID `path:"id" doc:"Invoice ID"`
+}
+
+type ListInvoicesInput struct {
+	ID uuid.UUID `path:"id" doc:"Carrier ID"`
+}
+
+type ReportOutput struct {
+	Status int
+	Body   ReportOutputBody
+}
+
+type ListInvoicesOutput struct {
+	Status int
+	Body   []InvoiceResponse
+}
+
+type ReportOutputBody struct {
+	Invoice          InvoiceResponse         `json:"invoice"           doc:"Imported invoice"`
+	Matched          []LineResponse          `json:"matched"           doc:"Lines that match a contract within the tolerances"`
+	Disputed         []LineResponse          `json:"disputed"          doc:"Lines matched to a contract with price, weight or status differences"`
+	Unexpected       []LineResponse          `json:"unexpected"        doc:"Lines that match no contract of the carrier"`
+	Missing          []MissingChargeResponse `json:"missing"           doc:"Active contracts of the period the carrier did not charge"`
+	InvoicedTotal    string                  `json:"invoiced_total"    doc:"Sum of all invoice lines in BRL"                                      example:"1520.40"`
+	ExpectedTotal    string                  `json:"expected_total"    doc:"Sum of the contracts charged or expected in the period"               example:"1498.10"`
+	DisputedAmount   string                  `json:"disputed_amount"   doc:"Amount charged above the contracts on disputed lines"                 example:"22.30"`
+	UnexpectedAmount string                  `json:"unexpected_amount" doc:"Amount charged on lines without a contract"                           example:"0.00"`
+	MissingAmount    string                  `json:"missing_amount"    doc:"Expected amount of contracts the carrier did not charge"              example:"0.00"`
+}
+
+type InvoiceResponse struct {
+	ID                uuid.UUID `json:"id"                  doc:"Invoice ID"                       example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierID         uuid.UUID `json:"carrier_id"          doc:"Carrier ID"                       example:"123e4567-e89b-12d3-a456-426614174000"`
+	Reference         string    `json:"reference"           doc:"Carrier invoice number"           example:"FAT-2025-06"`
+	PeriodFrom        string    `json:"period_from"         doc:"First day of the billed period"   example:"2025-06-01"`
+	PeriodTo          string    `json:"period_to"           doc:"Last day of the billed period"    example:"2025-06-30"`
+	PriceTolerance    string    `json:"price_tolerance"     doc:"Accepted price difference in BRL" example:"0.50"`
+	WeightToleranceKg string    `json:"weight_tolerance_kg" doc:"Accepted weight difference in kg" example:"0.50"`
+	CreatedAt         time.Time `json:"created_at"          doc:"Import date"                      example:"2025-07-02T10:00:00Z"`
+}
+
+type LineResponse struct {
+	Number             int        `json:"number"                       doc:"Line number in the imported file"       example:"1"`
+	TrackingCode       *string    `json:"tracking_code,omitempty"      doc:"Tracking code charged"                  example:"BR123456789XX"`
+	OrderID            *uuid.UUID `json:"order_id,omitempty"           doc:"Order ID charged"                       example:"123e4567-e89b-12d3-a456-426614174000"`
+	Amount             string     `json:"amount"                       doc:"Amount charged in BRL"                  example:"35.20"`
+	WeightKg           *string    `json:"weight_kg,omitempty"          doc:"Weight charged in kg"                   example:"3.00"`
+	ContractID         *uuid.UUID `json:"contract_id,omitempty"        doc:"Matched contract ID"                    example:"123e4567-e89b-12d3-a456-426614174000"`
+	ExpectedAmount     *string    `json:"expected_amount,omitempty"    doc:"Contract total price in BRL"            example:"35.20"`
+	AmountDifference   string     `json:"amount_difference"            doc:"Charged minus expected amount in BRL"   example:"0.00"`
+	ExpectedWeightKg   *string    `json:"expected_weight_kg,omitempty" doc:"Order weight in kg"                     example:"3.00"`
+	WeightDifferenceKg string     `json:"weight_difference_kg"         doc:"Charged minus order weight in kg"       example:"0.00"`
+	Reasons            []string   `json:"reasons"                      doc:"Why the line is disputed or unexpected"`
+}
+
+type MissingChargeResponse struct {
+	ContractID     uuid.UUID `json:"contract_id"             doc:"Contract ID"                 example:"123e4567-e89b-12d3-a456-426614174000"`
+	OrderID        uuid.UUID `json:"order_id"                doc:"Order ID"                    example:"123e4567-e89b-12d3-a456-426614174000"`
+	TrackingCode   *string   `json:"tracking_code,omitempty" doc:"Contract tracking code"      example:"BR123456789XX"`
+	ExpectedAmount string    `json:"expected_amount"         doc:"Contract total price in BRL" example:"35.20"`
+	ContractedAt   time.Time `json:"contracted_at"           doc:"Contract date"               example:"2025-06-10T14:00:00Z"`
+}
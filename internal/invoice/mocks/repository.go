@@ -0,0 +1,183 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+	"sync"
+)
+
+// Ensure, that RepositoryMock does implement invoice.Repository.
+// If this is not the case, regenerate this file with moq.
+var _ invoice.Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of invoice.Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked invoice.Repository
+//		mockedRepository := &RepositoryMock{
+//			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*invoice.Invoice, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			InsertFunc: func(ctx context.Context, inv *invoice.Invoice) (uuid.UUID, error) {
+//				panic("mock out the Insert method")
+//			},
+//			ListByCarrierFunc: func(ctx context.Context, carrierID uuid.UUID) ([]invoice.Invoice, error) {
+//				panic("mock out the ListByCarrier method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires invoice.Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (*invoice.Invoice, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, inv *invoice.Invoice) (uuid.UUID, error)
+
+	// ListByCarrierFunc mocks the ListByCarrier method.
+	ListByCarrierFunc func(ctx context.Context, carrierID uuid.UUID) ([]invoice.Invoice, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Inv is the inv argument value.
+			Inv *invoice.Invoice
+		}
+		// ListByCarrier holds details about calls to the ListByCarrier method.
+		ListByCarrier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+		}
+	}
+	lockGetByID       sync.RWMutex
+	lockInsert        sync.RWMutex
+	lockListByCarrier sync.RWMutex
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *RepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (*invoice.Invoice, error) {
+	if mock.GetByIDFunc == nil {
+		panic("RepositoryMock.GetByIDFunc: method is nil but Repository.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedRepository.GetByIDCalls())
+func (mock *RepositoryMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *RepositoryMock) Insert(ctx context.Context, inv *invoice.Invoice) (uuid.UUID, error) {
+	if mock.InsertFunc == nil {
+		panic("RepositoryMock.InsertFunc: method is nil but Repository.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Inv *invoice.Invoice
+	}{
+		Ctx: ctx,
+		Inv: inv,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, inv)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedRepository.InsertCalls())
+func (mock *RepositoryMock) InsertCalls() []struct {
+	Ctx context.Context
+	Inv *invoice.Invoice
+} {
+	var calls []struct {
+		Ctx context.Context
+		Inv *invoice.Invoice
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// ListByCarrier calls ListByCarrierFunc.
+func (mock *RepositoryMock) ListByCarrier(ctx context.Context, carrierID uuid.UUID) ([]invoice.Invoice, error) {
+	if mock.ListByCarrierFunc == nil {
+		panic("RepositoryMock.ListByCarrierFunc: method is nil but Repository.ListByCarrier was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+	}
+	mock.lockListByCarrier.Lock()
+	mock.calls.ListByCarrier = append(mock.calls.ListByCarrier, callInfo)
+	mock.lockListByCarrier.Unlock()
+	return mock.ListByCarrierFunc(ctx, carrierID)
+}
+
+// ListByCarrierCalls gets all the calls that were made to ListByCarrier.
+// Check the length with:
+//
+//	len(mockedRepository.ListByCarrierCalls())
+func (mock *RepositoryMock) ListByCarrierCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}
+	mock.lockListByCarrier.RLock()
+	calls = mock.calls.ListByCarrier
+	mock.lockListByCarrier.RUnlock()
+	return calls
+}
@@ -0,0 +1,183 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+	"sync"
+)
+
+// Ensure, that ServiceMock does implement invoice.Service.
+// If this is not the case, regenerate this file with moq.
+var _ invoice.Service = &ServiceMock{}
+
+// ServiceMock is a mock implementation of invoice.Service.
+//
+//	func TestSomethingThatUsesService(t *testing.T) {
+//
+//		// make and configure a mocked invoice.Service
+//		mockedService := &ServiceMock{
+//			ImportFunc: func(ctx context.Context, inv *invoice.Invoice) (*invoice.Report, error) {
+//				panic("mock out the Import method")
+//			},
+//			ListByCarrierFunc: func(ctx context.Context, carrierID uuid.UUID) ([]invoice.Invoice, error) {
+//				panic("mock out the ListByCarrier method")
+//			},
+//			ReconcileFunc: func(ctx context.Context, id uuid.UUID) (*invoice.Report, error) {
+//				panic("mock out the Reconcile method")
+//			},
+//		}
+//
+//		// use mockedService in code that requires invoice.Service
+//		// and then make assertions.
+//
+//	}
+type ServiceMock struct {
+	// ImportFunc mocks the Import method.
+	ImportFunc func(ctx context.Context, inv *invoice.Invoice) (*invoice.Report, error)
+
+	// ListByCarrierFunc mocks the ListByCarrier method.
+	ListByCarrierFunc func(ctx context.Context, carrierID uuid.UUID) ([]invoice.Invoice, error)
+
+	// ReconcileFunc mocks the Reconcile method.
+	ReconcileFunc func(ctx context.Context, id uuid.UUID) (*invoice.Report, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Import holds details about calls to the Import method.
+		Import []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Inv is the inv argument value.
+			Inv *invoice.Invoice
+		}
+		// ListByCarrier holds details about calls to the ListByCarrier method.
+		ListByCarrier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+		}
+		// Reconcile holds details about calls to the Reconcile method.
+		Reconcile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+	}
+	lockImport        sync.RWMutex
+	lockListByCarrier sync.RWMutex
+	lockReconcile     sync.RWMutex
+}
+
+// Import calls ImportFunc.
+func (mock *ServiceMock) Import(ctx context.Context, inv *invoice.Invoice) (*invoice.Report, error) {
+	if mock.ImportFunc == nil {
+		panic("ServiceMock.ImportFunc: method is nil but Service.Import was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Inv *invoice.Invoice
+	}{
+		Ctx: ctx,
+		Inv: inv,
+	}
+	mock.lockImport.Lock()
+	mock.calls.Import = append(mock.calls.Import, callInfo)
+	mock.lockImport.Unlock()
+	return mock.ImportFunc(ctx, inv)
+}
+
+// ImportCalls gets all the calls that were made to Import.
+// Check the length with:
+//
+//	len(mockedService.ImportCalls())
+func (mock *ServiceMock) ImportCalls() []struct {
+	Ctx context.Context
+	Inv *invoice.Invoice
+} {
+	var calls []struct {
+		Ctx context.Context
+		Inv *invoice.Invoice
+	}
+	mock.lockImport.RLock()
+	calls = mock.calls.Import
+	mock.lockImport.RUnlock()
+	return calls
+}
+
+// ListByCarrier calls ListByCarrierFunc.
+func (mock *ServiceMock) ListByCarrier(ctx context.Context, carrierID uuid.UUID) ([]invoice.Invoice, error) {
+	if mock.ListByCarrierFunc == nil {
+		panic("ServiceMock.ListByCarrierFunc: method is nil but Service.ListByCarrier was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+	}
+	mock.lockListByCarrier.Lock()
+	mock.calls.ListByCarrier = append(mock.calls.ListByCarrier, callInfo)
+	mock.lockListByCarrier.Unlock()
+	return mock.ListByCarrierFunc(ctx, carrierID)
+}
+
+// ListByCarrierCalls gets all the calls that were made to ListByCarrier.
+// Check the length with:
+//
+//	len(mockedService.ListByCarrierCalls())
+func (mock *ServiceMock) ListByCarrierCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+	}
+	mock.lockListByCarrier.RLock()
+	calls = mock.calls.ListByCarrier
+	mock.lockListByCarrier.RUnlock()
+	return calls
+}
+
+// Reconcile calls ReconcileFunc.
+func (mock *ServiceMock) Reconcile(ctx context.Context, id uuid.UUID) (*invoice.Report, error) {
+	if mock.ReconcileFunc == nil {
+		panic("ServiceMock.ReconcileFunc: method is nil but Service.Reconcile was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockReconcile.Lock()
+	mock.calls.Reconcile = append(mock.calls.Reconcile, callInfo)
+	mock.lockReconcile.Unlock()
+	return mock.ReconcileFunc(ctx, id)
+}
+
+// ReconcileCalls gets all the calls that were made to Reconcile.
+// Check the length with:
+//
+//	len(mockedService.ReconcileCalls())
+func (mock *ServiceMock) ReconcileCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockReconcile.RLock()
+	calls = mock.calls.Reconcile
+	mock.lockReconcile.RUnlock()
+	return calls
+}
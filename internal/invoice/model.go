@@ -0,0 +1,72 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type LineStatus string
+
+const (
+	LineMatched    LineStatus = "matched"
+	LineDisputed   LineStatus = "disputed"
+	LineUnexpected LineStatus = "unexpected"
+)
+
+type Invoice struct {
+	ID                uuid.UUID       `json:"id"`
+	CarrierID         uuid.UUID       `json:"carrier_id"`
+	Reference         string          `json:"reference"`
+	PeriodFrom        time.Time       `json:"period_from"`
+	PeriodTo          time.Time       `json:"period_to"`
+	PriceTolerance    decimal.Decimal `json:"price_tolerance"`
+	WeightToleranceKg decimal.Decimal `json:"weight_tolerance_kg"`
+	Lines             []Line          `json:"lines"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+type Line struct {
+	Number       int              `json:"number"`
+	TrackingCode *string          `json:"tracking_code"`
+	OrderID      *uuid.UUID       `json:"order_id"`
+	Amount       decimal.Decimal  `json:"amount"`
+	WeightKg     *decimal.Decimal `json:"weight_kg"`
+}
+
+type LineResult struct {
+	Line
+	Status             LineStatus       `json:"status"`
+	ContractID         *uuid.UUID       `json:"contract_id"`
+	ExpectedAmount     *decimal.Decimal `json:"expected_amount"`
+	AmountDifference   decimal.Decimal  `json:"amount_difference"`
+	ExpectedWeightKg   *decimal.Decimal `json:"expected_weight_kg"`
+	WeightDifferenceKg decimal.Decimal  `json:"weight_difference_kg"`
+	Reasons            []string         `json:"reasons"`
+}
+
+type MissingCharge struct {
+	ContractID     uuid.UUID       `json:"contract_id"`
+	OrderID        uuid.UUID       `json:"order_id"`
+	TrackingCode   *string         `json:"tracking_code"`
+	ExpectedAmount decimal.Decimal `json:"expected_amount"`
+	ContractedAt   time.Time       `json:"contracted_at"`
+}
+
+type Report struct {
+	Invoice          Invoice         `json:"invoice"`
+	Matched          []LineResult    `json:"matched"`
+	Disputed         []LineResult    `json:"disputed"`
+	Unexpected       []LineResult    `json:"unexpected"`
+	Missing          []MissingCharge `json:"missing"`
+	InvoicedTotal    decimal.Decimal `json:"invoiced_total"`
+	ExpectedTotal    decimal.Decimal `json:"expected_total"`
+	DisputedAmount   decimal.Decimal `json:"disputed_amount"`
+	UnexpectedAmount decimal.Decimal `json:"unexpected_amount"`
+	MissingAmount    decimal.Decimal `json:"missing_amount"`
+}
+
+func (inv Invoice) periodEnd() time.Time {
+	return inv.PeriodTo.AddDate(0, 0, 1)
+}
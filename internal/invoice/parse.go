@@ -0,0 +1,169 @@
+package invoice
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var ErrInvalidInvoice = errors.New("invalid invoice")
+
+const (
+	columnTrackingCode = "tracking_code"
+	columnOrderID      = "order_id"
+	columnAmount       = "amount"
+	columnWeightKg     = "weight_kg"
+)
+
+type jsonInvoice struct {
+	Lines []jsonLine `json:"lines"`
+}
+
+type jsonLine struct {
+	TrackingCode string           `json:"tracking_code"`
+	OrderID      string           `json:"order_id"`
+	Amount       *decimal.Decimal `json:"amount"`
+	WeightKg     *decimal.Decimal `json:"weight_kg"`
+}
+
+func ParseJSON(data []byte) ([]Line, error) {
+	var payload jsonInvoice
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInvoice, err)
+	}
+
+	lines := make([]Line, 0, len(payload.Lines))
+	for i, l := range payload.Lines {
+		if l.Amount == nil {
+			return nil, fmt.Errorf("%w: line %d: amount is required", ErrInvalidInvoice, i+1)
+		}
+		line, err := newLine(i+1, l.TrackingCode, l.OrderID, *l.Amount, l.WeightKg)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	return nonEmpty(lines)
+}
+
+func ParseCSV(data []byte) ([]Line, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = delimiter(data)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing CSV header: %v", ErrInvalidInvoice, err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, ok := columns[columnAmount]; !ok {
+		return nil, fmt.Errorf("%w: CSV header must have an %s column", ErrInvalidInvoice, columnAmount)
+	}
+	_, hasCode := columns[columnTrackingCode]
+	_, hasOrder := columns[columnOrderID]
+	if !hasCode && !hasOrder {
+		return nil, fmt.Errorf("%w: CSV header must have a %s or %s column",
+			ErrInvalidInvoice, columnTrackingCode, columnOrderID)
+	}
+
+	value := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var lines []Line
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInvoice, err)
+		}
+
+		amount, err := parseDecimal(value(record, columnAmount))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: invalid amount", ErrInvalidInvoice, number)
+		}
+
+		var weight *decimal.Decimal
+		if raw := value(record, columnWeightKg); raw != "" {
+			w, err := parseDecimal(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: invalid weight", ErrInvalidInvoice, number)
+			}
+			weight = &w
+		}
+
+		line, err := newLine(number, value(record, columnTrackingCode), value(record, columnOrderID), amount, weight)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	return nonEmpty(lines)
+}
+
+func newLine(number int, trackingCode, orderID string, amount decimal.Decimal, weight *decimal.Decimal) (Line, error) {
+	line := Line{Number: number, Amount: amount, WeightKg: weight}
+
+	if code := strings.TrimSpace(trackingCode); code != "" {
+		line.TrackingCode = &code
+	}
+	if id := strings.TrimSpace(orderID); id != "" {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return Line{}, fmt.Errorf("%w: line %d: invalid order ID", ErrInvalidInvoice, number)
+		}
+		line.OrderID = &parsed
+	}
+
+	switch {
+	case line.TrackingCode == nil && line.OrderID == nil:
+		return Line{}, fmt.Errorf("%w: line %d: tracking code or order ID is required", ErrInvalidInvoice, number)
+	case amount.IsNegative():
+		return Line{}, fmt.Errorf("%w: line %d: amount must not be negative", ErrInvalidInvoice, number)
+	case weight != nil && weight.IsNegative():
+		return Line{}, fmt.Errorf("%w: line %d: weight must not be negative", ErrInvalidInvoice, number)
+	}
+
+	return line, nil
+}
+
+func nonEmpty(lines []Line) ([]Line, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: no lines", ErrInvalidInvoice)
+	}
+	return lines, nil
+}
+
+func delimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+func parseDecimal(raw string) (decimal.Decimal, error) {
+	if strings.Contains(raw, ",") {
+		raw = strings.ReplaceAll(strings.ReplaceAll(raw, ".", ""), ",", ".")
+	}
+	return decimal.NewFromString(raw)
+}
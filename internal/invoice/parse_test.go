@@ -0,0 +1,52 @@
+package invoice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+)
+
+func TestParseCSV(t *testing.T) {
+	data := "Tracking_Code;Order_ID;Amount;Weight_Kg\n" +
+		"BR1;;1.234,56;3,5\n" +
+		";123e4567-e89b-12d3-a456-426614174000;30.10;\n"
+
+	lines, err := invoice.ParseCSV([]byte(data))
+	assert.NoError(t, err)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "BR1", *lines[0].TrackingCode)
+	assert.Equal(t, "1234.56", lines[0].Amount.StringFixed(2))
+	assert.Equal(t, "3.50", lines[0].WeightKg.StringFixed(2))
+	assert.Nil(t, lines[1].TrackingCode)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", lines[1].OrderID.String())
+	assert.Nil(t, lines[1].WeightKg)
+}
+
+func TestParseCSV_Invalid(t *testing.T) {
+	_, err := invoice.ParseCSV([]byte("tracking_code,weight_kg\nBR1,3\n"))
+	assert.ErrorIs(t, err, invoice.ErrInvalidInvoice)
+
+	_, err = invoice.ParseCSV([]byte("tracking_code,amount\n,10\n"))
+	assert.ErrorIs(t, err, invoice.ErrInvalidInvoice)
+
+	_, err = invoice.ParseCSV([]byte("tracking_code,amount\nBR1,abc\n"))
+	assert.ErrorIs(t, err, invoice.ErrInvalidInvoice)
+}
+
+func TestParseJSON(t *testing.T) {
+	lines, err := invoice.ParseJSON([]byte(`{"lines":[
+		{"tracking_code":"BR1","amount":30.4,"weight_kg":"3"},
+		{"order_id":"123e4567-e89b-12d3-a456-426614174000","amount":"12"}
+	]}`))
+	assert.NoError(t, err)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, 2, lines[1].Number)
+	assert.Equal(t, "30.40", lines[0].Amount.StringFixed(2))
+
+	_, err = invoice.ParseJSON([]byte(`{"lines":[{"tracking_code":"BR1"}]}`))
+	assert.ErrorIs(t, err, invoice.ErrInvalidInvoice)
+
+	_, err = invoice.ParseJSON([]byte(`{"lines":[]}`))
+	assert.ErrorIs(t, err, invoice.ErrInvalidInvoice)
+}
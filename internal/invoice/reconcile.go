@@ -0,0 +1,171 @@
+package invoice
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+)
+
+func Reconcile(inv Invoice, contracts []shipping.Contract, weights map[uuid.UUID]decimal.Decimal) Report {
+	byCode := map[string]shipping.Contract{}
+	byOrder := map[uuid.UUID]shipping.Contract{}
+	for _, c := range contracts {
+		if c.TrackingCode != nil {
+			byCode[*c.TrackingCode] = c
+		}
+		if current, ok := byOrder[c.OrderID]; !ok || preferred(c, current) {
+			byOrder[c.OrderID] = c
+		}
+	}
+
+	report := Report{
+		Invoice:          inv,
+		Matched:          []LineResult{},
+		Disputed:         []LineResult{},
+		Unexpected:       []LineResult{},
+		Missing:          []MissingCharge{},
+		InvoicedTotal:    decimal.Zero,
+		ExpectedTotal:    decimal.Zero,
+		DisputedAmount:   decimal.Zero,
+		UnexpectedAmount: decimal.Zero,
+		MissingAmount:    decimal.Zero,
+	}
+	report.Invoice.Lines = nil
+
+	billed := map[uuid.UUID]bool{}
+	for _, line := range inv.Lines {
+		report.InvoicedTotal = report.InvoicedTotal.Add(line.Amount)
+
+		contract, ok := lookup(line, byCode, byOrder)
+		if !ok {
+			report.UnexpectedAmount = report.UnexpectedAmount.Add(line.Amount)
+			report.Unexpected = append(report.Unexpected, LineResult{
+				Line:    line,
+				Status:  LineUnexpected,
+				Reasons: []string{"no contract found for the line"},
+			})
+			continue
+		}
+
+		duplicate := billed[contract.ID]
+		billed[contract.ID] = true
+
+		result := compare(inv, line, contract, weights)
+		if duplicate {
+			result.Reasons = append(result.Reasons, "contract already charged on a previous line")
+		}
+		if !duplicate && contract.Status == shipping.ContractStatusActive {
+			report.ExpectedTotal = report.ExpectedTotal.Add(*result.ExpectedAmount)
+		}
+
+		if len(result.Reasons) == 0 {
+			result.Status = LineMatched
+			report.Matched = append(report.Matched, result)
+			continue
+		}
+		result.Status = LineDisputed
+		report.DisputedAmount = report.DisputedAmount.Add(disputedAmount(inv, result, contract, duplicate))
+		report.Disputed = append(report.Disputed, result)
+	}
+
+	for _, c := range contracts {
+		if billed[c.ID] || !expectedInPeriod(inv, c) {
+			continue
+		}
+		expected := c.TotalPrice()
+		report.ExpectedTotal = report.ExpectedTotal.Add(expected)
+		report.MissingAmount = report.MissingAmount.Add(expected)
+		report.Missing = append(report.Missing, MissingCharge{
+			ContractID:     c.ID,
+			OrderID:        c.OrderID,
+			TrackingCode:   c.TrackingCode,
+			ExpectedAmount: expected,
+			ContractedAt:   c.ContractedAt,
+		})
+	}
+	sort.Slice(report.Missing, func(i, j int) bool {
+		return report.Missing[i].ContractedAt.Before(report.Missing[j].ContractedAt)
+	})
+
+	return report
+}
+
+func lookup(
+	line Line,
+	byCode map[string]shipping.Contract,
+	byOrder map[uuid.UUID]shipping.Contract,
+) (shipping.Contract, bool) {
+	if line.TrackingCode != nil {
+		if c, ok := byCode[*line.TrackingCode]; ok {
+			return c, true
+		}
+	}
+	if line.OrderID != nil {
+		if c, ok := byOrder[*line.OrderID]; ok {
+			return c, true
+		}
+	}
+	return shipping.Contract{}, false
+}
+
+func compare(inv Invoice, line Line, contract shipping.Contract, weights map[uuid.UUID]decimal.Decimal) LineResult {
+	expected := contract.TotalPrice()
+	result := LineResult{
+		Line:               line,
+		ContractID:         &contract.ID,
+		ExpectedAmount:     &expected,
+		AmountDifference:   line.Amount.Sub(expected),
+		WeightDifferenceKg: decimal.Zero,
+		Reasons:            []string{},
+	}
+
+	if line.OrderID != nil && *line.OrderID != contract.OrderID {
+		result.Reasons = append(result.Reasons, "order ID does not match the contract of the tracking code")
+	}
+	if contract.Status != shipping.ContractStatusActive {
+		result.Reasons = append(result.Reasons, fmt.Sprintf("contract is %s", contract.Status))
+	}
+	if result.AmountDifference.Abs().GreaterThan(inv.PriceTolerance) {
+		result.Reasons = append(result.Reasons,
+			fmt.Sprintf("amount differs from the contract by %s", result.AmountDifference.StringFixed(2)))
+	}
+
+	if weight, ok := weights[contract.OrderID]; ok {
+		result.ExpectedWeightKg = &weight
+		if line.WeightKg != nil {
+			result.WeightDifferenceKg = line.WeightKg.Sub(weight)
+			if result.WeightDifferenceKg.Abs().GreaterThan(inv.WeightToleranceKg) {
+				result.Reasons = append(result.Reasons,
+					fmt.Sprintf("weight differs from the order by %s kg", result.WeightDifferenceKg.StringFixed(2)))
+			}
+		}
+	}
+
+	return result
+}
+
+func disputedAmount(inv Invoice, result LineResult, contract shipping.Contract, duplicate bool) decimal.Decimal {
+	if duplicate || contract.Status != shipping.ContractStatusActive {
+		return result.Amount
+	}
+	if result.AmountDifference.GreaterThan(inv.PriceTolerance) {
+		return result.AmountDifference
+	}
+	return decimal.Zero
+}
+
+func expectedInPeriod(inv Invoice, c shipping.Contract) bool {
+	return c.Status == shipping.ContractStatusActive &&
+		!c.ContractedAt.Before(inv.PeriodFrom) &&
+		c.ContractedAt.Before(inv.periodEnd())
+}
+
+func preferred(c, current shipping.Contract) bool {
+	if (c.Status == shipping.ContractStatusActive) != (current.Status == shipping.ContractStatusActive) {
+		return c.Status == shipping.ContractStatusActive
+	}
+	return c.ContractedAt.After(current.ContractedAt)
+}
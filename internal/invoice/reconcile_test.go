@@ -0,0 +1,110 @@
+package invoice_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+)
+
+var june = invoice.Invoice{
+	ID:                uuid.New(),
+	CarrierID:         uuid.New(),
+	Reference:         "FAT-2025-06",
+	PeriodFrom:        time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+	PeriodTo:          time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+	PriceTolerance:    decimal.NewFromFloat(0.5),
+	WeightToleranceKg: decimal.NewFromFloat(0.5),
+}
+
+func contract(code string, price float64, contractedAt time.Time) shipping.Contract {
+	return shipping.Contract{
+		ID:           uuid.New(),
+		OrderID:      uuid.New(),
+		CarrierID:    june.CarrierID,
+		Price:        decimal.NewFromFloat(price),
+		ICMS:         decimal.Zero,
+		Status:       shipping.ContractStatusActive,
+		TrackingCode: &code,
+		ContractedAt: contractedAt,
+	}
+}
+
+func line(number int, code string, amount float64, weight *float64) invoice.Line {
+	l := invoice.Line{Number: number, TrackingCode: &code, Amount: decimal.NewFromFloat(amount)}
+	if weight != nil {
+		w := decimal.NewFromFloat(*weight)
+		l.WeightKg = &w
+	}
+	return l
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestReconcile(t *testing.T) {
+	day := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	matched := contract("BR1", 30, day)
+	overpriced := contract("BR2", 40, day)
+	heavier := contract("BR3", 25, day)
+	missing := contract("BR4", 50, time.Date(2025, 6, 30, 23, 0, 0, 0, time.UTC))
+	july := contract("BR5", 60, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+	voided := contract("BR6", 20, day)
+	voided.Status = shipping.ContractStatusVoided
+
+	weights := map[uuid.UUID]decimal.Decimal{
+		matched.OrderID:    decimal.NewFromInt(3),
+		overpriced.OrderID: decimal.NewFromInt(5),
+		heavier.OrderID:    decimal.NewFromInt(2),
+	}
+
+	inv := june
+	inv.Lines = []invoice.Line{
+		line(1, "BR1", 30.40, ptr(3.2)),
+		line(2, "BR2", 45, nil),
+		line(3, "BR3", 25, ptr(4.0)),
+		line(4, "BR1", 30, nil),
+		line(5, "XX9", 12, nil),
+		line(6, "BR6", 20, nil),
+	}
+
+	report := invoice.Reconcile(inv, []shipping.Contract{matched, overpriced, heavier, missing, july, voided}, weights)
+
+	assert.Len(t, report.Matched, 1)
+	assert.Equal(t, 1, report.Matched[0].Number)
+	assert.Equal(t, matched.ID, *report.Matched[0].ContractID)
+
+	assert.Len(t, report.Disputed, 4)
+	assert.Equal(t, "5.00", report.Disputed[0].AmountDifference.StringFixed(2))
+	assert.Equal(t, []string{"amount differs from the contract by 5.00"}, report.Disputed[0].Reasons)
+	assert.Equal(t, "2.00", report.Disputed[1].WeightDifferenceKg.StringFixed(2))
+	assert.Equal(t, []string{"contract already charged on a previous line"}, report.Disputed[2].Reasons)
+	assert.Equal(t, []string{"contract is voided"}, report.Disputed[3].Reasons)
+
+	assert.Len(t, report.Unexpected, 1)
+	assert.Equal(t, 5, report.Unexpected[0].Number)
+
+	assert.Len(t, report.Missing, 1)
+	assert.Equal(t, missing.ID, report.Missing[0].ContractID)
+
+	assert.Equal(t, "162.40", report.InvoicedTotal.StringFixed(2))
+	assert.Equal(t, "145.00", report.ExpectedTotal.StringFixed(2))
+	assert.Equal(t, "55.00", report.DisputedAmount.StringFixed(2))
+	assert.Equal(t, "12.00", report.UnexpectedAmount.StringFixed(2))
+	assert.Equal(t, "50.00", report.MissingAmount.StringFixed(2))
+}
+
+func TestReconcile_MatchesByOrderID(t *testing.T) {
+	c := contract("BR1", 30, time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC))
+	inv := june
+	inv.Lines = []invoice.Line{{Number: 1, OrderID: &c.OrderID, Amount: decimal.NewFromInt(30)}}
+
+	report := invoice.Reconcile(inv, []shipping.Contract{c}, nil)
+	assert.Len(t, report.Matched, 1)
+	assert.Empty(t, report.Missing)
+}
@@ -0,0 +1,191 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	ErrInvoiceExists   = errors.New("invoice reference already imported for the carrier")
+)
+
+const (
+	uniqueViolationCode    = "23505"
+	uniqueInvoiceReference = "unique_invoice_reference_per_carrier"
+)
+
+const (
+	queryInsertInvoice = `
+	INSERT INTO invoices (carrier_id, reference, period_from, period_to, price_tolerance,
+	                      weight_tolerance_kg, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id
+`
+
+	queryInsertInvoiceLine = `
+	INSERT INTO invoice_lines (invoice_id, line_number, tracking_code, order_id, amount, weight_kg)
+	VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+	querySelectInvoice = `
+	SELECT id, carrier_id, reference, period_from, period_to, price_tolerance, weight_tolerance_kg, created_at
+	FROM invoices
+	WHERE id = $1
+`
+
+	querySelectInvoiceLines = `
+	SELECT line_number, tracking_code, order_id, amount, weight_kg
+	FROM invoice_lines
+	WHERE invoice_id = $1
+	ORDER BY line_number
+`
+
+	queryListInvoicesByCarrier = `
+	SELECT id, carrier_id, reference, period_from, period_to, price_tolerance, weight_tolerance_kg, created_at
+	FROM invoices
+	WHERE carrier_id = $1
+	ORDER BY period_from DESC, created_at DESC
+`
+)
+
+//go:generate moq -pkg mocks -out mocks/repository.go . Repository
+type Repository interface {
+	Insert(ctx context.Context, inv *Invoice) (uuid.UUID, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Invoice, error)
+	ListByCarrier(ctx context.Context, carrierID uuid.UUID) ([]Invoice, error)
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) Repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) Insert(ctx context.Context, inv *Invoice) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, queryInsertInvoice,
+			inv.CarrierID,
+			inv.Reference,
+			inv.PeriodFrom,
+			inv.PeriodTo,
+			inv.PriceTolerance,
+			inv.WeightToleranceKg,
+			inv.CreatedAt,
+		).Scan(&id)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range inv.Lines {
+			_, err := tx.Exec(ctx, queryInsertInvoiceLine,
+				id,
+				line.Number,
+				line.TrackingCode,
+				line.OrderID,
+				line.Amount,
+				line.WeightKg,
+			)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", line.Number, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode &&
+			pgErr.ConstraintName == uniqueInvoiceReference {
+			return uuid.Nil, ErrInvoiceExists
+		}
+		return uuid.Nil, fmt.Errorf("failed to insert invoice: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*Invoice, error) {
+	inv, err := scanInvoice(r.pool.QueryRow(ctx, querySelectInvoice, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, querySelectInvoiceLines, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice lines: %w", err)
+	}
+	defer rows.Close()
+
+	inv.Lines = []Line{}
+	for rows.Next() {
+		var (
+			line   Line
+			weight decimal.NullDecimal
+		)
+		if err := rows.Scan(&line.Number, &line.TrackingCode, &line.OrderID, &line.Amount, &weight); err != nil {
+			return nil, fmt.Errorf("failed to scan invoice line: %w", err)
+		}
+		if weight.Valid {
+			line.WeightKg = &weight.Decimal
+		}
+		inv.Lines = append(inv.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list invoice lines: %w", err)
+	}
+
+	return inv, nil
+}
+
+func (r *repository) ListByCarrier(ctx context.Context, carrierID uuid.UUID) ([]Invoice, error) {
+	rows, err := r.pool.Query(ctx, queryListInvoicesByCarrier, carrierID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+	defer rows.Close()
+
+	invoices := []Invoice{}
+	for rows.Next() {
+		inv, err := scanInvoice(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invoice: %w", err)
+		}
+		invoices = append(invoices, *inv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	return invoices, nil
+}
+
+func scanInvoice(row pgx.Row) (*Invoice, error) {
+	var inv Invoice
+	err := row.Scan(
+		&inv.ID,
+		&inv.CarrierID,
+		&inv.Reference,
+		&inv.PeriodFrom,
+		&inv.PeriodTo,
+		&inv.PriceTolerance,
+		&inv.WeightToleranceKg,
+		&inv.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
@@ -0,0 +1,223 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	log "go.uber.org/zap"
+)
+
+var ErrInvalidPeriod = errors.New("invoice period must not end before it starts")
+
+const contractsPageSize = 500
+
+type Config struct {
+	PriceTolerance    decimal.Decimal
+	WeightToleranceKg decimal.Decimal
+}
+
+//go:generate moq -pkg mocks -out mocks/service.go . Service
+type Service interface {
+	Import(ctx context.Context, inv *Invoice) (*Report, error)
+	Reconcile(ctx context.Context, id uuid.UUID) (*Report, error)
+	ListByCarrier(ctx context.Context, carrierID uuid.UUID) ([]Invoice, error)
+}
+
+type service struct {
+	repo              Repository
+	carrierRepository carrier.Repository
+	orderRepository   order.Repository
+	contracts         shipping.Repository
+	config            Config
+}
+
+func NewService(
+	repo Repository,
+	carrierRepository carrier.Repository,
+	orderRepository order.Repository,
+	contracts shipping.Repository,
+	config Config,
+) Service {
+	return &service{
+		repo:              repo,
+		carrierRepository: carrierRepository,
+		orderRepository:   orderRepository,
+		contracts:         contracts,
+		config:            config,
+	}
+}
+
+func (s *service) Import(ctx context.Context, inv *Invoice) (*Report, error) {
+	if inv.PeriodTo.Before(inv.PeriodFrom) {
+		return nil, ErrInvalidPeriod
+	}
+
+	if _, err := s.carrierRepository.GetByID(ctx, inv.CarrierID); err != nil {
+		log.L().
+			Error("failed to get carrier for invoice", log.String("carrier_id", inv.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	inv.PriceTolerance = s.config.PriceTolerance
+	inv.WeightToleranceKg = s.config.WeightToleranceKg
+	inv.CreatedAt = time.Now().UTC()
+
+	id, err := s.repo.Insert(ctx, inv)
+	if err != nil {
+		log.L().
+			Error("failed to insert invoice", log.String("carrier_id", inv.CarrierID.String()), log.String("reference", inv.Reference), log.Error(err))
+		return nil, err
+	}
+	inv.ID = id
+
+	return s.reconcile(ctx, *inv)
+}
+
+func (s *service) Reconcile(ctx context.Context, id uuid.UUID) (*Report, error) {
+	inv, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		log.L().
+			Error("failed to get invoice by ID", log.String("invoice_id", id.String()), log.Error(err))
+		return nil, err
+	}
+
+	return s.reconcile(ctx, *inv)
+}
+
+func (s *service) ListByCarrier(ctx context.Context, carrierID uuid.UUID) ([]Invoice, error) {
+	invoices, err := s.repo.ListByCarrier(ctx, carrierID)
+	if err != nil {
+		log.L().
+			Error("failed to list invoices", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return nil, err
+	}
+	return invoices, nil
+}
+
+func (s *service) reconcile(ctx context.Context, inv Invoice) (*Report, error) {
+	contracts, err := s.periodContracts(ctx, inv)
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[uuid.UUID]bool{}
+	codes := map[string]bool{}
+	orders := map[uuid.UUID]bool{}
+	for _, c := range contracts {
+		known[c.ID] = true
+		if c.TrackingCode != nil {
+			codes[*c.TrackingCode] = true
+		}
+		orders[c.OrderID] = true
+	}
+
+	for _, line := range inv.Lines {
+		c, err := s.lineContract(ctx, inv.CarrierID, line, codes, orders)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil || known[c.ID] {
+			continue
+		}
+		known[c.ID] = true
+		if c.TrackingCode != nil {
+			codes[*c.TrackingCode] = true
+		}
+		orders[c.OrderID] = true
+		contracts = append(contracts, *c)
+	}
+
+	orderIDs := make([]uuid.UUID, 0, len(orders))
+	for id := range orders {
+		orderIDs = append(orderIDs, id)
+	}
+	weights := map[uuid.UUID]decimal.Decimal{}
+	if len(orderIDs) > 0 {
+		found, err := s.orderRepository.ListByIDs(ctx, orderIDs)
+		if err != nil {
+			log.L().
+				Error("failed to list invoiced orders", log.String("invoice_id", inv.ID.String()), log.Error(err))
+			return nil, err
+		}
+		for _, o := range found {
+			weights[o.ID] = o.WeightKg
+		}
+	}
+
+	report := Reconcile(inv, contracts, weights)
+	return &report, nil
+}
+
+func (s *service) periodContracts(ctx context.Context, inv Invoice) ([]shipping.Contract, error) {
+	status := shipping.ContractStatusActive
+	end := inv.periodEnd()
+	filter := shipping.ContractFilter{
+		CarrierID: &inv.CarrierID,
+		Status:    &status,
+		From:      &inv.PeriodFrom,
+		To:        &end,
+		Limit:     contractsPageSize,
+	}
+
+	var contracts []shipping.Contract
+	for {
+		page, err := s.contracts.ListContracts(ctx, filter)
+		if err != nil {
+			log.L().
+				Error("failed to list contracts for invoice", log.String("invoice_id", inv.ID.String()), log.Error(err))
+			return nil, err
+		}
+		contracts = append(contracts, page...)
+		if len(page) < filter.Limit {
+			return contracts, nil
+		}
+		filter.Offset += filter.Limit
+	}
+}
+
+func (s *service) lineContract(
+	ctx context.Context,
+	carrierID uuid.UUID,
+	line Line,
+	codes map[string]bool,
+	orders map[uuid.UUID]bool,
+) (*shipping.Contract, error) {
+	if line.TrackingCode != nil {
+		if codes[*line.TrackingCode] {
+			return nil, nil
+		}
+		c, err := s.contracts.GetContractByTrackingCode(ctx, carrierID, *line.TrackingCode)
+		switch {
+		case err == nil:
+			return c, nil
+		case !errors.Is(err, shipping.ErrContractNotFound):
+			log.L().
+				Error("failed to get contract by tracking code", log.String("tracking_code", *line.TrackingCode), log.Error(err))
+			return nil, err
+		}
+	}
+
+	if line.OrderID == nil || orders[*line.OrderID] {
+		return nil, nil
+	}
+	found, err := s.contracts.ListContracts(ctx, shipping.ContractFilter{
+		OrderID:   line.OrderID,
+		CarrierID: &carrierID,
+		Limit:     1,
+	})
+	if err != nil {
+		log.L().
+			Error("failed to list contracts by order", log.String("order_id", line.OrderID.String()), log.Error(err))
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	return &found[0], nil
+}
@@ -0,0 +1,84 @@
+package invoice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	carriermock "github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice"
+	"github.com/victorvcruz/shipment-coordinator/internal/invoice/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	shippingmock "github.com/victorvcruz/shipment-coordinator/internal/shipping/mocks"
+)
+
+func TestService_Import_LooksUpContractsOutsideThePeriod(t *testing.T) {
+	may := contract("BR0", 28, time.Date(2025, 5, 31, 22, 0, 0, 0, time.UTC))
+	inPeriod := contract("BR1", 30, time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC))
+
+	repo := &mocks.RepositoryMock{
+		InsertFunc: func(ctx context.Context, inv *invoice.Invoice) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+	}
+	carriers := &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return &carrier.Carrier{ID: id}, nil
+		},
+	}
+	orders := &ordermock.RepositoryMock{
+		ListByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]order.Order, error) {
+			found := make([]order.Order, len(ids))
+			for i, id := range ids {
+				found[i] = order.Order{ID: id, WeightKg: decimal.NewFromInt(3)}
+			}
+			return found, nil
+		},
+	}
+	contracts := &shippingmock.RepositoryMock{
+		ListContractsFunc: func(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error) {
+			return []shipping.Contract{inPeriod}, nil
+		},
+		GetContractByTrackingCodeFunc: func(ctx context.Context, carrierID uuid.UUID, code string) (*shipping.Contract, error) {
+			if code == "BR0" {
+				return &may, nil
+			}
+			return nil, shipping.ErrContractNotFound
+		},
+	}
+
+	svc := invoice.NewService(repo, carriers, orders, contracts, invoice.Config{
+		PriceTolerance:    decimal.NewFromFloat(0.5),
+		WeightToleranceKg: decimal.NewFromFloat(0.5),
+	})
+
+	inv := june
+	inv.Lines = []invoice.Line{
+		line(1, "BR0", 28, ptr(3.0)),
+		line(2, "BR1", 30, ptr(3.0)),
+	}
+	report, err := svc.Import(context.Background(), &inv)
+	assert.NoError(t, err)
+	assert.Len(t, report.Matched, 2)
+	assert.Empty(t, report.Missing)
+
+	filter := contracts.ListContractsCalls()[0].Filter
+	assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), *filter.To)
+	assert.Len(t, contracts.GetContractByTrackingCodeCalls(), 1)
+	assert.Equal(t, "0.50", repo.InsertCalls()[0].Inv.PriceTolerance.StringFixed(2))
+}
+
+func TestService_Import_InvalidPeriod(t *testing.T) {
+	svc := invoice.NewService(&mocks.RepositoryMock{}, nil, nil, nil, invoice.Config{})
+
+	inv := june
+	inv.PeriodTo = inv.PeriodFrom.AddDate(0, 0, -1)
+	_, err := svc.Import(context.Background(), &inv)
+	assert.ErrorIs(t, err, invoice.ErrInvalidPeriod)
+}
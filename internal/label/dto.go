@@ -0,0 +1,46 @@
+package label
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RenderInput struct {
+	ID     uuid.UUID `path:"id"      doc:"Contract ID"`
+	Format string    `query:"format" doc:"Label format, pdf for regular printers or zpl for thermal printers" default:"pdf" enum:"pdf,zpl"`
+}
+
+type RenderOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	Body               []byte
+}
+
+type TemplateInput struct {
+	ID     uuid.UUID `path:"id"     doc:"Carrier ID"`
+	Format string    `path:"format" doc:"Label format" enum:"pdf,zpl"`
+}
+
+type SetTemplateInput struct {
+	ID     uuid.UUID `path:"id"     doc:"Carrier ID"`
+	Format string    `path:"format" doc:"Label format" enum:"pdf,zpl"`
+	Body   SetTemplateInputBody
+}
+
+type SetTemplateInputBody struct {
+	Content string `json:"content" required:"true" doc:"Go text/template over ContractID, OrderID, TrackingCode, CarrierName, DestinationUF, DestinationState and WeightKg; in PDF templates a line starting with '# ' is a title and a line '@barcode' draws the tracking code barcode" example:"# {{.CarrierName}}\n@barcode\n{{.TrackingCode}}" minLength:"1" maxLength:"16384"`
+}
+
+type TemplateOutput struct {
+	Status int
+	Body   TemplateOutputBody
+}
+
+type TemplateOutputBody struct {
+	CarrierID uuid.UUID  `json:"carrier_id"           doc:"Carrier ID"                                            example:"123e4567-e89b-12d3-a456-426614174000"`
+	Format    string     `json:"format"               doc:"Label format"                                          example:"pdf"`
+	Content   string     `json:"content"              doc:"Template content"                                      example:"# {{.CarrierName}}\n@barcode\n{{.TrackingCode}}"`
+	Default   bool       `json:"default"              doc:"Whether the built-in template is used for the carrier" example:"false"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" doc:"Last update date, empty for the built-in template"     example:"2025-06-28T15:04:05Z"`
+}
@@ -0,0 +1,139 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/label"
+	"sync"
+)
+
+// Ensure, that RepositoryMock does implement label.Repository.
+// If this is not the case, regenerate this file with moq.
+var _ label.Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of label.Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked label.Repository
+//		mockedRepository := &RepositoryMock{
+//			GetTemplateFunc: func(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error) {
+//				panic("mock out the GetTemplate method")
+//			},
+//			SetTemplateFunc: func(ctx context.Context, template *label.Template) error {
+//				panic("mock out the SetTemplate method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires label.Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// GetTemplateFunc mocks the GetTemplate method.
+	GetTemplateFunc func(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error)
+
+	// SetTemplateFunc mocks the SetTemplate method.
+	SetTemplateFunc func(ctx context.Context, template *label.Template) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTemplate holds details about calls to the GetTemplate method.
+		GetTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// Format is the format argument value.
+			Format label.Format
+		}
+		// SetTemplate holds details about calls to the SetTemplate method.
+		SetTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Template is the template argument value.
+			Template *label.Template
+		}
+	}
+	lockGetTemplate sync.RWMutex
+	lockSetTemplate sync.RWMutex
+}
+
+// GetTemplate calls GetTemplateFunc.
+func (mock *RepositoryMock) GetTemplate(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error) {
+	if mock.GetTemplateFunc == nil {
+		panic("RepositoryMock.GetTemplateFunc: method is nil but Repository.GetTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Format    label.Format
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+		Format:    format,
+	}
+	mock.lockGetTemplate.Lock()
+	mock.calls.GetTemplate = append(mock.calls.GetTemplate, callInfo)
+	mock.lockGetTemplate.Unlock()
+	return mock.GetTemplateFunc(ctx, carrierID, format)
+}
+
+// GetTemplateCalls gets all the calls that were made to GetTemplate.
+// Check the length with:
+//
+//	len(mockedRepository.GetTemplateCalls())
+func (mock *RepositoryMock) GetTemplateCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+	Format    label.Format
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Format    label.Format
+	}
+	mock.lockGetTemplate.RLock()
+	calls = mock.calls.GetTemplate
+	mock.lockGetTemplate.RUnlock()
+	return calls
+}
+
+// SetTemplate calls SetTemplateFunc.
+func (mock *RepositoryMock) SetTemplate(ctx context.Context, template *label.Template) error {
+	if mock.SetTemplateFunc == nil {
+		panic("RepositoryMock.SetTemplateFunc: method is nil but Repository.SetTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Template *label.Template
+	}{
+		Ctx:      ctx,
+		Template: template,
+	}
+	mock.lockSetTemplate.Lock()
+	mock.calls.SetTemplate = append(mock.calls.SetTemplate, callInfo)
+	mock.lockSetTemplate.Unlock()
+	return mock.SetTemplateFunc(ctx, template)
+}
+
+// SetTemplateCalls gets all the calls that were made to SetTemplate.
+// Check the length with:
+//
+//	len(mockedRepository.SetTemplateCalls())
+func (mock *RepositoryMock) SetTemplateCalls() []struct {
+	Ctx      context.Context
+	Template *label.Template
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Template *label.Template
+	}
+	mock.lockSetTemplate.RLock()
+	calls = mock.calls.SetTemplate
+	mock.lockSetTemplate.RUnlock()
+	return calls
+}
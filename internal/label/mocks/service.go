@@ -0,0 +1,195 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/label"
+	"sync"
+)
+
+// Ensure, that ServiceMock does implement label.Service.
+// If this is not the case, regenerate this file with moq.
+var _ label.Service = &ServiceMock{}
+
+// ServiceMock is a mock implementation of label.Service.
+//
+//	func TestSomethingThatUsesService(t *testing.T) {
+//
+//		// make and configure a mocked label.Service
+//		mockedService := &ServiceMock{
+//			GetTemplateFunc: func(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error) {
+//				panic("mock out the GetTemplate method")
+//			},
+//			RenderFunc: func(ctx context.Context, contractID uuid.UUID, format label.Format) (*label.Label, error) {
+//				panic("mock out the Render method")
+//			},
+//			SetTemplateFunc: func(ctx context.Context, template *label.Template) (*label.Template, error) {
+//				panic("mock out the SetTemplate method")
+//			},
+//		}
+//
+//		// use mockedService in code that requires label.Service
+//		// and then make assertions.
+//
+//	}
+type ServiceMock struct {
+	// GetTemplateFunc mocks the GetTemplate method.
+	GetTemplateFunc func(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error)
+
+	// RenderFunc mocks the Render method.
+	RenderFunc func(ctx context.Context, contractID uuid.UUID, format label.Format) (*label.Label, error)
+
+	// SetTemplateFunc mocks the SetTemplate method.
+	SetTemplateFunc func(ctx context.Context, template *label.Template) (*label.Template, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTemplate holds details about calls to the GetTemplate method.
+		GetTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// Format is the format argument value.
+			Format label.Format
+		}
+		// Render holds details about calls to the Render method.
+		Render []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ContractID is the contractID argument value.
+			ContractID uuid.UUID
+			// Format is the format argument value.
+			Format label.Format
+		}
+		// SetTemplate holds details about calls to the SetTemplate method.
+		SetTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Template is the template argument value.
+			Template *label.Template
+		}
+	}
+	lockGetTemplate sync.RWMutex
+	lockRender      sync.RWMutex
+	lockSetTemplate sync.RWMutex
+}
+
+// GetTemplate calls GetTemplateFunc.
+func (mock *ServiceMock) GetTemplate(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error) {
+	if mock.GetTemplateFunc == nil {
+		panic("ServiceMock.GetTemplateFunc: method is nil but Service.GetTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Format    label.Format
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+		Format:    format,
+	}
+	mock.lockGetTemplate.Lock()
+	mock.calls.GetTemplate = append(mock.calls.GetTemplate, callInfo)
+	mock.lockGetTemplate.Unlock()
+	return mock.GetTemplateFunc(ctx, carrierID, format)
+}
+
+// GetTemplateCalls gets all the calls that were made to GetTemplate.
+// Check the length with:
+//
+//	len(mockedService.GetTemplateCalls())
+func (mock *ServiceMock) GetTemplateCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+	Format    label.Format
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Format    label.Format
+	}
+	mock.lockGetTemplate.RLock()
+	calls = mock.calls.GetTemplate
+	mock.lockGetTemplate.RUnlock()
+	return calls
+}
+
+// Render calls RenderFunc.
+func (mock *ServiceMock) Render(ctx context.Context, contractID uuid.UUID, format label.Format) (*label.Label, error) {
+	if mock.RenderFunc == nil {
+		panic("ServiceMock.RenderFunc: method is nil but Service.Render was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+		Format     label.Format
+	}{
+		Ctx:        ctx,
+		ContractID: contractID,
+		Format:     format,
+	}
+	mock.lockRender.Lock()
+	mock.calls.Render = append(mock.calls.Render, callInfo)
+	mock.lockRender.Unlock()
+	return mock.RenderFunc(ctx, contractID, format)
+}
+
+// RenderCalls gets all the calls that were made to Render.
+// Check the length with:
+//
+//	len(mockedService.RenderCalls())
+func (mock *ServiceMock) RenderCalls() []struct {
+	Ctx        context.Context
+	ContractID uuid.UUID
+	Format     label.Format
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+		Format     label.Format
+	}
+	mock.lockRender.RLock()
+	calls = mock.calls.Render
+	mock.lockRender.RUnlock()
+	return calls
+}
+
+// SetTemplate calls SetTemplateFunc.
+func (mock *ServiceMock) SetTemplate(ctx context.Context, template *label.Template) (*label.Template, error) {
+	if mock.SetTemplateFunc == nil {
+		panic("ServiceMock.SetTemplateFunc: method is nil but Service.SetTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Template *label.Template
+	}{
+		Ctx:      ctx,
+		Template: template,
+	}
+	mock.lockSetTemplate.Lock()
+	mock.calls.SetTemplate = append(mock.calls.SetTemplate, callInfo)
+	mock.lockSetTemplate.Unlock()
+	return mock.SetTemplateFunc(ctx, template)
+}
+
+// SetTemplateCalls gets all the calls that were made to SetTemplate.
+// Check the length with:
+//
+//	len(mockedService.SetTemplateCalls())
+func (mock *ServiceMock) SetTemplateCalls() []struct {
+	Ctx      context.Context
+	Template *label.Template
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Template *label.Template
+	}
+	mock.lockSetTemplate.RLock()
+	calls = mock.calls.SetTemplate
+	mock.lockSetTemplate.RUnlock()
+	return calls
+}
@@ -0,0 +1,49 @@
+package label
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Format string
+
+const (
+	FormatPDF Format = "pdf"
+	FormatZPL Format = "zpl"
+)
+
+var Formats = map[string]Format{
+	"pdf": FormatPDF,
+	"zpl": FormatZPL,
+}
+
+var contentTypes = map[Format]string{
+	FormatPDF: "application/pdf",
+	FormatZPL: "application/zpl",
+}
+
+type Template struct {
+	CarrierID uuid.UUID `json:"carrier_id"`
+	Format    Format    `json:"format"`
+	Content   string    `json:"content"`
+	Default   bool      `json:"default"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Data struct {
+	ContractID       string
+	OrderID          string
+	TrackingCode     string
+	CarrierName      string
+	DestinationUF    string
+	DestinationState string
+	WeightKg         string
+}
+
+type Label struct {
+	Format      Format
+	ContentType string
+	Filename    string
+	Content     []byte
+}
@@ -0,0 +1,167 @@
+package label
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/victorvcruz/shipment-coordinator/pkg/barcode"
+	"github.com/victorvcruz/shipment-coordinator/pkg/pdf"
+)
+
+var ErrInvalidTemplate = errors.New("invalid label template")
+
+const (
+	pdfWidth         = 288
+	pdfHeight        = 432
+	pdfMargin        = 18
+	pdfTitleSize     = 14
+	pdfTextSize      = 10
+	pdfLineSpacing   = 1.4
+	pdfBarcodeHeight = 60
+	pdfMaxModule     = 1.5
+
+	pdfTitlePrefix  = "# "
+	pdfBarcodeLine  = "@barcode"
+	zplStartCommand = "^XA"
+	zplEndCommand   = "^XZ"
+)
+
+var defaultTemplates = map[Format]string{
+	FormatPDF: `# {{.CarrierName}}
+@barcode
+{{.TrackingCode}}
+
+# Destination
+{{.DestinationState}} - {{.DestinationUF}}
+Weight: {{.WeightKg}} kg
+
+Contract: {{.ContractID}}
+Order: {{.OrderID}}
+`,
+	FormatZPL: `^XA
+^CI28
+^FO40,40^A0N,50,50^FD{{.CarrierName}}^FS
+^FO40,120^BY3^BCN,150,Y,N,N^FD{{.TrackingCode}}^FS
+^FO40,360^A0N,40,40^FDDestination: {{.DestinationState}} - {{.DestinationUF}}^FS
+^FO40,420^A0N,40,40^FDWeight: {{.WeightKg}} kg^FS
+^FO40,500^A0N,28,28^FDContract: {{.ContractID}}^FS
+^FO40,540^A0N,28,28^FDOrder: {{.OrderID}}^FS
+^XZ
+`,
+}
+
+var sampleData = Data{
+	ContractID:       "123e4567-e89b-12d3-a456-426614174000",
+	OrderID:          "123e4567-e89b-12d3-a456-426614174001",
+	TrackingCode:     "BR123456789",
+	CarrierName:      "Fast Delivery",
+	DestinationUF:    "SP",
+	DestinationState: "São Paulo",
+	WeightKg:         "12.50",
+}
+
+func DefaultTemplate(format Format) string {
+	return defaultTemplates[format]
+}
+
+func Validate(format Format, content string) error {
+	_, err := render(format, content, sampleData)
+	return err
+}
+
+func render(format Format, content string, data Data) ([]byte, error) {
+	tmpl, err := template.New(string(format)).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	if format == FormatZPL {
+		data = sanitizeZPL(data)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	switch format {
+	case FormatZPL:
+		out := strings.TrimSpace(buf.String())
+		if !strings.HasPrefix(out, zplStartCommand) || !strings.HasSuffix(out, zplEndCommand) {
+			return nil, fmt.Errorf("%w: ZPL must start with %s and end with %s", ErrInvalidTemplate, zplStartCommand, zplEndCommand)
+		}
+		return []byte(out + "\n"), nil
+	case FormatPDF:
+		return renderPDF(buf.String(), data.TrackingCode)
+	}
+	return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalidTemplate, format)
+}
+
+func renderPDF(text, trackingCode string) ([]byte, error) {
+	doc := pdf.New(pdfWidth, pdfHeight)
+	page := doc.AddPage()
+
+	y := float64(pdfMargin)
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		switch {
+		case line == pdfBarcodeLine:
+			height, err := drawBarcode(page, y, trackingCode)
+			if err != nil {
+				return nil, err
+			}
+			y += height + pdfTextSize/2
+		case strings.HasPrefix(line, pdfTitlePrefix):
+			page.BoldText(pdfMargin, y, pdfTitleSize, strings.TrimPrefix(line, pdfTitlePrefix))
+			y += pdfTitleSize * pdfLineSpacing
+		default:
+			if line != "" {
+				page.Text(pdfMargin, y, pdfTextSize, line)
+			}
+			y += pdfTextSize * pdfLineSpacing
+		}
+	}
+
+	return doc.Bytes(), nil
+}
+
+func drawBarcode(page *pdf.Page, y float64, data string) (float64, error) {
+	widths, err := barcode.Code128(data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode tracking code: %w", err)
+	}
+
+	modules := 0
+	for _, w := range widths {
+		modules += w
+	}
+	module := min(pdfMaxModule, (pdfWidth-2*pdfMargin)/float64(modules))
+
+	x := (pdfWidth - module*float64(modules)) / 2
+	for i, w := range widths {
+		if i%2 == 0 {
+			page.Rect(x, y, module*float64(w), pdfBarcodeHeight)
+		}
+		x += module * float64(w)
+	}
+
+	return pdfBarcodeHeight, nil
+}
+
+func sanitizeZPL(data Data) Data {
+	clean := strings.NewReplacer("^", "", "~", "").Replace
+	return Data{
+		ContractID:       clean(data.ContractID),
+		OrderID:          clean(data.OrderID),
+		TrackingCode:     clean(data.TrackingCode),
+		CarrierName:      clean(data.CarrierName),
+		DestinationUF:    clean(data.DestinationUF),
+		DestinationState: clean(data.DestinationState),
+		WeightKg:         clean(data.WeightKg),
+	}
+}
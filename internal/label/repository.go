@@ -0,0 +1,75 @@
+package label
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrTemplateNotFound = errors.New("label template not found")
+
+const (
+	queryUpsertTemplate = `
+	INSERT INTO label_templates (carrier_id, format, content, updated_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (carrier_id, format) DO UPDATE SET content = EXCLUDED.content, updated_at = EXCLUDED.updated_at
+`
+
+	querySelectTemplate = `
+	SELECT carrier_id, format, content, updated_at
+	FROM label_templates
+	WHERE carrier_id = $1 AND format = $2
+`
+)
+
+//go:generate moq -pkg mocks -out mocks/repository.go . Repository
+type Repository interface {
+	SetTemplate(ctx context.Context, template *Template) error
+	GetTemplate(ctx context.Context, carrierID uuid.UUID, format Format) (*Template, error)
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) Repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) SetTemplate(ctx context.Context, template *Template) error {
+	_, err := r.pool.Exec(ctx, queryUpsertTemplate,
+		template.CarrierID,
+		string(template.Format),
+		template.Content,
+		template.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set label template: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetTemplate(ctx context.Context, carrierID uuid.UUID, format Format) (*Template, error) {
+	var (
+		template Template
+		fmtValue string
+	)
+	err := r.pool.QueryRow(ctx, querySelectTemplate, carrierID, string(format)).Scan(
+		&template.CarrierID,
+		&fmtValue,
+		&template.Content,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get label template: %w", err)
+	}
+	template.Format = Format(fmtValue)
+	return &template, nil
+}
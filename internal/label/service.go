@@ -0,0 +1,142 @@
+package label
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	log "go.uber.org/zap"
+)
+
+var ErrMissingTrackingCode = errors.New("contract has no tracking code")
+
+//go:generate moq -pkg mocks -out mocks/service.go . Service
+type Service interface {
+	Render(ctx context.Context, contractID uuid.UUID, format Format) (*Label, error)
+	SetTemplate(ctx context.Context, template *Template) (*Template, error)
+	GetTemplate(ctx context.Context, carrierID uuid.UUID, format Format) (*Template, error)
+}
+
+type service struct {
+	repo              Repository
+	contracts         shipping.Repository
+	orderService      order.Service
+	carrierRepository carrier.Repository
+}
+
+func NewService(
+	repo Repository,
+	contracts shipping.Repository,
+	orderService order.Service,
+	carrierRepository carrier.Repository,
+) Service {
+	return &service{
+		repo:              repo,
+		contracts:         contracts,
+		orderService:      orderService,
+		carrierRepository: carrierRepository,
+	}
+}
+
+func (s *service) Render(ctx context.Context, contractID uuid.UUID, format Format) (*Label, error) {
+	contract, err := s.contracts.GetContractByID(ctx, contractID)
+	if err != nil {
+		log.L().
+			Error("failed to get contract by ID", log.String("contract_id", contractID.String()), log.Error(err))
+		return nil, err
+	}
+	if contract.Status != shipping.ContractStatusActive {
+		return nil, shipping.ErrContractNotActive
+	}
+	if contract.TrackingCode == nil {
+		return nil, ErrMissingTrackingCode
+	}
+
+	o, err := s.orderService.GetByID(ctx, contract.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.carrierRepository.GetByID(ctx, contract.CarrierID)
+	if err != nil {
+		log.L().
+			Error("failed to get carrier by ID", log.String("carrier_id", contract.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	template, err := s.GetTemplate(ctx, c.ID, format)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := render(format, template.Content, Data{
+		ContractID:       contract.ID.String(),
+		OrderID:          o.ID.String(),
+		TrackingCode:     *contract.TrackingCode,
+		CarrierName:      c.Name,
+		DestinationUF:    o.DestinationUF.Sigla,
+		DestinationState: o.DestinationUF.LongName,
+		WeightKg:         o.WeightKg.StringFixed(2),
+	})
+	if err != nil {
+		log.L().
+			Error("failed to render label", log.String("contract_id", contract.ID.String()), log.String("format", string(format)), log.Error(err))
+		return nil, err
+	}
+
+	return &Label{
+		Format:      format,
+		ContentType: contentTypes[format],
+		Filename:    "label-" + contract.ID.String() + "." + string(format),
+		Content:     content,
+	}, nil
+}
+
+func (s *service) SetTemplate(ctx context.Context, template *Template) (*Template, error) {
+	if _, err := s.carrierRepository.GetByID(ctx, template.CarrierID); err != nil {
+		log.L().
+			Error("failed to get carrier for label template", log.String("carrier_id", template.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	if err := Validate(template.Format, template.Content); err != nil {
+		return nil, err
+	}
+
+	template.UpdatedAt = time.Now().UTC()
+	if err := s.repo.SetTemplate(ctx, template); err != nil {
+		log.L().
+			Error("failed to set label template", log.String("carrier_id", template.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *service) GetTemplate(ctx context.Context, carrierID uuid.UUID, format Format) (*Template, error) {
+	template, err := s.repo.GetTemplate(ctx, carrierID, format)
+	switch {
+	case err == nil:
+		return template, nil
+	case errors.Is(err, ErrTemplateNotFound):
+		if _, err := s.carrierRepository.GetByID(ctx, carrierID); err != nil {
+			log.L().
+				Error("failed to get carrier for label template", log.String("carrier_id", carrierID.String()), log.Error(err))
+			return nil, err
+		}
+		return &Template{
+			CarrierID: carrierID,
+			Format:    format,
+			Content:   DefaultTemplate(format),
+			Default:   true,
+		}, nil
+	}
+
+	log.L().
+		Error("failed to get label template", log.String("carrier_id", carrierID.String()), log.Error(err))
+	return nil, err
+}
@@ -0,0 +1,165 @@
+package label_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	carriermock "github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/label"
+	"github.com/victorvcruz/shipment-coordinator/internal/label/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	shippingmock "github.com/victorvcruz/shipment-coordinator/internal/shipping/mocks"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+)
+
+func labelService(contract *shipping.Contract, repo *mocks.RepositoryMock) label.Service {
+	contracts := &shippingmock.RepositoryMock{
+		GetContractByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return contract, nil
+		},
+	}
+	orders := &ordermock.ServiceMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &order.Order{
+				ID:            id,
+				WeightKg:      decimal.NewFromFloat(12.5),
+				DestinationUF: states.State{Sigla: "SP", LongName: "São Paulo", Region: "Sudeste"},
+			}, nil
+		},
+	}
+	carriers := &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return &carrier.Carrier{ID: id, Name: "Fast^Delivery"}, nil
+		},
+	}
+	return label.NewService(repo, contracts, orders, carriers)
+}
+
+func templateRepo(stored *label.Template) *mocks.RepositoryMock {
+	return &mocks.RepositoryMock{
+		GetTemplateFunc: func(ctx context.Context, carrierID uuid.UUID, format label.Format) (*label.Template, error) {
+			if stored == nil {
+				return nil, label.ErrTemplateNotFound
+			}
+			return stored, nil
+		},
+		SetTemplateFunc: func(ctx context.Context, template *label.Template) error {
+			return nil
+		},
+	}
+}
+
+func activeContract() *shipping.Contract {
+	code := "BR123456789"
+	return &shipping.Contract{
+		ID:           uuid.New(),
+		OrderID:      uuid.New(),
+		CarrierID:    uuid.New(),
+		Status:       shipping.ContractStatusActive,
+		TrackingCode: &code,
+	}
+}
+
+func TestService_Render_DefaultZPL(t *testing.T) {
+	contract := activeContract()
+	svc := labelService(contract, templateRepo(nil))
+
+	l, err := svc.Render(context.Background(), contract.ID, label.FormatZPL)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/zpl", l.ContentType)
+	assert.Equal(t, "label-"+contract.ID.String()+".zpl", l.Filename)
+
+	zpl := string(l.Content)
+	assert.True(t, strings.HasPrefix(zpl, "^XA"))
+	assert.Contains(t, zpl, "^BCN,150,Y,N,N^FDBR123456789^FS")
+	assert.Contains(t, zpl, "^FDFastDelivery^FS")
+	assert.Contains(t, zpl, "São Paulo - SP")
+	assert.Contains(t, zpl, "12.50 kg")
+	assert.Contains(t, zpl, contract.ID.String())
+	assert.Contains(t, zpl, contract.OrderID.String())
+}
+
+func TestService_Render_CarrierPDFTemplate(t *testing.T) {
+	contract := activeContract()
+	svc := labelService(contract, templateRepo(&label.Template{
+		CarrierID: contract.CarrierID,
+		Format:    label.FormatPDF,
+		Content:   "# Order {{.OrderID}}\n@barcode\n{{.DestinationUF}}",
+	}))
+
+	l, err := svc.Render(context.Background(), contract.ID, label.FormatPDF)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/pdf", l.ContentType)
+	assert.True(t, bytes.HasPrefix(l.Content, []byte("%PDF-")))
+	assert.Contains(t, string(l.Content), "/F2 14 Tf 18 400 Td (Order "+contract.OrderID.String()+") Tj")
+	assert.Contains(t, string(l.Content), "re f")
+	assert.Contains(t, string(l.Content), "(SP) Tj")
+}
+
+func TestService_Render_MissingTrackingCode(t *testing.T) {
+	contract := activeContract()
+	contract.TrackingCode = nil
+	svc := labelService(contract, templateRepo(nil))
+
+	_, err := svc.Render(context.Background(), contract.ID, label.FormatPDF)
+	assert.ErrorIs(t, err, label.ErrMissingTrackingCode)
+}
+
+func TestService_Render_ContractNotActive(t *testing.T) {
+	contract := activeContract()
+	contract.Status = shipping.ContractStatusCancelled
+	svc := labelService(contract, templateRepo(nil))
+
+	_, err := svc.Render(context.Background(), contract.ID, label.FormatZPL)
+	assert.ErrorIs(t, err, shipping.ErrContractNotActive)
+}
+
+func TestService_SetTemplate_Invalid(t *testing.T) {
+	repo := templateRepo(nil)
+	svc := labelService(activeContract(), repo)
+
+	_, err := svc.SetTemplate(context.Background(), &label.Template{
+		CarrierID: uuid.New(),
+		Format:    label.FormatPDF,
+		Content:   "{{.Unknown}}",
+	})
+	assert.ErrorIs(t, err, label.ErrInvalidTemplate)
+
+	_, err = svc.SetTemplate(context.Background(), &label.Template{
+		CarrierID: uuid.New(),
+		Format:    label.FormatZPL,
+		Content:   "^FO40,40^FD{{.TrackingCode}}^FS",
+	})
+	assert.ErrorIs(t, err, label.ErrInvalidTemplate)
+	assert.Empty(t, repo.SetTemplateCalls())
+}
+
+func TestService_GetTemplate_FallsBackToDefault(t *testing.T) {
+	svc := labelService(activeContract(), templateRepo(nil))
+
+	template, err := svc.GetTemplate(context.Background(), uuid.New(), label.FormatZPL)
+	assert.NoError(t, err)
+	assert.True(t, template.Default)
+	assert.Equal(t, label.DefaultTemplate(label.FormatZPL), template.Content)
+}
+
+func TestService_GetTemplate_UnknownCarrier(t *testing.T) {
+	carriers := &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return nil, carrier.ErrCarrierNotFound
+		},
+	}
+	svc := label.NewService(templateRepo(nil), &shippingmock.RepositoryMock{}, &ordermock.ServiceMock{}, carriers)
+
+	template, err := svc.GetTemplate(context.Background(), uuid.New(), label.FormatZPL)
+	assert.ErrorIs(t, err, carrier.ErrCarrierNotFound)
+	assert.Nil(t, template)
+}
@@ -0,0 +1,79 @@
+package manifest
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateManifestInput struct {
+	ID   uuid.UUID `path:"id" doc:"Carrier ID"`
+	Body CreateManifestInputBody
+}
+
+type CreateManifestInputBody struct {
+	PickupDate string `json:"pickup_date" required:"true" doc:"Pickup date; contracts made up to this day and still awaiting pickup are included" example:"2025-07-01" format:"date"`
+}
+
+type GetManifestInput struct {
+	ID uuid.UUID `path:"id" doc:"Manifest ID"`
+}
+
+type ListManifestsInput struct {
+	ID         uuid.UUID `path:"id"           doc:"Carrier ID"`
+	PickupDate time.Time `query:"pickup_date" doc:"Filter by pickup date" timeFormat:"2006-01-02"`
+}
+
+type ExportManifestInput struct {
+	ID     uuid.UUID `path:"id"      doc:"Manifest ID"`
+	Format string    `query:"format" doc:"Export format" default:"pdf" enum:"csv,pdf"`
+}
+
+type ExportManifestOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	Body               []byte
+}
+
+type ManifestOutput struct {
+	Status int
+	Body   ManifestResponse
+}
+
+type ListManifestsOutput struct {
+	Status int
+	Body   []ManifestSummaryResponse
+}
+
+type ManifestResponse struct {
+	ID            uuid.UUID              `json:"id"                     doc:"Manifest ID"              example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierID     uuid.UUID              `json:"carrier_id"             doc:"Carrier ID"               example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierName   string                 `json:"carrier_name"           doc:"Carrier name"             example:"Fast Delivery"`
+	PickupDate    string                 `json:"pickup_date"            doc:"Pickup date"              example:"2025-07-01"`
+	Status        string                 `json:"status"                 doc:"Manifest status"          example:"open"`
+	Packages      int                    `json:"packages"               doc:"Number of packages"       example:"12"`
+	TotalWeightKg string                 `json:"total_weight_kg"        doc:"Total weight in kg"       example:"84.50"`
+	Items         []ManifestItemResponse `json:"items"                  doc:"Packages to collect"`
+	CreatedAt     time.Time              `json:"created_at"             doc:"Creation date"            example:"2025-07-01T07:00:00Z"`
+	ConfirmedAt   *time.Time             `json:"confirmed_at,omitempty" doc:"Pickup confirmation date" example:"2025-07-01T15:00:00Z"`
+	CancelledAt   *time.Time             `json:"cancelled_at,omitempty" doc:"Cancellation date"        example:"2025-07-01T09:00:00Z"`
+}
+
+type ManifestItemResponse struct {
+	ContractID    uuid.UUID `json:"contract_id"             doc:"Contract ID"         example:"123e4567-e89b-12d3-a456-426614174000"`
+	OrderID       uuid.UUID `json:"order_id"                doc:"Order ID"            example:"123e4567-e89b-12d3-a456-426614174000"`
+	TrackingCode  *string   `json:"tracking_code,omitempty" doc:"Tracking code"       example:"BR123456789XX"`
+	Product       string    `json:"product"                 doc:"Product description" example:"Notebook"`
+	WeightKg      string    `json:"weight_kg"               doc:"Weight in kg"        example:"3.00"`
+	DestinationUF string    `json:"destination_uf"          doc:"Destination UF"      example:"RJ"`
+}
+
+type ManifestSummaryResponse struct {
+	ID          uuid.UUID  `json:"id"                     doc:"Manifest ID"              example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierID   uuid.UUID  `json:"carrier_id"             doc:"Carrier ID"               example:"123e4567-e89b-12d3-a456-426614174000"`
+	PickupDate  string     `json:"pickup_date"            doc:"Pickup date"              example:"2025-07-01"`
+	Status      string     `json:"status"                 doc:"Manifest status"          example:"confirmed"`
+	CreatedAt   time.Time  `json:"created_at"             doc:"Creation date"            example:"2025-07-01T07:00:00Z"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty" doc:"Pickup confirmation date" example:"2025-07-01T15:00:00Z"`
+	CancelledAt *time.Time `json:"cancelled_at,omitempty" doc:"Cancellation date"        example:"2025-07-01T09:00:00Z"`
+}
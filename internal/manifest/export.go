@@ -0,0 +1,130 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/victorvcruz/shipment-coordinator/pkg/pdf"
+)
+
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	pageMargin   = 36
+	rowHeight    = 14
+	titleSize    = 14
+	textSize     = 8
+	productWidth = 34
+)
+
+var pdfColumns = []struct {
+	title string
+	x     float64
+}{
+	{"#", pageMargin},
+	{"Tracking code", 58},
+	{"Order ID", 160},
+	{"Product", 330},
+	{"UF", 490},
+	{"Weight (kg)", 515},
+}
+
+func ToCSV(m Manifest) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	records := [][]string{{"manifest_id", "carrier", "pickup_date", "contract_id", "order_id",
+		"tracking_code", "product", "destination_uf", "weight_kg"}}
+	for _, item := range m.Items {
+		records = append(records, []string{
+			m.ID.String(),
+			m.CarrierName,
+			m.PickupDate.Format(time.DateOnly),
+			item.ContractID.String(),
+			item.OrderID.String(),
+			trackingCode(item),
+			item.Product,
+			item.DestinationUF,
+			item.WeightKg.StringFixed(2),
+		})
+	}
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("failed to write manifest CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func ToPDF(m Manifest) []byte {
+	doc := pdf.New(pageWidth, pageHeight)
+
+	var (
+		page *pdf.Page
+		y    float64
+	)
+	newPage := func() {
+		page = doc.AddPage()
+		page.BoldText(pageMargin, pageMargin, titleSize, "Pickup manifest - "+m.CarrierName)
+		page.Text(pageMargin, pageMargin+22, textSize+1, fmt.Sprintf("Manifest %s    Pickup date %s    Status %s",
+			m.ID, m.PickupDate.Format("02/01/2006"), m.Status))
+		y = pageMargin + 48
+		for _, col := range pdfColumns {
+			page.BoldText(col.x, y, textSize, col.title)
+		}
+		y += rowHeight - 4
+		page.Line(pageMargin, y, pageWidth-pageMargin, y, 0.5)
+		y += 4
+	}
+	newPage()
+
+	for i, item := range m.Items {
+		if y+rowHeight > pageHeight-pageMargin {
+			newPage()
+		}
+		values := []string{
+			strconv.Itoa(i + 1),
+			trackingCode(item),
+			item.OrderID.String(),
+			truncate(item.Product, productWidth),
+			item.DestinationUF,
+			item.WeightKg.StringFixed(2),
+		}
+		for j, col := range pdfColumns {
+			page.Text(col.x, y, textSize, values[j])
+		}
+		y += rowHeight
+	}
+
+	if y+4*rowHeight > pageHeight-pageMargin {
+		newPage()
+	}
+	page.Line(pageMargin, y, pageWidth-pageMargin, y, 0.5)
+	y += 6
+	page.BoldText(pageMargin, y, textSize+1, fmt.Sprintf("Packages: %d    Total weight: %s kg",
+		len(m.Items), m.TotalWeightKg().StringFixed(2)))
+	y += 3 * rowHeight
+	page.Line(pageMargin, y, pageMargin+220, y, 0.5)
+	page.Line(pageWidth-pageMargin-220, y, pageWidth-pageMargin, y, 0.5)
+	page.Text(pageMargin, y+4, textSize, "Driver signature")
+	page.Text(pageWidth-pageMargin-220, y+4, textSize, "Shipper signature")
+
+	return doc.Bytes()
+}
+
+func trackingCode(item Item) string {
+	if item.TrackingCode == nil {
+		return ""
+	}
+	return *item.TrackingCode
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-3]) + "..."
+}
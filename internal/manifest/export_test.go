@@ -0,0 +1,70 @@
+package manifest_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest"
+)
+
+func sampleManifest(items int) manifest.Manifest {
+	m := manifest.Manifest{
+		ID:          uuid.New(),
+		CarrierID:   uuid.New(),
+		CarrierName: "Fast Delivery",
+		PickupDate:  time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		Status:      manifest.StatusOpen,
+	}
+	for i := 0; i < items; i++ {
+		code := fmt.Sprintf("BR%09d", i)
+		m.Items = append(m.Items, manifest.Item{
+			ContractID:    uuid.New(),
+			OrderID:       uuid.New(),
+			TrackingCode:  &code,
+			Product:       "Notebook, 15 inch (gray)",
+			WeightKg:      decimal.NewFromFloat(2.5),
+			DestinationUF: "RJ",
+		})
+	}
+	return m
+}
+
+func TestToCSV(t *testing.T) {
+	m := sampleManifest(2)
+	m.Items[1].TrackingCode = nil
+
+	out, err := manifest.ToCSV(m)
+	assert.NoError(t, err)
+
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, "tracking_code", records[0][5])
+	assert.Equal(t, []string{
+		m.ID.String(), "Fast Delivery", "2025-07-01", m.Items[0].ContractID.String(), m.Items[0].OrderID.String(),
+		"BR000000000", "Notebook, 15 inch (gray)", "RJ", "2.50",
+	}, records[1])
+	assert.Equal(t, "", records[2][5])
+}
+
+func TestToPDF_Paginates(t *testing.T) {
+	m := sampleManifest(80)
+
+	out := manifest.ToPDF(m)
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+	assert.Contains(t, string(out), "/Count 2")
+	assert.Contains(t, string(out), "(Packages: 80    Total weight: 200.00 kg) Tj")
+	assert.Contains(t, string(out), "(Driver signature) Tj")
+	assert.Contains(t, string(out), `(Notebook, 15 inch \(gray\)) Tj`)
+}
+
+func TestManifest_TotalWeightKg(t *testing.T) {
+	assert.Equal(t, "7.50", sampleManifest(3).TotalWeightKg().StringFixed(2))
+	assert.True(t, manifest.Manifest{}.TotalWeightKg().IsZero())
+}
@@ -0,0 +1,302 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest"
+	"sync"
+	"time"
+)
+
+// Ensure, that RepositoryMock does implement manifest.Repository.
+// If this is not the case, regenerate this file with moq.
+var _ manifest.Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of manifest.Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked manifest.Repository
+//		mockedRepository := &RepositoryMock{
+//			CancelFunc: func(ctx context.Context, id uuid.UUID, at time.Time) error {
+//				panic("mock out the Cancel method")
+//			},
+//			ConfirmFunc: func(ctx context.Context, id uuid.UUID, at time.Time) error {
+//				panic("mock out the Confirm method")
+//			},
+//			CreateFunc: func(ctx context.Context, m *manifest.Manifest) (uuid.UUID, error) {
+//				panic("mock out the Create method")
+//			},
+//			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+//				panic("mock out the GetByID method")
+//			},
+//			ListByCarrierFunc: func(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]manifest.Manifest, error) {
+//				panic("mock out the ListByCarrier method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires manifest.Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// CancelFunc mocks the Cancel method.
+	CancelFunc func(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// ConfirmFunc mocks the Confirm method.
+	ConfirmFunc func(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, m *manifest.Manifest) (uuid.UUID, error)
+
+	// GetByIDFunc mocks the GetByID method.
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error)
+
+	// ListByCarrierFunc mocks the ListByCarrier method.
+	ListByCarrierFunc func(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]manifest.Manifest, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Cancel holds details about calls to the Cancel method.
+		Cancel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// At is the at argument value.
+			At time.Time
+		}
+		// Confirm holds details about calls to the Confirm method.
+		Confirm []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// At is the at argument value.
+			At time.Time
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// M is the m argument value.
+			M *manifest.Manifest
+		}
+		// GetByID holds details about calls to the GetByID method.
+		GetByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// ListByCarrier holds details about calls to the ListByCarrier method.
+		ListByCarrier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// PickupDate is the pickupDate argument value.
+			PickupDate *time.Time
+		}
+	}
+	lockCancel        sync.RWMutex
+	lockConfirm       sync.RWMutex
+	lockCreate        sync.RWMutex
+	lockGetByID       sync.RWMutex
+	lockListByCarrier sync.RWMutex
+}
+
+// Cancel calls CancelFunc.
+func (mock *RepositoryMock) Cancel(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if mock.CancelFunc == nil {
+		panic("RepositoryMock.CancelFunc: method is nil but Repository.Cancel was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		At:  at,
+	}
+	mock.lockCancel.Lock()
+	mock.calls.Cancel = append(mock.calls.Cancel, callInfo)
+	mock.lockCancel.Unlock()
+	return mock.CancelFunc(ctx, id, at)
+}
+
+// CancelCalls gets all the calls that were made to Cancel.
+// Check the length with:
+//
+//	len(mockedRepository.CancelCalls())
+func (mock *RepositoryMock) CancelCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}
+	mock.lockCancel.RLock()
+	calls = mock.calls.Cancel
+	mock.lockCancel.RUnlock()
+	return calls
+}
+
+// Confirm calls ConfirmFunc.
+func (mock *RepositoryMock) Confirm(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if mock.ConfirmFunc == nil {
+		panic("RepositoryMock.ConfirmFunc: method is nil but Repository.Confirm was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		At:  at,
+	}
+	mock.lockConfirm.Lock()
+	mock.calls.Confirm = append(mock.calls.Confirm, callInfo)
+	mock.lockConfirm.Unlock()
+	return mock.ConfirmFunc(ctx, id, at)
+}
+
+// ConfirmCalls gets all the calls that were made to Confirm.
+// Check the length with:
+//
+//	len(mockedRepository.ConfirmCalls())
+func (mock *RepositoryMock) ConfirmCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}
+	mock.lockConfirm.RLock()
+	calls = mock.calls.Confirm
+	mock.lockConfirm.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *RepositoryMock) Create(ctx context.Context, m *manifest.Manifest) (uuid.UUID, error) {
+	if mock.CreateFunc == nil {
+		panic("RepositoryMock.CreateFunc: method is nil but Repository.Create was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		M   *manifest.Manifest
+	}{
+		Ctx: ctx,
+		M:   m,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, m)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedRepository.CreateCalls())
+func (mock *RepositoryMock) CreateCalls() []struct {
+	Ctx context.Context
+	M   *manifest.Manifest
+} {
+	var calls []struct {
+		Ctx context.Context
+		M   *manifest.Manifest
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// GetByID calls GetByIDFunc.
+func (mock *RepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+	if mock.GetByIDFunc == nil {
+		panic("RepositoryMock.GetByIDFunc: method is nil but Repository.GetByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetByID.Lock()
+	mock.calls.GetByID = append(mock.calls.GetByID, callInfo)
+	mock.lockGetByID.Unlock()
+	return mock.GetByIDFunc(ctx, id)
+}
+
+// GetByIDCalls gets all the calls that were made to GetByID.
+// Check the length with:
+//
+//	len(mockedRepository.GetByIDCalls())
+func (mock *RepositoryMock) GetByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetByID.RLock()
+	calls = mock.calls.GetByID
+	mock.lockGetByID.RUnlock()
+	return calls
+}
+
+// ListByCarrier calls ListByCarrierFunc.
+func (mock *RepositoryMock) ListByCarrier(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]manifest.Manifest, error) {
+	if mock.ListByCarrierFunc == nil {
+		panic("RepositoryMock.ListByCarrierFunc: method is nil but Repository.ListByCarrier was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		CarrierID  uuid.UUID
+		PickupDate *time.Time
+	}{
+		Ctx:        ctx,
+		CarrierID:  carrierID,
+		PickupDate: pickupDate,
+	}
+	mock.lockListByCarrier.Lock()
+	mock.calls.ListByCarrier = append(mock.calls.ListByCarrier, callInfo)
+	mock.lockListByCarrier.Unlock()
+	return mock.ListByCarrierFunc(ctx, carrierID, pickupDate)
+}
+
+// ListByCarrierCalls gets all the calls that were made to ListByCarrier.
+// Check the length with:
+//
+//	len(mockedRepository.ListByCarrierCalls())
+func (mock *RepositoryMock) ListByCarrierCalls() []struct {
+	Ctx        context.Context
+	CarrierID  uuid.UUID
+	PickupDate *time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		CarrierID  uuid.UUID
+		PickupDate *time.Time
+	}
+	mock.lockListByCarrier.RLock()
+	calls = mock.calls.ListByCarrier
+	mock.lockListByCarrier.RUnlock()
+	return calls
+}
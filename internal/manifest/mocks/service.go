@@ -0,0 +1,352 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest"
+	"sync"
+	"time"
+)
+
+// Ensure, that ServiceMock does implement manifest.Service.
+// If this is not the case, regenerate this file with moq.
+var _ manifest.Service = &ServiceMock{}
+
+// ServiceMock is a mock implementation of manifest.Service.
+//
+//	func TestSomethingThatUsesService(t *testing.T) {
+//
+//		// make and configure a mocked manifest.Service
+//		mockedService := &ServiceMock{
+//			CancelFunc: func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+//				panic("mock out the Cancel method")
+//			},
+//			ConfirmFunc: func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+//				panic("mock out the Confirm method")
+//			},
+//			CreateFunc: func(ctx context.Context, carrierID uuid.UUID, pickupDate time.Time) (*manifest.Manifest, error) {
+//				panic("mock out the Create method")
+//			},
+//			ExportFunc: func(ctx context.Context, id uuid.UUID, format manifest.Format) (*manifest.Export, error) {
+//				panic("mock out the Export method")
+//			},
+//			GetFunc: func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+//				panic("mock out the Get method")
+//			},
+//			ListByCarrierFunc: func(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]manifest.Manifest, error) {
+//				panic("mock out the ListByCarrier method")
+//			},
+//		}
+//
+//		// use mockedService in code that requires manifest.Service
+//		// and then make assertions.
+//
+//	}
+type ServiceMock struct {
+	// CancelFunc mocks the Cancel method.
+	CancelFunc func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error)
+
+	// ConfirmFunc mocks the Confirm method.
+	ConfirmFunc func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, carrierID uuid.UUID, pickupDate time.Time) (*manifest.Manifest, error)
+
+	// ExportFunc mocks the Export method.
+	ExportFunc func(ctx context.Context, id uuid.UUID, format manifest.Format) (*manifest.Export, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error)
+
+	// ListByCarrierFunc mocks the ListByCarrier method.
+	ListByCarrierFunc func(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]manifest.Manifest, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Cancel holds details about calls to the Cancel method.
+		Cancel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Confirm holds details about calls to the Confirm method.
+		Confirm []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// PickupDate is the pickupDate argument value.
+			PickupDate time.Time
+		}
+		// Export holds details about calls to the Export method.
+		Export []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Format is the format argument value.
+			Format manifest.Format
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// ListByCarrier holds details about calls to the ListByCarrier method.
+		ListByCarrier []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// PickupDate is the pickupDate argument value.
+			PickupDate *time.Time
+		}
+	}
+	lockCancel        sync.RWMutex
+	lockConfirm       sync.RWMutex
+	lockCreate        sync.RWMutex
+	lockExport        sync.RWMutex
+	lockGet           sync.RWMutex
+	lockListByCarrier sync.RWMutex
+}
+
+// Cancel calls CancelFunc.
+func (mock *ServiceMock) Cancel(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+	if mock.CancelFunc == nil {
+		panic("ServiceMock.CancelFunc: method is nil but Service.Cancel was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockCancel.Lock()
+	mock.calls.Cancel = append(mock.calls.Cancel, callInfo)
+	mock.lockCancel.Unlock()
+	return mock.CancelFunc(ctx, id)
+}
+
+// CancelCalls gets all the calls that were made to Cancel.
+// Check the length with:
+//
+//	len(mockedService.CancelCalls())
+func (mock *ServiceMock) CancelCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockCancel.RLock()
+	calls = mock.calls.Cancel
+	mock.lockCancel.RUnlock()
+	return calls
+}
+
+// Confirm calls ConfirmFunc.
+func (mock *ServiceMock) Confirm(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+	if mock.ConfirmFunc == nil {
+		panic("ServiceMock.ConfirmFunc: method is nil but Service.Confirm was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockConfirm.Lock()
+	mock.calls.Confirm = append(mock.calls.Confirm, callInfo)
+	mock.lockConfirm.Unlock()
+	return mock.ConfirmFunc(ctx, id)
+}
+
+// ConfirmCalls gets all the calls that were made to Confirm.
+// Check the length with:
+//
+//	len(mockedService.ConfirmCalls())
+func (mock *ServiceMock) ConfirmCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockConfirm.RLock()
+	calls = mock.calls.Confirm
+	mock.lockConfirm.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *ServiceMock) Create(ctx context.Context, carrierID uuid.UUID, pickupDate time.Time) (*manifest.Manifest, error) {
+	if mock.CreateFunc == nil {
+		panic("ServiceMock.CreateFunc: method is nil but Service.Create was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		CarrierID  uuid.UUID
+		PickupDate time.Time
+	}{
+		Ctx:        ctx,
+		CarrierID:  carrierID,
+		PickupDate: pickupDate,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, carrierID, pickupDate)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedService.CreateCalls())
+func (mock *ServiceMock) CreateCalls() []struct {
+	Ctx        context.Context
+	CarrierID  uuid.UUID
+	PickupDate time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		CarrierID  uuid.UUID
+		PickupDate time.Time
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Export calls ExportFunc.
+func (mock *ServiceMock) Export(ctx context.Context, id uuid.UUID, format manifest.Format) (*manifest.Export, error) {
+	if mock.ExportFunc == nil {
+		panic("ServiceMock.ExportFunc: method is nil but Service.Export was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Format manifest.Format
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Format: format,
+	}
+	mock.lockExport.Lock()
+	mock.calls.Export = append(mock.calls.Export, callInfo)
+	mock.lockExport.Unlock()
+	return mock.ExportFunc(ctx, id, format)
+}
+
+// ExportCalls gets all the calls that were made to Export.
+// Check the length with:
+//
+//	len(mockedService.ExportCalls())
+func (mock *ServiceMock) ExportCalls() []struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Format manifest.Format
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Format manifest.Format
+	}
+	mock.lockExport.RLock()
+	calls = mock.calls.Export
+	mock.lockExport.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *ServiceMock) Get(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+	if mock.GetFunc == nil {
+		panic("ServiceMock.GetFunc: method is nil but Service.Get was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedService.GetCalls())
+func (mock *ServiceMock) GetCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// ListByCarrier calls ListByCarrierFunc.
+func (mock *ServiceMock) ListByCarrier(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]manifest.Manifest, error) {
+	if mock.ListByCarrierFunc == nil {
+		panic("ServiceMock.ListByCarrierFunc: method is nil but Service.ListByCarrier was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		CarrierID  uuid.UUID
+		PickupDate *time.Time
+	}{
+		Ctx:        ctx,
+		CarrierID:  carrierID,
+		PickupDate: pickupDate,
+	}
+	mock.lockListByCarrier.Lock()
+	mock.calls.ListByCarrier = append(mock.calls.ListByCarrier, callInfo)
+	mock.lockListByCarrier.Unlock()
+	return mock.ListByCarrierFunc(ctx, carrierID, pickupDate)
+}
+
+// ListByCarrierCalls gets all the calls that were made to ListByCarrier.
+// Check the length with:
+//
+//	len(mockedService.ListByCarrierCalls())
+func (mock *ServiceMock) ListByCarrierCalls() []struct {
+	Ctx        context.Context
+	CarrierID  uuid.UUID
+	PickupDate *time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		CarrierID  uuid.UUID
+		PickupDate *time.Time
+	}
+	mock.lockListByCarrier.RLock()
+	calls = mock.calls.ListByCarrier
+	mock.lockListByCarrier.RUnlock()
+	return calls
+}
@@ -0,0 +1,75 @@
+package manifest
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type Status string
+
+const (
+	StatusOpen      Status = "open"
+	StatusConfirmed Status = "confirmed"
+	StatusCancelled Status = "cancelled"
+)
+
+var StatusValues = map[string]Status{
+	"open":      StatusOpen,
+	"confirmed": StatusConfirmed,
+	"cancelled": StatusCancelled,
+}
+
+type Format string
+
+const (
+	FormatCSV Format = "csv"
+	FormatPDF Format = "pdf"
+)
+
+var Formats = map[string]Format{
+	"csv": FormatCSV,
+	"pdf": FormatPDF,
+}
+
+var contentTypes = map[Format]string{
+	FormatCSV: "text/csv",
+	FormatPDF: "application/pdf",
+}
+
+type Manifest struct {
+	ID          uuid.UUID  `json:"id"`
+	CarrierID   uuid.UUID  `json:"carrier_id"`
+	CarrierName string     `json:"carrier_name"`
+	PickupDate  time.Time  `json:"pickup_date"`
+	Status      Status     `json:"status"`
+	Items       []Item     `json:"items"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+	CancelledAt *time.Time `json:"cancelled_at"`
+}
+
+type Item struct {
+	ContractID    uuid.UUID       `json:"contract_id"`
+	OrderID       uuid.UUID       `json:"order_id"`
+	TrackingCode  *string         `json:"tracking_code"`
+	Product       string          `json:"product"`
+	WeightKg      decimal.Decimal `json:"weight_kg"`
+	DestinationUF string          `json:"destination_uf"`
+}
+
+type Export struct {
+	Format      Format
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+func (m Manifest) TotalWeightKg() decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range m.Items {
+		total = total.Add(item.WeightKg)
+	}
+	return total
+}
@@ -0,0 +1,323 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+)
+
+var (
+	ErrManifestNotFound = errors.New("manifest not found")
+	ErrManifestNotOpen  = errors.New("manifest is not open")
+	ErrEmptyManifest    = errors.New("no contracts awaiting pickup for the carrier")
+	ErrManifestConflict = errors.New("contracts were added to another manifest")
+	ErrManifestOutdated = errors.New("manifest has orders no longer awaiting pickup")
+)
+
+const (
+	uniqueViolationCode      = "23505"
+	uniqueManifestedContract = "unique_manifest_item_contract"
+)
+
+const (
+	queryInsertManifest = `
+	INSERT INTO manifests (carrier_id, pickup_date, status, created_at)
+	VALUES ($1, $2, 'open', $3)
+	RETURNING id
+`
+
+	queryInsertManifestItems = `
+	INSERT INTO manifest_items (manifest_id, contract_id, order_id, tracking_code, product, weight_kg, destination_uf)
+	SELECT $1, c.id, c.order_id, c.tracking_code, o.product, o.weight_kg, o.destination_uf
+	FROM contracts c
+	INNER JOIN orders o ON o.id = c.order_id
+	WHERE c.carrier_id = $2
+	  AND c.status = 'active'
+	  AND o.status = 'awaiting_pickup'
+	  AND c.contracted_at < $3
+	  AND NOT EXISTS (
+	      SELECT 1 FROM manifest_items mi WHERE mi.contract_id = c.id AND NOT mi.released
+	  )
+`
+
+	querySelectManifest = `
+	SELECT id, carrier_id, pickup_date, status, created_at, confirmed_at, cancelled_at
+	FROM manifests
+	WHERE id = $1
+`
+
+	querySelectManifestItems = `
+	SELECT contract_id, order_id, tracking_code, product, weight_kg, destination_uf
+	FROM manifest_items
+	WHERE manifest_id = $1
+	ORDER BY destination_uf, tracking_code, order_id
+`
+
+	queryListManifests = `
+	SELECT id, carrier_id, pickup_date, status, created_at, confirmed_at, cancelled_at
+	FROM manifests
+	WHERE carrier_id = $1
+	  AND ($2::date IS NULL OR pickup_date = $2)
+	ORDER BY pickup_date DESC, created_at DESC
+`
+
+	queryLockManifest = `
+	SELECT status
+	FROM manifests
+	WHERE id = $1
+	FOR UPDATE
+`
+
+	querySelectManifestOrders = `
+	SELECT mi.order_id
+	FROM manifest_items mi
+	INNER JOIN contracts c ON c.id = mi.contract_id
+	WHERE mi.manifest_id = $1
+	  AND c.status = 'active'
+	ORDER BY mi.order_id
+`
+
+	queryCountManifestItems = `
+	SELECT COUNT(*)
+	FROM manifest_items
+	WHERE manifest_id = $1
+`
+
+	queryConfirmManifest = `
+	UPDATE manifests
+	SET status = 'confirmed', confirmed_at = $2
+	WHERE id = $1
+`
+
+	queryCancelManifest = `
+	UPDATE manifests
+	SET status = 'cancelled', cancelled_at = $2
+	WHERE id = $1
+`
+
+	queryReleaseManifestItems = `
+	UPDATE manifest_items
+	SET released = TRUE
+	WHERE manifest_id = $1
+`
+)
+
+//go:generate moq -pkg mocks -out mocks/repository.go . Repository
+type Repository interface {
+	Create(ctx context.Context, m *Manifest) (uuid.UUID, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Manifest, error)
+	ListByCarrier(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]Manifest, error)
+	Confirm(ctx context.Context, id uuid.UUID, at time.Time) error
+	Cancel(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+type repository struct {
+	pool   *pgxpool.Pool
+	orders order.Repository
+}
+
+func NewRepository(pool *pgxpool.Pool, orders order.Repository) Repository {
+	return &repository{pool: pool, orders: orders}
+}
+
+func (r *repository) Create(ctx context.Context, m *Manifest) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, queryInsertManifest, m.CarrierID, m.PickupDate, m.CreatedAt).Scan(&id); err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(ctx, queryInsertManifestItems, id, m.CarrierID, m.PickupDate.AddDate(0, 0, 1))
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrEmptyManifest
+		}
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.Is(err, ErrEmptyManifest):
+			return uuid.Nil, err
+		case errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode &&
+			pgErr.ConstraintName == uniqueManifestedContract:
+			return uuid.Nil, ErrManifestConflict
+		}
+		return uuid.Nil, fmt.Errorf("failed to create manifest: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*Manifest, error) {
+	m, err := scanManifest(r.pool.QueryRow(ctx, querySelectManifest, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrManifestNotFound
+		}
+		return nil, fmt.Errorf("failed to get manifest: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, querySelectManifestItems, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list manifest items: %w", err)
+	}
+	defer rows.Close()
+
+	m.Items = []Item{}
+	for rows.Next() {
+		var item Item
+		if err := rows.Scan(
+			&item.ContractID,
+			&item.OrderID,
+			&item.TrackingCode,
+			&item.Product,
+			&item.WeightKg,
+			&item.DestinationUF,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan manifest item: %w", err)
+		}
+		m.Items = append(m.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list manifest items: %w", err)
+	}
+
+	return m, nil
+}
+
+func (r *repository) ListByCarrier(
+	ctx context.Context,
+	carrierID uuid.UUID,
+	pickupDate *time.Time,
+) ([]Manifest, error) {
+	rows, err := r.pool.Query(ctx, queryListManifests, carrierID, pickupDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list manifests: %w", err)
+	}
+	defer rows.Close()
+
+	manifests := []Manifest{}
+	for rows.Next() {
+		m, err := scanManifest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan manifest: %w", err)
+		}
+		manifests = append(manifests, *m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list manifests: %w", err)
+	}
+
+	return manifests, nil
+}
+
+func (r *repository) Confirm(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockOpen(ctx, tx, id); err != nil {
+			return err
+		}
+
+		var items int64
+		if err := tx.QueryRow(ctx, queryCountManifestItems, id).Scan(&items); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, querySelectManifestOrders, id)
+		if err != nil {
+			return err
+		}
+		orderIDs, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+		if err != nil {
+			return err
+		}
+		if int64(len(orderIDs)) != items {
+			return ErrManifestOutdated
+		}
+
+		for _, orderID := range orderIDs {
+			err := r.orders.TransitionStatusTx(ctx, tx, orderID, order.StatusAwaitingPickup, order.StatusPickedUp, at)
+			if errors.Is(err, order.ErrInvalidStatusTransition) || errors.Is(err, order.ErrOrderNotFound) {
+				return ErrManifestOutdated
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(ctx, queryConfirmManifest, id, at)
+		return err
+	})
+	if err != nil && !isManifestError(err) {
+		return fmt.Errorf("failed to confirm manifest: %w", err)
+	}
+	return err
+}
+
+func (r *repository) Cancel(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockOpen(ctx, tx, id); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, queryCancelManifest, id, at); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, queryReleaseManifestItems, id)
+		return err
+	})
+	if err != nil && !isManifestError(err) {
+		return fmt.Errorf("failed to cancel manifest: %w", err)
+	}
+	return err
+}
+
+func lockOpen(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	var status string
+	if err := tx.QueryRow(ctx, queryLockManifest, id).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrManifestNotFound
+		}
+		return err
+	}
+	if Status(status) != StatusOpen {
+		return ErrManifestNotOpen
+	}
+	return nil
+}
+
+func isManifestError(err error) bool {
+	return errors.Is(err, ErrManifestNotFound) ||
+		errors.Is(err, ErrManifestNotOpen) ||
+		errors.Is(err, ErrManifestOutdated)
+}
+
+func scanManifest(row pgx.Row) (*Manifest, error) {
+	var (
+		m      Manifest
+		status string
+	)
+	err := row.Scan(
+		&m.ID,
+		&m.CarrierID,
+		&m.PickupDate,
+		&status,
+		&m.CreatedAt,
+		&m.ConfirmedAt,
+		&m.CancelledAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	m.Status = Status(status)
+	return &m, nil
+}
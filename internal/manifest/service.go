@@ -0,0 +1,131 @@
+package manifest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	log "go.uber.org/zap"
+)
+
+//go:generate moq -pkg mocks -out mocks/service.go . Service
+type Service interface {
+	Create(ctx context.Context, carrierID uuid.UUID, pickupDate time.Time) (*Manifest, error)
+	Get(ctx context.Context, id uuid.UUID) (*Manifest, error)
+	ListByCarrier(ctx context.Context, carrierID uuid.UUID, pickupDate *time.Time) ([]Manifest, error)
+	Export(ctx context.Context, id uuid.UUID, format Format) (*Export, error)
+	Confirm(ctx context.Context, id uuid.UUID) (*Manifest, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*Manifest, error)
+}
+
+type service struct {
+	repo              Repository
+	carrierRepository carrier.Repository
+}
+
+func NewService(repo Repository, carrierRepository carrier.Repository) Service {
+	return &service{
+		repo:              repo,
+		carrierRepository: carrierRepository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, carrierID uuid.UUID, pickupDate time.Time) (*Manifest, error) {
+	if _, err := s.carrierRepository.GetByID(ctx, carrierID); err != nil {
+		log.L().
+			Error("failed to get carrier for manifest", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	y, m, d := pickupDate.Date()
+	id, err := s.repo.Create(ctx, &Manifest{
+		CarrierID:  carrierID,
+		PickupDate: time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+		Status:     StatusOpen,
+		CreatedAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		log.L().
+			Error("failed to create manifest", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+func (s *service) Get(ctx context.Context, id uuid.UUID) (*Manifest, error) {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		log.L().
+			Error("failed to get manifest by ID", log.String("manifest_id", id.String()), log.Error(err))
+		return nil, err
+	}
+
+	c, err := s.carrierRepository.GetByID(ctx, m.CarrierID)
+	if err != nil {
+		log.L().
+			Error("failed to get manifest carrier", log.String("carrier_id", m.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+	m.CarrierName = c.Name
+
+	return m, nil
+}
+
+func (s *service) ListByCarrier(
+	ctx context.Context,
+	carrierID uuid.UUID,
+	pickupDate *time.Time,
+) ([]Manifest, error) {
+	manifests, err := s.repo.ListByCarrier(ctx, carrierID, pickupDate)
+	if err != nil {
+		log.L().
+			Error("failed to list manifests", log.String("carrier_id", carrierID.String()), log.Error(err))
+		return nil, err
+	}
+	return manifests, nil
+}
+
+func (s *service) Export(ctx context.Context, id uuid.UUID, format Format) (*Export, error) {
+	m, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var content []byte
+	switch format {
+	case FormatCSV:
+		content, err = ToCSV(*m)
+		if err != nil {
+			return nil, err
+		}
+	case FormatPDF:
+		content = ToPDF(*m)
+	}
+
+	return &Export{
+		Format:      format,
+		ContentType: contentTypes[format],
+		Filename:    "manifest-" + m.ID.String() + "." + string(format),
+		Content:     content,
+	}, nil
+}
+
+func (s *service) Confirm(ctx context.Context, id uuid.UUID) (*Manifest, error) {
+	if err := s.repo.Confirm(ctx, id, time.Now().UTC()); err != nil {
+		log.L().
+			Error("failed to confirm manifest", log.String("manifest_id", id.String()), log.Error(err))
+		return nil, err
+	}
+	return s.Get(ctx, id)
+}
+
+func (s *service) Cancel(ctx context.Context, id uuid.UUID) (*Manifest, error) {
+	if err := s.repo.Cancel(ctx, id, time.Now().UTC()); err != nil {
+		log.L().
+			Error("failed to cancel manifest", log.String("manifest_id", id.String()), log.Error(err))
+		return nil, err
+	}
+	return s.Get(ctx, id)
+}
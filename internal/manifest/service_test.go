@@ -0,0 +1,86 @@
+package manifest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	carriermock "github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest"
+	"github.com/victorvcruz/shipment-coordinator/internal/manifest/mocks"
+)
+
+func carrierRepo() *carriermock.RepositoryMock {
+	return &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return &carrier.Carrier{ID: id, Name: "Fast Delivery"}, nil
+		},
+	}
+}
+
+func TestService_Create_UsesPickupDay(t *testing.T) {
+	stored := sampleManifest(2)
+	repo := &mocks.RepositoryMock{
+		CreateFunc: func(ctx context.Context, m *manifest.Manifest) (uuid.UUID, error) {
+			return stored.ID, nil
+		},
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+			return &stored, nil
+		},
+	}
+
+	svc := manifest.NewService(repo, carrierRepo())
+	m, err := svc.Create(context.Background(), stored.CarrierID,
+		time.Date(2025, 7, 1, 18, 30, 0, 0, time.FixedZone("BRT", -3*3600)))
+	assert.NoError(t, err)
+	assert.Equal(t, "Fast Delivery", m.CarrierName)
+	assert.Len(t, m.Items, 2)
+
+	created := repo.CreateCalls()[0].M
+	assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), created.PickupDate)
+	assert.Equal(t, manifest.StatusOpen, created.Status)
+}
+
+func TestService_Create_Empty(t *testing.T) {
+	repo := &mocks.RepositoryMock{
+		CreateFunc: func(ctx context.Context, m *manifest.Manifest) (uuid.UUID, error) {
+			return uuid.Nil, manifest.ErrEmptyManifest
+		},
+	}
+
+	svc := manifest.NewService(repo, carrierRepo())
+	_, err := svc.Create(context.Background(), uuid.New(), time.Now())
+	assert.ErrorIs(t, err, manifest.ErrEmptyManifest)
+	assert.Empty(t, repo.GetByIDCalls())
+}
+
+func TestService_Confirm_Outdated(t *testing.T) {
+	repo := &mocks.RepositoryMock{
+		ConfirmFunc: func(ctx context.Context, id uuid.UUID, at time.Time) error {
+			return manifest.ErrManifestOutdated
+		},
+	}
+
+	svc := manifest.NewService(repo, carrierRepo())
+	_, err := svc.Confirm(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, manifest.ErrManifestOutdated)
+}
+
+func TestService_Export_CSV(t *testing.T) {
+	stored := sampleManifest(1)
+	repo := &mocks.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*manifest.Manifest, error) {
+			return &stored, nil
+		},
+	}
+
+	svc := manifest.NewService(repo, carrierRepo())
+	export, err := svc.Export(context.Background(), stored.ID, manifest.FormatCSV)
+	assert.NoError(t, err)
+	assert.Equal(t, "text/csv", export.ContentType)
+	assert.Equal(t, "manifest-"+stored.ID.String()+".csv", export.Filename)
+	assert.Contains(t, string(export.Content), "BR000000000")
+}
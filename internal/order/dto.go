@@ -10,9 +10,11 @@ type CreateOrderInput struct {
 	Body CreateOrderInputBody
 }
 type CreateOrderInputBody struct {
-	Product       string  `json:"product"        required:"true" doc:"Product name"   example:"MacBook Pro 16"`
-	WeightKg      float64 `json:"weight_kg"      required:"true" doc:"Weight kg"      example:"2.5"`
-	DestinationUF string  `json:"destination_uf" required:"true" doc:"Destination UF" example:"SP"`
+	Product       string  `json:"product"                  required:"true"  doc:"Product name"                                                           example:"MacBook Pro 16"`
+	WeightKg      float64 `json:"weight_kg"                required:"true"  doc:"Weight kg"                                                              example:"2.5"`
+	DestinationUF string  `json:"destination_uf"           required:"true"  doc:"Destination UF"                                                         example:"SP"`
+	DeclaredValue float64 `json:"declared_value,omitempty" required:"false" doc:"Declared goods value in BRL, used for frete-valor and GRIS"             example:"1500.00"`
+	AutoContract  *bool   `json:"auto_contract,omitempty"  required:"false" doc:"Contract the recommended carrier right away, defaults to configuration" example:"true"`
 }
 
 type OrderResponseOutput struct {
@@ -21,13 +23,15 @@ type OrderResponseOutput struct {
 }
 
 type OrderResponseOutputBody struct {
-	ID            uuid.UUID `json:"id"             doc:"Order ID"               example:"123e4567-e89b-12d3-a456-426614174000"`
-	Product       string    `json:"product"        doc:"Product name"           example:"MacBook Pro 16"`
-	WeightKg      string    `json:"weight_kg"      doc:"Weight in kg"           example:"2.5"`
-	DestinationUF string    `json:"destination_uf" doc:"Destination UF"         example:"SP"`
-	Status        Status    `json:"status"         doc:"Order status"           example:"created"`
-	CreatedAt     time.Time `json:"created_at"     doc:"Order creation date"    example:"2023-10-01T12:00:00Z"`
-	UpdatedAt     time.Time `json:"updated_at"     doc:"Order last update date" example:"2023-10-01T12:00:00Z"`
+	ID            uuid.UUID  `json:"id"                    doc:"Order ID"                                              example:"123e4567-e89b-12d3-a456-426614174000"`
+	Product       string     `json:"product"               doc:"Product name"                                          example:"MacBook Pro 16"`
+	WeightKg      string     `json:"weight_kg"             doc:"Weight in kg"                                          example:"2.5"`
+	DestinationUF string     `json:"destination_uf"        doc:"Destination UF"                                        example:"SP"`
+	DeclaredValue string     `json:"declared_value"        doc:"Declared goods value in BRL"                           example:"1500.00"`
+	Status        Status     `json:"status"                doc:"Order status"                                          example:"created"`
+	ContractID    *uuid.UUID `json:"contract_id,omitempty" doc:"Contract ID when the order was contracted on creation" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CreatedAt     time.Time  `json:"created_at"            doc:"Order creation date"                                   example:"2023-10-01T12:00:00Z"`
+	UpdatedAt     time.Time  `json:"updated_at"            doc:"Order last update date"                                example:"2023-10-01T12:00:00Z"`
 }
 
 type GetOrderParams struct {
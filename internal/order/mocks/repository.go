@@ -6,8 +6,10 @@ package mocks
 import (
 	"context"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/victorvcruz/shipment-coordinator/internal/order"
 	"sync"
+	"time"
 )
 
 // Ensure, that RepositoryMock does implement order.Repository.
@@ -20,12 +22,24 @@ var _ order.Repository = &RepositoryMock{}
 //
 //		// make and configure a mocked order.Repository
 //		mockedRepository := &RepositoryMock{
+//			AdvanceStatusTxFunc: func(ctx context.Context, tx pgx.Tx, id uuid.UUID, to order.Status, at time.Time) (bool, error) {
+//				panic("mock out the AdvanceStatusTx method")
+//			},
 //			CreateFunc: func(ctx context.Context, orderMoqParam *order.Order) (uuid.UUID, error) {
 //				panic("mock out the Create method")
 //			},
 //			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
 //				panic("mock out the GetByID method")
 //			},
+//			ListByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]order.Order, error) {
+//				panic("mock out the ListByIDs method")
+//			},
+//			ListByStatusFunc: func(ctx context.Context, status order.Status, ufs []string) ([]order.Order, error) {
+//				panic("mock out the ListByStatus method")
+//			},
+//			TransitionStatusTxFunc: func(ctx context.Context, tx pgx.Tx, id uuid.UUID, from order.Status, to order.Status, at time.Time) error {
+//				panic("mock out the TransitionStatusTx method")
+//			},
 //			UpdateStatusFunc: func(ctx context.Context, id uuid.UUID, status order.Status) error {
 //				panic("mock out the UpdateStatus method")
 //			},
@@ -36,17 +50,42 @@ var _ order.Repository = &RepositoryMock{}
 //
 //	}
 type RepositoryMock struct {
+	// AdvanceStatusTxFunc mocks the AdvanceStatusTx method.
+	AdvanceStatusTxFunc func(ctx context.Context, tx pgx.Tx, id uuid.UUID, to order.Status, at time.Time) (bool, error)
+
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, orderMoqParam *order.Order) (uuid.UUID, error)
 
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(ctx context.Context, id uuid.UUID) (*order.Order, error)
 
+	// ListByIDsFunc mocks the ListByIDs method.
+	ListByIDsFunc func(ctx context.Context, ids []uuid.UUID) ([]order.Order, error)
+
+	// ListByStatusFunc mocks the ListByStatus method.
+	ListByStatusFunc func(ctx context.Context, status order.Status, ufs []string) ([]order.Order, error)
+
+	// TransitionStatusTxFunc mocks the TransitionStatusTx method.
+	TransitionStatusTxFunc func(ctx context.Context, tx pgx.Tx, id uuid.UUID, from order.Status, to order.Status, at time.Time) error
+
 	// UpdateStatusFunc mocks the UpdateStatus method.
 	UpdateStatusFunc func(ctx context.Context, id uuid.UUID, status order.Status) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AdvanceStatusTx holds details about calls to the AdvanceStatusTx method.
+		AdvanceStatusTx []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx pgx.Tx
+			// ID is the id argument value.
+			ID uuid.UUID
+			// To is the to argument value.
+			To order.Status
+			// At is the at argument value.
+			At time.Time
+		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
 			// Ctx is the ctx argument value.
@@ -61,6 +100,37 @@ type RepositoryMock struct {
 			// ID is the id argument value.
 			ID uuid.UUID
 		}
+		// ListByIDs holds details about calls to the ListByIDs method.
+		ListByIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
+		}
+		// ListByStatus holds details about calls to the ListByStatus method.
+		ListByStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Status is the status argument value.
+			Status order.Status
+			// Ufs is the ufs argument value.
+			Ufs []string
+		}
+		// TransitionStatusTx holds details about calls to the TransitionStatusTx method.
+		TransitionStatusTx []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx pgx.Tx
+			// ID is the id argument value.
+			ID uuid.UUID
+			// From is the from argument value.
+			From order.Status
+			// To is the to argument value.
+			To order.Status
+			// At is the at argument value.
+			At time.Time
+		}
 		// UpdateStatus holds details about calls to the UpdateStatus method.
 		UpdateStatus []struct {
 			// Ctx is the ctx argument value.
@@ -71,9 +141,61 @@ type RepositoryMock struct {
 			Status order.Status
 		}
 	}
-	lockCreate       sync.RWMutex
-	lockGetByID      sync.RWMutex
-	lockUpdateStatus sync.RWMutex
+	lockAdvanceStatusTx    sync.RWMutex
+	lockCreate             sync.RWMutex
+	lockGetByID            sync.RWMutex
+	lockListByIDs          sync.RWMutex
+	lockListByStatus       sync.RWMutex
+	lockTransitionStatusTx sync.RWMutex
+	lockUpdateStatus       sync.RWMutex
+}
+
+// AdvanceStatusTx calls AdvanceStatusTxFunc.
+func (mock *RepositoryMock) AdvanceStatusTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, to order.Status, at time.Time) (bool, error) {
+	if mock.AdvanceStatusTxFunc == nil {
+		panic("RepositoryMock.AdvanceStatusTxFunc: method is nil but Repository.AdvanceStatusTx was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  pgx.Tx
+		ID  uuid.UUID
+		To  order.Status
+		At  time.Time
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+		ID:  id,
+		To:  to,
+		At:  at,
+	}
+	mock.lockAdvanceStatusTx.Lock()
+	mock.calls.AdvanceStatusTx = append(mock.calls.AdvanceStatusTx, callInfo)
+	mock.lockAdvanceStatusTx.Unlock()
+	return mock.AdvanceStatusTxFunc(ctx, tx, id, to, at)
+}
+
+// AdvanceStatusTxCalls gets all the calls that were made to AdvanceStatusTx.
+// Check the length with:
+//
+//	len(mockedRepository.AdvanceStatusTxCalls())
+func (mock *RepositoryMock) AdvanceStatusTxCalls() []struct {
+	Ctx context.Context
+	Tx  pgx.Tx
+	ID  uuid.UUID
+	To  order.Status
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  pgx.Tx
+		ID  uuid.UUID
+		To  order.Status
+		At  time.Time
+	}
+	mock.lockAdvanceStatusTx.RLock()
+	calls = mock.calls.AdvanceStatusTx
+	mock.lockAdvanceStatusTx.RUnlock()
+	return calls
 }
 
 // Create calls CreateFunc.
@@ -148,6 +270,134 @@ func (mock *RepositoryMock) GetByIDCalls() []struct {
 	return calls
 }
 
+// ListByIDs calls ListByIDsFunc.
+func (mock *RepositoryMock) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]order.Order, error) {
+	if mock.ListByIDsFunc == nil {
+		panic("RepositoryMock.ListByIDsFunc: method is nil but Repository.ListByIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockListByIDs.Lock()
+	mock.calls.ListByIDs = append(mock.calls.ListByIDs, callInfo)
+	mock.lockListByIDs.Unlock()
+	return mock.ListByIDsFunc(ctx, ids)
+}
+
+// ListByIDsCalls gets all the calls that were made to ListByIDs.
+// Check the length with:
+//
+//	len(mockedRepository.ListByIDsCalls())
+func (mock *RepositoryMock) ListByIDsCalls() []struct {
+	Ctx context.Context
+	Ids []uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []uuid.UUID
+	}
+	mock.lockListByIDs.RLock()
+	calls = mock.calls.ListByIDs
+	mock.lockListByIDs.RUnlock()
+	return calls
+}
+
+// ListByStatus calls ListByStatusFunc.
+func (mock *RepositoryMock) ListByStatus(ctx context.Context, status order.Status, ufs []string) ([]order.Order, error) {
+	if mock.ListByStatusFunc == nil {
+		panic("RepositoryMock.ListByStatusFunc: method is nil but Repository.ListByStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Status order.Status
+		Ufs    []string
+	}{
+		Ctx:    ctx,
+		Status: status,
+		Ufs:    ufs,
+	}
+	mock.lockListByStatus.Lock()
+	mock.calls.ListByStatus = append(mock.calls.ListByStatus, callInfo)
+	mock.lockListByStatus.Unlock()
+	return mock.ListByStatusFunc(ctx, status, ufs)
+}
+
+// ListByStatusCalls gets all the calls that were made to ListByStatus.
+// Check the length with:
+//
+//	len(mockedRepository.ListByStatusCalls())
+func (mock *RepositoryMock) ListByStatusCalls() []struct {
+	Ctx    context.Context
+	Status order.Status
+	Ufs    []string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Status order.Status
+		Ufs    []string
+	}
+	mock.lockListByStatus.RLock()
+	calls = mock.calls.ListByStatus
+	mock.lockListByStatus.RUnlock()
+	return calls
+}
+
+// TransitionStatusTx calls TransitionStatusTxFunc.
+func (mock *RepositoryMock) TransitionStatusTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, from order.Status, to order.Status, at time.Time) error {
+	if mock.TransitionStatusTxFunc == nil {
+		panic("RepositoryMock.TransitionStatusTxFunc: method is nil but Repository.TransitionStatusTx was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Tx   pgx.Tx
+		ID   uuid.UUID
+		From order.Status
+		To   order.Status
+		At   time.Time
+	}{
+		Ctx:  ctx,
+		Tx:   tx,
+		ID:   id,
+		From: from,
+		To:   to,
+		At:   at,
+	}
+	mock.lockTransitionStatusTx.Lock()
+	mock.calls.TransitionStatusTx = append(mock.calls.TransitionStatusTx, callInfo)
+	mock.lockTransitionStatusTx.Unlock()
+	return mock.TransitionStatusTxFunc(ctx, tx, id, from, to, at)
+}
+
+// TransitionStatusTxCalls gets all the calls that were made to TransitionStatusTx.
+// Check the length with:
+//
+//	len(mockedRepository.TransitionStatusTxCalls())
+func (mock *RepositoryMock) TransitionStatusTxCalls() []struct {
+	Ctx  context.Context
+	Tx   pgx.Tx
+	ID   uuid.UUID
+	From order.Status
+	To   order.Status
+	At   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Tx   pgx.Tx
+		ID   uuid.UUID
+		From order.Status
+		To   order.Status
+		At   time.Time
+	}
+	mock.lockTransitionStatusTx.RLock()
+	calls = mock.calls.TransitionStatusTx
+	mock.lockTransitionStatusTx.RUnlock()
+	return calls
+}
+
 // UpdateStatus calls UpdateStatusFunc.
 func (mock *RepositoryMock) UpdateStatus(ctx context.Context, id uuid.UUID, status order.Status) error {
 	if mock.UpdateStatusFunc == nil {
@@ -42,6 +42,7 @@ type Order struct {
 	Product       string          `json:"product"`
 	WeightKg      decimal.Decimal `json:"weight_kg"`
 	DestinationUF states.State    `json:"destination_uf"`
+	DeclaredValue decimal.Decimal `json:"declared_value"`
 	Status        Status          `json:"status"`
 	CreatedAt     time.Time       `json:"created_at"`
 	UpdatedAt     time.Time       `json:"updated_at"`
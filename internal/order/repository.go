@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
 	"github.com/victorvcruz/shipment-coordinator/internal/platform/telemetry"
 	"github.com/victorvcruz/shipment-coordinator/pkg/states"
 	"go.opentelemetry.io/otel/attribute"
@@ -17,22 +19,41 @@ var ErrOrderNotFound = errors.New("order not found")
 
 const (
 	queryInsertOrder = `
-		INSERT INTO orders (product, weight_kg, destination_uf, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO orders (product, weight_kg, destination_uf, declared_value, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id
 	`
 
 	querySelectByID = `
-		SELECT id, product, weight_kg, destination_uf, status, created_at, updated_at
+		SELECT id, product, weight_kg, destination_uf, declared_value, status, created_at, updated_at
 		FROM orders
 		WHERE id = $1
 	`
 
+	querySelectByIDs = `
+		SELECT id, product, weight_kg, destination_uf, declared_value, status, created_at, updated_at
+		FROM orders
+		WHERE id = ANY($1)
+	`
+
+	querySelectByStatus = `
+		SELECT id, product, weight_kg, destination_uf, declared_value, status, created_at, updated_at
+		FROM orders
+		WHERE status = $1 AND destination_uf = ANY($2)
+		ORDER BY created_at
+	`
+
+	queryLockStatus = `
+		SELECT status
+		FROM orders
+		WHERE id = $1
+		FOR UPDATE
+	`
+
 	queryUpdateStatus = `
 		UPDATE orders
 		SET status = $2, updated_at = $3
 		WHERE id = $1
-		RETURNING id
 	`
 )
 
@@ -40,7 +61,11 @@ const (
 type Repository interface {
 	Create(ctx context.Context, order *Order) (uuid.UUID, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*Order, error)
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]Order, error)
+	ListByStatus(ctx context.Context, status Status, ufs []string) ([]Order, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status Status) error
+	TransitionStatusTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, from, to Status, at time.Time) error
+	AdvanceStatusTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, to Status, at time.Time) (bool, error)
 }
 
 type repository struct {
@@ -56,14 +81,30 @@ func (r *repository) Create(ctx context.Context, order *Order) (uuid.UUID, error
 	defer cancel()
 
 	var id uuid.UUID
-	err := r.pool.QueryRow(ctx, queryInsertOrder,
-		order.Product,
-		order.WeightKg,
-		order.DestinationUF.Sigla,
-		order.Status,
-		order.CreatedAt,
-		order.UpdatedAt,
-	).Scan(&id)
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, queryInsertOrder,
+			order.Product,
+			order.WeightKg,
+			order.DestinationUF.Sigla,
+			order.DeclaredValue,
+			order.Status,
+			order.CreatedAt,
+			order.UpdatedAt,
+		).Scan(&id)
+		if err != nil {
+			return err
+		}
+
+		return outbox.Write(ctx, tx, order.CreatedAt, outbox.OrderCreated{
+			OrderID:       id,
+			Product:       order.Product,
+			WeightKg:      order.WeightKg,
+			DestinationUF: order.DestinationUF.Sigla,
+			DeclaredValue: order.DeclaredValue,
+			Status:        string(order.Status),
+			CreatedAt:     order.CreatedAt,
+		})
+	})
 
 	return id, err
 }
@@ -83,6 +124,7 @@ func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*Order, error)
 		&order.Product,
 		&order.WeightKg,
 		&state,
+		&order.DeclaredValue,
 		&order.Status,
 		&order.CreatedAt,
 		&order.UpdatedAt,
@@ -95,20 +137,134 @@ func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*Order, error)
 	return &order, nil
 }
 
-func (r *repository) UpdateStatus(ctx context.Context, id uuid.UUID, status Status) error {
-	telemetry.OrderUpdatedCounter.Add(
-		ctx,
-		1,
-		metric.WithAttributes(attribute.String("status", string(status))),
-	)
+func (r *repository) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]Order, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return r.queryOrders(ctx, querySelectByIDs, ids)
+}
+
+func (r *repository) ListByStatus(ctx context.Context, status Status, ufs []string) ([]Order, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return r.queryOrders(ctx, querySelectByStatus, status, ufs)
+}
+
+func (r *repository) queryOrders(ctx context.Context, query string, args ...any) ([]Order, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := []Order{}
+	for rows.Next() {
+		var (
+			order Order
+			state string
+		)
+		if err := rows.Scan(
+			&order.ID,
+			&order.Product,
+			&order.WeightKg,
+			&state,
+			&order.DeclaredValue,
+			&order.Status,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
 
+		order.DestinationUF = states.States[state]
+		orders = append(orders, order)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *repository) UpdateStatus(ctx context.Context, id uuid.UUID, status Status) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	var returnedID uuid.UUID
-	err := r.pool.QueryRow(ctx, queryUpdateStatus, id, status, time.Now().UTC()).Scan(&returnedID)
+	now := time.Now().UTC()
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		previous, err := lockStatus(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		return setStatus(ctx, tx, id, previous, status, now)
+	})
+}
+
+func (r *repository) TransitionStatusTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	from, to Status,
+	at time.Time,
+) error {
+	current, err := lockStatus(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	if current != from {
+		return ErrInvalidStatusTransition
+	}
+
+	return setStatus(ctx, tx, id, current, to, at)
+}
+
+func (r *repository) AdvanceStatusTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	to Status,
+	at time.Time,
+) (bool, error) {
+	current, err := lockStatus(ctx, tx, id)
 	if err != nil {
-		return ErrOrderNotFound
+		return false, err
+	}
+	if StatusOrder[to] <= StatusOrder[current] {
+		return false, nil
+	}
+
+	return true, setStatus(ctx, tx, id, current, to, at)
+}
+
+func lockStatus(ctx context.Context, tx pgx.Tx, id uuid.UUID) (Status, error) {
+	var status Status
+	if err := tx.QueryRow(ctx, queryLockStatus, id).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrOrderNotFound
+		}
+		return "", err
 	}
-	return nil
+	return status, nil
+}
+
+func setStatus(ctx context.Context, tx pgx.Tx, id uuid.UUID, from, to Status, at time.Time) error {
+	if _, err := tx.Exec(ctx, queryUpdateStatus, id, to, at); err != nil {
+		return err
+	}
+
+	telemetry.OrderUpdatedCounter.Add(
+		ctx,
+		1,
+		metric.WithAttributes(attribute.String("status", string(to))),
+	)
+
+	return outbox.Write(ctx, tx, at, outbox.OrderStatusChanged{
+		OrderID:   id,
+		From:      string(from),
+		To:        string(to),
+		ChangedAt: at,
+	})
 }
@@ -0,0 +1,129 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type EventType string
+
+const (
+	EventOrderCreated       EventType = "order.created"
+	EventOrderStatusChanged EventType = "order.status_changed"
+	EventContractCreated    EventType = "contract.created"
+	EventContractCancelled  EventType = "contract.cancelled"
+)
+
+var EventTypes = map[string]EventType{
+	"order.created":        EventOrderCreated,
+	"order.status_changed": EventOrderStatusChanged,
+	"contract.created":     EventContractCreated,
+	"contract.cancelled":   EventContractCancelled,
+}
+
+type Payload interface {
+	EventType() EventType
+	AggregateID() uuid.UUID
+}
+
+type OrderCreated struct {
+	OrderID       uuid.UUID       `json:"order_id"`
+	Product       string          `json:"product"`
+	WeightKg      decimal.Decimal `json:"weight_kg"`
+	DestinationUF string          `json:"destination_uf"`
+	DeclaredValue decimal.Decimal `json:"declared_value"`
+	Status        string          `json:"status"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type OrderStatusChanged struct {
+	OrderID   uuid.UUID `json:"order_id"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type ContractCreated struct {
+	ContractID    uuid.UUID       `json:"contract_id"`
+	OrderID       uuid.UUID       `json:"order_id"`
+	CarrierID     uuid.UUID       `json:"carrier_id"`
+	Price         decimal.Decimal `json:"price"`
+	ICMS          decimal.Decimal `json:"icms"`
+	EstimatedDays int             `json:"estimated_days"`
+	QuoteID       *uuid.UUID      `json:"quote_id"`
+	LoadID        *uuid.UUID      `json:"load_id"`
+	ContractedAt  time.Time       `json:"contracted_at"`
+}
+
+type ContractCancelled struct {
+	ContractID  uuid.UUID `json:"contract_id"`
+	OrderID     uuid.UUID `json:"order_id"`
+	CarrierID   uuid.UUID `json:"carrier_id"`
+	Reason      string    `json:"reason"`
+	CancelledAt time.Time `json:"cancelled_at"`
+}
+
+func (e OrderCreated) EventType() EventType {
+	return EventOrderCreated
+}
+
+func (e OrderCreated) AggregateID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e OrderStatusChanged) EventType() EventType {
+	return EventOrderStatusChanged
+}
+
+func (e OrderStatusChanged) AggregateID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e ContractCreated) EventType() EventType {
+	return EventContractCreated
+}
+
+func (e ContractCreated) AggregateID() uuid.UUID {
+	return e.ContractID
+}
+
+func (e ContractCancelled) EventType() EventType {
+	return EventContractCancelled
+}
+
+func (e ContractCancelled) AggregateID() uuid.UUID {
+	return e.ContractID
+}
+
+type Event struct {
+	ID          uuid.UUID       `json:"id"`
+	Sequence    int64           `json:"sequence"`
+	Type        EventType       `json:"type"`
+	AggregateID uuid.UUID       `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Attempts    int             `json:"attempts"`
+	LastError   *string         `json:"last_error"`
+	PublishedAt *time.Time      `json:"published_at"`
+}
+
+func NewEvent(p Payload, at time.Time) (Event, error) {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Type:        p.EventType(),
+		AggregateID: p.AggregateID(),
+		Payload:     raw,
+		OccurredAt:  at,
+	}, nil
+}
+
+func (e Event) Decode(v any) error {
+	return json.Unmarshal(e.Payload, v)
+}
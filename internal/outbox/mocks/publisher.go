@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"sync"
+)
+
+// Ensure, that PublisherMock does implement outbox.Publisher.
+// If this is not the case, regenerate this file with moq.
+var _ outbox.Publisher = &PublisherMock{}
+
+// PublisherMock is a mock implementation of outbox.Publisher.
+//
+//	func TestSomethingThatUsesPublisher(t *testing.T) {
+//
+//		// make and configure a mocked outbox.Publisher
+//		mockedPublisher := &PublisherMock{
+//			PublishFunc: func(ctx context.Context, event outbox.Event) error {
+//				panic("mock out the Publish method")
+//			},
+//		}
+//
+//		// use mockedPublisher in code that requires outbox.Publisher
+//		// and then make assertions.
+//
+//	}
+type PublisherMock struct {
+	// PublishFunc mocks the Publish method.
+	PublishFunc func(ctx context.Context, event outbox.Event) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Publish holds details about calls to the Publish method.
+		Publish []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event outbox.Event
+		}
+	}
+	lockPublish sync.RWMutex
+}
+
+// Publish calls PublishFunc.
+func (mock *PublisherMock) Publish(ctx context.Context, event outbox.Event) error {
+	if mock.PublishFunc == nil {
+		panic("PublisherMock.PublishFunc: method is nil but Publisher.Publish was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Event outbox.Event
+	}{
+		Ctx:   ctx,
+		Event: event,
+	}
+	mock.lockPublish.Lock()
+	mock.calls.Publish = append(mock.calls.Publish, callInfo)
+	mock.lockPublish.Unlock()
+	return mock.PublishFunc(ctx, event)
+}
+
+// PublishCalls gets all the calls that were made to Publish.
+// Check the length with:
+//
+//	len(mockedPublisher.PublishCalls())
+func (mock *PublisherMock) PublishCalls() []struct {
+	Ctx   context.Context
+	Event outbox.Event
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Event outbox.Event
+	}
+	mock.lockPublish.RLock()
+	calls = mock.calls.Publish
+	mock.lockPublish.RUnlock()
+	return calls
+}
@@ -0,0 +1,276 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"sync"
+	"time"
+)
+
+// Ensure, that RepositoryMock does implement outbox.Repository.
+// If this is not the case, regenerate this file with moq.
+var _ outbox.Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of outbox.Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked outbox.Repository
+//		mockedRepository := &RepositoryMock{
+//			ClaimFunc: func(ctx context.Context, limit int, at time.Time, lease time.Duration) ([]outbox.Event, error) {
+//				panic("mock out the Claim method")
+//			},
+//			MarkFailedFunc: func(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+//				panic("mock out the MarkFailed method")
+//			},
+//			MarkPublishedFunc: func(ctx context.Context, id uuid.UUID, at time.Time) error {
+//				panic("mock out the MarkPublished method")
+//			},
+//			ParkFunc: func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+//				panic("mock out the Park method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires outbox.Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// ClaimFunc mocks the Claim method.
+	ClaimFunc func(ctx context.Context, limit int, at time.Time, lease time.Duration) ([]outbox.Event, error)
+
+	// MarkFailedFunc mocks the MarkFailed method.
+	MarkFailedFunc func(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error
+
+	// MarkPublishedFunc mocks the MarkPublished method.
+	MarkPublishedFunc func(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// ParkFunc mocks the Park method.
+	ParkFunc func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Claim holds details about calls to the Claim method.
+		Claim []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// At is the at argument value.
+			At time.Time
+			// Lease is the lease argument value.
+			Lease time.Duration
+		}
+		// MarkFailed holds details about calls to the MarkFailed method.
+		MarkFailed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Reason is the reason argument value.
+			Reason string
+			// RetryAt is the retryAt argument value.
+			RetryAt time.Time
+		}
+		// MarkPublished holds details about calls to the MarkPublished method.
+		MarkPublished []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// At is the at argument value.
+			At time.Time
+		}
+		// Park holds details about calls to the Park method.
+		Park []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Reason is the reason argument value.
+			Reason string
+			// At is the at argument value.
+			At time.Time
+		}
+	}
+	lockClaim         sync.RWMutex
+	lockMarkFailed    sync.RWMutex
+	lockMarkPublished sync.RWMutex
+	lockPark          sync.RWMutex
+}
+
+// Claim calls ClaimFunc.
+func (mock *RepositoryMock) Claim(ctx context.Context, limit int, at time.Time, lease time.Duration) ([]outbox.Event, error) {
+	if mock.ClaimFunc == nil {
+		panic("RepositoryMock.ClaimFunc: method is nil but Repository.Claim was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Limit int
+		At    time.Time
+		Lease time.Duration
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+		At:    at,
+		Lease: lease,
+	}
+	mock.lockClaim.Lock()
+	mock.calls.Claim = append(mock.calls.Claim, callInfo)
+	mock.lockClaim.Unlock()
+	return mock.ClaimFunc(ctx, limit, at, lease)
+}
+
+// ClaimCalls gets all the calls that were made to Claim.
+// Check the length with:
+//
+//	len(mockedRepository.ClaimCalls())
+func (mock *RepositoryMock) ClaimCalls() []struct {
+	Ctx   context.Context
+	Limit int
+	At    time.Time
+	Lease time.Duration
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Limit int
+		At    time.Time
+		Lease time.Duration
+	}
+	mock.lockClaim.RLock()
+	calls = mock.calls.Claim
+	mock.lockClaim.RUnlock()
+	return calls
+}
+
+// MarkFailed calls MarkFailedFunc.
+func (mock *RepositoryMock) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	if mock.MarkFailedFunc == nil {
+		panic("RepositoryMock.MarkFailedFunc: method is nil but Repository.MarkFailed was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      uuid.UUID
+		Reason  string
+		RetryAt time.Time
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		Reason:  reason,
+		RetryAt: retryAt,
+	}
+	mock.lockMarkFailed.Lock()
+	mock.calls.MarkFailed = append(mock.calls.MarkFailed, callInfo)
+	mock.lockMarkFailed.Unlock()
+	return mock.MarkFailedFunc(ctx, id, reason, retryAt)
+}
+
+// MarkFailedCalls gets all the calls that were made to MarkFailed.
+// Check the length with:
+//
+//	len(mockedRepository.MarkFailedCalls())
+func (mock *RepositoryMock) MarkFailedCalls() []struct {
+	Ctx     context.Context
+	ID      uuid.UUID
+	Reason  string
+	RetryAt time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      uuid.UUID
+		Reason  string
+		RetryAt time.Time
+	}
+	mock.lockMarkFailed.RLock()
+	calls = mock.calls.MarkFailed
+	mock.lockMarkFailed.RUnlock()
+	return calls
+}
+
+// MarkPublished calls MarkPublishedFunc.
+func (mock *RepositoryMock) MarkPublished(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if mock.MarkPublishedFunc == nil {
+		panic("RepositoryMock.MarkPublishedFunc: method is nil but Repository.MarkPublished was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		At:  at,
+	}
+	mock.lockMarkPublished.Lock()
+	mock.calls.MarkPublished = append(mock.calls.MarkPublished, callInfo)
+	mock.lockMarkPublished.Unlock()
+	return mock.MarkPublishedFunc(ctx, id, at)
+}
+
+// MarkPublishedCalls gets all the calls that were made to MarkPublished.
+// Check the length with:
+//
+//	len(mockedRepository.MarkPublishedCalls())
+func (mock *RepositoryMock) MarkPublishedCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}
+	mock.lockMarkPublished.RLock()
+	calls = mock.calls.MarkPublished
+	mock.lockMarkPublished.RUnlock()
+	return calls
+}
+
+// Park calls ParkFunc.
+func (mock *RepositoryMock) Park(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+	if mock.ParkFunc == nil {
+		panic("RepositoryMock.ParkFunc: method is nil but Repository.Park was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Reason string
+		At     time.Time
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Reason: reason,
+		At:     at,
+	}
+	mock.lockPark.Lock()
+	mock.calls.Park = append(mock.calls.Park, callInfo)
+	mock.lockPark.Unlock()
+	return mock.ParkFunc(ctx, id, reason, at)
+}
+
+// ParkCalls gets all the calls that were made to Park.
+// Check the length with:
+//
+//	len(mockedRepository.ParkCalls())
+func (mock *RepositoryMock) ParkCalls() []struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Reason string
+	At     time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Reason string
+		At     time.Time
+	}
+	mock.lockPark.RLock()
+	calls = mock.calls.Park
+	mock.lockPark.RUnlock()
+	return calls
+}
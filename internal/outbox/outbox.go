@@ -0,0 +1,38 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const queryInsertEvent = `
+	INSERT INTO outbox_events (type, aggregate_id, payload, occurred_at)
+	VALUES ($1, $2, $3, $4)
+`
+
+type Execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func Write(ctx context.Context, db Execer, at time.Time, payloads ...Payload) error {
+	for _, p := range payloads {
+		event, err := NewEvent(p, at)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s event: %w", p.EventType(), err)
+		}
+
+		if _, err := db.Exec(ctx, queryInsertEvent,
+			event.Type,
+			event.AggregateID,
+			event.Payload,
+			event.OccurredAt,
+		); err != nil {
+			return fmt.Errorf("failed to write %s event: %w", event.Type, err)
+		}
+	}
+
+	return nil
+}
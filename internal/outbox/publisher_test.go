@@ -0,0 +1,57 @@
+package outbox_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+)
+
+func TestLogFilePublisher_AppendsOneEventPerLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	publisher, err := outbox.NewLogFilePublisher(path)
+	assert.NoError(t, err)
+
+	contractID := uuid.New()
+	created, err := outbox.NewEvent(outbox.ContractCreated{
+		ContractID: contractID,
+		Price:      decimal.RequireFromString("42.50"),
+	}, time.Now())
+	assert.NoError(t, err)
+	cancelled, err := outbox.NewEvent(outbox.ContractCancelled{ContractID: contractID, Reason: "customer"}, time.Now())
+	assert.NoError(t, err)
+
+	assert.NoError(t, publisher.Publish(context.Background(), created))
+	assert.NoError(t, publisher.Publish(context.Background(), cancelled))
+	assert.NoError(t, publisher.Close())
+
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close() //nolint:errcheck
+
+	events := []outbox.Event{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var e outbox.Event
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		events = append(events, e)
+	}
+
+	assert.Len(t, events, 2)
+	assert.Equal(t, outbox.EventContractCreated, events[0].Type)
+	assert.Equal(t, outbox.EventContractCancelled, events[1].Type)
+	assert.Equal(t, contractID, events[1].AggregateID)
+
+	var payload outbox.ContractCreated
+	assert.NoError(t, events[0].Decode(&payload))
+	assert.Equal(t, contractID, payload.ContractID)
+	assert.Equal(t, "42.50", payload.Price.StringFixed(2))
+}
@@ -0,0 +1,115 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	log "go.uber.org/zap"
+)
+
+const (
+	defaultRelayInterval    = time.Second
+	defaultRelayBatchSize   = 100
+	defaultRelayLease       = 30 * time.Second
+	defaultRelayRetryDelay  = 10 * time.Second
+	defaultRelayMaxAttempts = 10
+)
+
+type RelayConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	Lease       time.Duration
+	RetryDelay  time.Duration
+	MaxAttempts int
+}
+
+type Relay struct {
+	repository Repository
+	publisher  Publisher
+	config     RelayConfig
+}
+
+func NewRelay(repository Repository, publisher Publisher, config RelayConfig) *Relay {
+	if config.Interval <= 0 {
+		config.Interval = defaultRelayInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultRelayBatchSize
+	}
+	if config.Lease <= 0 {
+		config.Lease = defaultRelayLease
+	}
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = defaultRelayRetryDelay
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultRelayMaxAttempts
+	}
+
+	return &Relay{repository: repository, publisher: publisher, config: config}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Flush(ctx); err != nil && ctx.Err() == nil {
+			log.L().
+				Warn("failed to relay outbox events", log.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	var (
+		published int
+		failure   error
+	)
+	for {
+		events, err := r.repository.Claim(ctx, r.config.BatchSize, time.Now().UTC(), r.config.Lease)
+		if err != nil {
+			return published, err
+		}
+
+		for _, event := range events {
+			if err := r.publisher.Publish(ctx, event); err != nil {
+				r.fail(ctx, event, err)
+				failure = err
+				continue
+			}
+
+			if err := r.repository.MarkPublished(ctx, event.ID, time.Now().UTC()); err != nil {
+				return published, err
+			}
+			published++
+		}
+
+		if len(events) < r.config.BatchSize {
+			return published, failure
+		}
+	}
+}
+
+func (r *Relay) fail(ctx context.Context, event Event, cause error) {
+	now := time.Now().UTC()
+
+	var err error
+	if event.Attempts+1 >= r.config.MaxAttempts {
+		log.L().
+			Error("parking outbox event after too many failed attempts", log.String("event_id", event.ID.String()), log.Int("attempts", event.Attempts+1), log.Error(cause))
+		err = r.repository.Park(ctx, event.ID, cause.Error(), now)
+	} else {
+		err = r.repository.MarkFailed(ctx, event.ID, cause.Error(), now.Add(r.config.RetryDelay))
+	}
+	if err != nil {
+		log.L().
+			Error("failed to record outbox publish failure", log.String("event_id", event.ID.String()), log.Error(err))
+	}
+}
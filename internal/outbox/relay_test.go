@@ -0,0 +1,118 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox/mocks"
+)
+
+func pendingEvents(t *testing.T, n int) []outbox.Event {
+	events := make([]outbox.Event, n)
+	for i := range events {
+		event, err := outbox.NewEvent(outbox.OrderCreated{OrderID: uuid.New(), Status: "created"}, time.Now())
+		assert.NoError(t, err)
+		event.ID = uuid.New()
+		event.Sequence = int64(i + 1)
+		events[i] = event
+	}
+	return events
+}
+
+func pendingRepository(events []outbox.Event) *mocks.RepositoryMock {
+	settled := map[uuid.UUID]bool{}
+	retryAt := map[uuid.UUID]time.Time{}
+	return &mocks.RepositoryMock{
+		ClaimFunc: func(ctx context.Context, limit int, at time.Time, lease time.Duration) ([]outbox.Event, error) {
+			claimed := []outbox.Event{}
+			for _, e := range events {
+				if !settled[e.ID] && !retryAt[e.ID].After(at) && len(claimed) < limit {
+					retryAt[e.ID] = at.Add(lease)
+					claimed = append(claimed, e)
+				}
+			}
+			return claimed, nil
+		},
+		MarkPublishedFunc: func(ctx context.Context, id uuid.UUID, at time.Time) error {
+			settled[id] = true
+			return nil
+		},
+		MarkFailedFunc: func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+			retryAt[id] = at
+			return nil
+		},
+		ParkFunc: func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+			settled[id] = true
+			return nil
+		},
+	}
+}
+
+func TestRelay_PublishesPendingEventsInOrder(t *testing.T) {
+	events := pendingEvents(t, 5)
+	repo := pendingRepository(events)
+	publisher := outbox.NewMemoryPublisher()
+
+	relay := outbox.NewRelay(repo, publisher, outbox.RelayConfig{BatchSize: 2})
+	published, err := relay.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, published)
+	assert.Equal(t, events, publisher.Events())
+	assert.Len(t, repo.MarkPublishedCalls(), 5)
+	assert.Len(t, repo.ClaimCalls(), 3)
+
+	published, err = relay.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, published)
+}
+
+func TestRelay_ContinuesPastFailedEvent(t *testing.T) {
+	events := pendingEvents(t, 3)
+	repo := pendingRepository(events)
+	publisher := &mocks.PublisherMock{
+		PublishFunc: func(ctx context.Context, event outbox.Event) error {
+			if event.ID == events[1].ID {
+				return errors.New("broker unavailable")
+			}
+			return nil
+		},
+	}
+
+	relay := outbox.NewRelay(repo, publisher, outbox.RelayConfig{RetryDelay: time.Minute})
+	published, err := relay.Flush(context.Background())
+	assert.EqualError(t, err, "broker unavailable")
+	assert.Equal(t, 2, published)
+	assert.Len(t, publisher.PublishCalls(), 3)
+	assert.Len(t, repo.MarkFailedCalls(), 1)
+	assert.Equal(t, events[1].ID, repo.MarkFailedCalls()[0].ID)
+	assert.Equal(t, "broker unavailable", repo.MarkFailedCalls()[0].Reason)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), repo.MarkFailedCalls()[0].RetryAt, time.Second)
+
+	published, err = relay.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, published)
+	assert.Len(t, publisher.PublishCalls(), 3)
+}
+
+func TestRelay_ParksEventAfterMaxAttempts(t *testing.T) {
+	events := pendingEvents(t, 1)
+	events[0].Attempts = 2
+	repo := pendingRepository(events)
+	publisher := &mocks.PublisherMock{
+		PublishFunc: func(ctx context.Context, event outbox.Event) error {
+			return errors.New("payload rejected")
+		},
+	}
+
+	relay := outbox.NewRelay(repo, publisher, outbox.RelayConfig{MaxAttempts: 3})
+	_, err := relay.Flush(context.Background())
+	assert.EqualError(t, err, "payload rejected")
+	assert.Empty(t, repo.MarkFailedCalls())
+	assert.Len(t, repo.ParkCalls(), 1)
+	assert.Equal(t, "payload rejected", repo.ParkCalls()[0].Reason)
+}
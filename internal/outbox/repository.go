@@ -0,0 +1,117 @@
+package outbox
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	queryClaimPending = `
+	WITH claimed AS (
+		SELECT id
+		FROM outbox_events
+		WHERE published_at IS NULL
+		  AND parked_at IS NULL
+		  AND (locked_until IS NULL OR locked_until <= $2)
+		ORDER BY sequence
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE outbox_events e
+	SET locked_until = $3
+	FROM claimed
+	WHERE e.id = claimed.id
+	RETURNING e.id, e.sequence, e.type, e.aggregate_id, e.payload, e.occurred_at, e.attempts, e.last_error,
+	          e.published_at
+`
+
+	queryMarkPublished = `
+	UPDATE outbox_events
+	SET published_at = $2, attempts = attempts + 1, last_error = NULL, locked_until = NULL
+	WHERE id = $1
+`
+
+	queryMarkFailed = `
+	UPDATE outbox_events
+	SET attempts = attempts + 1, last_error = $2, locked_until = $3
+	WHERE id = $1
+`
+
+	queryPark = `
+	UPDATE outbox_events
+	SET attempts = attempts + 1, last_error = $2, parked_at = $3, locked_until = NULL
+	WHERE id = $1
+`
+)
+
+//go:generate moq -pkg mocks -out mocks/repository.go . Repository
+type Repository interface {
+	Claim(ctx context.Context, limit int, at time.Time, lease time.Duration) ([]Event, error)
+	MarkPublished(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error
+	Park(ctx context.Context, id uuid.UUID, reason string, at time.Time) error
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) Repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) Claim(ctx context.Context, limit int, at time.Time, lease time.Duration) ([]Event, error) {
+	rows, err := r.pool.Query(ctx, queryClaimPending, limit, at, at.Add(lease))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []Event{}
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(
+			&e.ID,
+			&e.Sequence,
+			&e.Type,
+			&e.AggregateID,
+			&e.Payload,
+			&e.OccurredAt,
+			&e.Attempts,
+			&e.LastError,
+			&e.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Sequence < events[j].Sequence
+	})
+
+	return events, nil
+}
+
+func (r *repository) MarkPublished(ctx context.Context, id uuid.UUID, at time.Time) error {
+	_, err := r.pool.Exec(ctx, queryMarkPublished, id, at)
+	return err
+}
+
+func (r *repository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	_, err := r.pool.Exec(ctx, queryMarkFailed, id, reason, retryAt)
+	return err
+}
+
+func (r *repository) Park(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+	_, err := r.pool.Exec(ctx, queryPark, id, reason, at)
+	return err
+}
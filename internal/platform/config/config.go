@@ -3,13 +3,15 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 type (
 	Server struct {
-		Port string `yaml:"port"`
+		Port     string `yaml:"port"`
+		GRPCPort string `yaml:"grpc_port"`
 	}
 	Database struct {
 		URL string `yaml:"url"`
@@ -21,14 +23,77 @@ type (
 		Enabled  bool   `yaml:"enabled"`
 		Endpoint string `yaml:"endpoint"`
 	}
+	Ranking struct {
+		Strategy          string  `yaml:"strategy"`
+		PriceWeight       float64 `yaml:"price_weight"`
+		DaysWeight        float64 `yaml:"days_weight"`
+		ReliabilityWeight float64 `yaml:"reliability_weight"`
+	}
+	Booking struct {
+		Interval    time.Duration `yaml:"interval"`
+		BatchSize   int           `yaml:"batch_size"`
+		Lease       time.Duration `yaml:"lease"`
+		MaxAttempts int           `yaml:"max_attempts"`
+		Backoff     time.Duration `yaml:"backoff"`
+	}
+	Shipping struct {
+		Ranking         Ranking       `yaml:"ranking"`
+		AutoContract    bool          `yaml:"auto_contract"`
+		QuoteTTL        time.Duration `yaml:"quote_ttl"`
+		SnapshotTTL     time.Duration `yaml:"snapshot_ttl"`
+		OriginUF        string        `yaml:"origin_uf"`
+		GatewayDeadline time.Duration `yaml:"gateway_deadline"`
+		Booking         Booking       `yaml:"booking"`
+	}
+	Webhooks struct {
+		Tolerance time.Duration `yaml:"tolerance"`
+	}
+	Invoices struct {
+		PriceTolerance    float64 `yaml:"price_tolerance"`
+		WeightToleranceKg float64 `yaml:"weight_tolerance_kg"`
+	}
+	Gateway struct {
+		CarrierID        string        `yaml:"carrier_id"`
+		BaseURL          string        `yaml:"base_url"`
+		APIKey           string        `yaml:"api_key"`
+		Timeout          time.Duration `yaml:"timeout"`
+		Retries          int           `yaml:"retries"`
+		Backoff          time.Duration `yaml:"backoff"`
+		FailureThreshold int           `yaml:"failure_threshold"`
+		Cooldown         time.Duration `yaml:"cooldown"`
+	}
+	Outbox struct {
+		Publisher   string        `yaml:"publisher"`
+		Path        string        `yaml:"path"`
+		Interval    time.Duration `yaml:"interval"`
+		BatchSize   int           `yaml:"batch_size"`
+		Lease       time.Duration `yaml:"lease"`
+		RetryDelay  time.Duration `yaml:"retry_delay"`
+		MaxAttempts int           `yaml:"max_attempts"`
+	}
+	Subscriptions struct {
+		Interval    time.Duration `yaml:"interval"`
+		BatchSize   int           `yaml:"batch_size"`
+		Timeout     time.Duration `yaml:"timeout"`
+		Lease       time.Duration `yaml:"lease"`
+		MaxAttempts int           `yaml:"max_attempts"`
+		Backoff     time.Duration `yaml:"backoff"`
+		MaxBackoff  time.Duration `yaml:"max_backoff"`
+	}
 	AppConfig struct {
-		Env       string    `yaml:"env"`
-		Service   string    `yaml:"service"`
-		Version   string    `yaml:"version"`
-		Server    Server    `yaml:"server"`
-		Database  Database  `yaml:"database"`
-		Logging   Logging   `yaml:"logging"`
-		Telemetry Telemetry `yaml:"telemetry"`
+		Env           string        `yaml:"env"`
+		Service       string        `yaml:"service"`
+		Version       string        `yaml:"version"`
+		Server        Server        `yaml:"server"`
+		Database      Database      `yaml:"database"`
+		Logging       Logging       `yaml:"logging"`
+		Telemetry     Telemetry     `yaml:"telemetry"`
+		Shipping      Shipping      `yaml:"shipping"`
+		Webhooks      Webhooks      `yaml:"webhooks"`
+		Invoices      Invoices      `yaml:"invoices"`
+		Gateways      []Gateway     `yaml:"gateways"`
+		Outbox        Outbox        `yaml:"outbox"`
+		Subscriptions Subscriptions `yaml:"subscriptions"`
 	}
 )
 
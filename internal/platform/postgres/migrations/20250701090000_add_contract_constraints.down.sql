@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS unique_active_contract_per_order;
+
+ALTER TABLE contracts
+    DROP CONSTRAINT IF EXISTS fk_contracts_order,
+    DROP COLUMN IF EXISTS status;
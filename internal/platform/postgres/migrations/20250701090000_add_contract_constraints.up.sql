@@ -0,0 +1,7 @@
+ALTER TABLE contracts
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'active',
+    ADD CONSTRAINT fk_contracts_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE RESTRICT;
+
+CREATE UNIQUE INDEX unique_active_contract_per_order
+    ON contracts (order_id)
+    WHERE status = 'active';
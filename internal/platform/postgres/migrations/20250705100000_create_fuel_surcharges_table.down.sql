@@ -0,0 +1,5 @@
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS fuel_surcharge,
+    DROP COLUMN IF EXISTS fuel_surcharge_percentage;
+
+DROP TABLE IF EXISTS fuel_surcharges;
@@ -0,0 +1,15 @@
+CREATE TABLE fuel_surcharges
+(
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    carrier_id     UUID REFERENCES carriers (id) ON DELETE CASCADE,
+    percentage     NUMERIC(5, 2) NOT NULL,
+    effective_from TIMESTAMPTZ   NOT NULL,
+    created_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_fuel_surcharges_carrier_effective
+    ON fuel_surcharges (carrier_id, effective_from DESC);
+
+ALTER TABLE contracts
+    ADD COLUMN fuel_surcharge_percentage NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN fuel_surcharge            NUMERIC(10, 2) NOT NULL DEFAULT 0;
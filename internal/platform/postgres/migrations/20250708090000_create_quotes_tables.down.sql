@@ -0,0 +1,9 @@
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS quote_id;
+
+DROP TABLE IF EXISTS quotes;
+
+DROP TABLE IF EXISTS quote_requests;
+
+ALTER TABLE carrier_policies
+    DROP COLUMN IF EXISTS version;
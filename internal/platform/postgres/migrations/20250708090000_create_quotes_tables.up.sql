@@ -0,0 +1,35 @@
+ALTER TABLE carrier_policies
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE quote_requests
+(
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id     UUID        NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    strategy     TEXT        NOT NULL,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE quotes
+(
+    id                        UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    request_id                UUID           NOT NULL REFERENCES quote_requests (id) ON DELETE CASCADE,
+    order_id                  UUID           NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    carrier_id                UUID           NOT NULL REFERENCES carriers (id) ON DELETE CASCADE,
+    policy_id                 UUID           NOT NULL,
+    policy_version            INTEGER        NOT NULL,
+    base_price                NUMERIC(10, 2) NOT NULL,
+    fuel_surcharge_percentage NUMERIC(5, 2)  NOT NULL,
+    fuel_surcharge            NUMERIC(10, 2) NOT NULL,
+    price                     NUMERIC(10, 2) NOT NULL,
+    estimated_days            INTEGER        NOT NULL,
+    reliability               NUMERIC(5, 4)  NOT NULL,
+    score                     NUMERIC(6, 4)  NOT NULL,
+    recommended               BOOLEAN        NOT NULL DEFAULT FALSE,
+    expires_at                TIMESTAMPTZ    NOT NULL,
+    created_at                TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_quotes_order_id ON quotes (order_id);
+
+ALTER TABLE contracts
+    ADD COLUMN quote_id UUID REFERENCES quotes (id) ON DELETE SET NULL;
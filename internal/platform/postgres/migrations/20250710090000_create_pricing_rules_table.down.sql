@@ -0,0 +1,7 @@
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS price_breakdown;
+
+ALTER TABLE quotes
+    DROP COLUMN IF EXISTS price_breakdown;
+
+DROP TABLE IF EXISTS pricing_rules;
@@ -0,0 +1,18 @@
+CREATE TABLE pricing_rules
+(
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    carrier_id UUID REFERENCES carriers (id) ON DELETE CASCADE,
+    name       TEXT           NOT NULL DEFAULT '',
+    kind       TEXT           NOT NULL,
+    value      NUMERIC(10, 2) NOT NULL,
+    position   INTEGER        NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_pricing_rules_carrier_id ON pricing_rules (carrier_id);
+
+ALTER TABLE quotes
+    ADD COLUMN price_breakdown JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE contracts
+    ADD COLUMN price_breakdown JSONB NOT NULL DEFAULT '[]';
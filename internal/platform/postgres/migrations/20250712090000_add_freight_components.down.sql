@@ -0,0 +1,22 @@
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS tde,
+    DROP COLUMN IF EXISTS toll,
+    DROP COLUMN IF EXISTS gris,
+    DROP COLUMN IF EXISTS ad_valorem;
+
+ALTER TABLE quotes
+    DROP COLUMN IF EXISTS tde,
+    DROP COLUMN IF EXISTS toll,
+    DROP COLUMN IF EXISTS gris,
+    DROP COLUMN IF EXISTS ad_valorem;
+
+ALTER TABLE carrier_policies
+    DROP COLUMN IF EXISTS tde_ufs,
+    DROP COLUMN IF EXISTS tde_percentage,
+    DROP COLUMN IF EXISTS toll_per_fraction,
+    DROP COLUMN IF EXISTS gris_minimum,
+    DROP COLUMN IF EXISTS gris_percentage,
+    DROP COLUMN IF EXISTS ad_valorem_percentage;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS declared_value;
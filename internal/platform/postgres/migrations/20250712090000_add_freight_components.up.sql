@@ -0,0 +1,22 @@
+ALTER TABLE orders
+    ADD COLUMN declared_value NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE carrier_policies
+    ADD COLUMN ad_valorem_percentage NUMERIC(5, 2)  NOT NULL DEFAULT 0,
+    ADD COLUMN gris_percentage       NUMERIC(5, 2)  NOT NULL DEFAULT 0,
+    ADD COLUMN gris_minimum          NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN toll_per_fraction     NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN tde_percentage        NUMERIC(5, 2)  NOT NULL DEFAULT 0,
+    ADD COLUMN tde_ufs               TEXT[]         NOT NULL DEFAULT '{}';
+
+ALTER TABLE quotes
+    ADD COLUMN ad_valorem NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN gris       NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN toll       NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN tde        NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE contracts
+    ADD COLUMN ad_valorem NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN gris       NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN toll       NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN tde        NUMERIC(10, 2) NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS icms,
+    DROP COLUMN IF EXISTS icms_rate;
+
+ALTER TABLE quotes
+    DROP COLUMN IF EXISTS icms,
+    DROP COLUMN IF EXISTS icms_rate;
@@ -0,0 +1,7 @@
+ALTER TABLE quotes
+    ADD COLUMN icms_rate NUMERIC(5, 2)  NOT NULL DEFAULT 0,
+    ADD COLUMN icms      NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE contracts
+    ADD COLUMN icms_rate NUMERIC(5, 2)  NOT NULL DEFAULT 0,
+    ADD COLUMN icms      NUMERIC(10, 2) NOT NULL DEFAULT 0;
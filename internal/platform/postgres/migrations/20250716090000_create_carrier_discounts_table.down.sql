@@ -0,0 +1,9 @@
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS discount,
+    DROP COLUMN IF EXISTS discount_id;
+
+ALTER TABLE quotes
+    DROP COLUMN IF EXISTS discount,
+    DROP COLUMN IF EXISTS discount_id;
+
+DROP TABLE IF EXISTS carrier_discounts;
@@ -0,0 +1,25 @@
+CREATE TABLE carrier_discounts
+(
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    carrier_id         UUID           NOT NULL REFERENCES carriers (id) ON DELETE CASCADE,
+    name               TEXT           NOT NULL,
+    percentage         NUMERIC(5, 2)  NOT NULL,
+    region             TEXT           NOT NULL DEFAULT '',
+    min_weight_kg      NUMERIC(10, 3) NOT NULL DEFAULT 0,
+    max_weight_kg      NUMERIC(10, 3) NOT NULL DEFAULT 0,
+    min_monthly_volume INTEGER        NOT NULL DEFAULT 0,
+    valid_from         TIMESTAMPTZ    NOT NULL,
+    valid_until        TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_carrier_discounts_carrier_validity
+    ON carrier_discounts (carrier_id, valid_from, valid_until);
+
+ALTER TABLE quotes
+    ADD COLUMN discount_id UUID REFERENCES carrier_discounts (id) ON DELETE SET NULL,
+    ADD COLUMN discount    NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE contracts
+    ADD COLUMN discount_id UUID REFERENCES carrier_discounts (id) ON DELETE SET NULL,
+    ADD COLUMN discount    NUMERIC(10, 2) NOT NULL DEFAULT 0;
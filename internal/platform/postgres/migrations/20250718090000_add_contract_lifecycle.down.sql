@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_contracts_status;
+DROP INDEX IF EXISTS idx_contracts_carrier_contracted_at;
+DROP INDEX IF EXISTS idx_contracts_order_id;
+
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS replaced_by,
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS cancel_reason;
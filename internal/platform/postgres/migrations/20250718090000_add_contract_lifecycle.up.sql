@@ -0,0 +1,8 @@
+ALTER TABLE contracts
+    ADD COLUMN cancel_reason TEXT,
+    ADD COLUMN cancelled_at  TIMESTAMPTZ,
+    ADD COLUMN replaced_by   UUID REFERENCES contracts (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_contracts_order_id ON contracts (order_id);
+CREATE INDEX idx_contracts_carrier_contracted_at ON contracts (carrier_id, contracted_at);
+CREATE INDEX idx_contracts_status ON contracts (status);
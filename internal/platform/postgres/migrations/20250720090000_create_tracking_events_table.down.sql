@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS tracking_events_append_only ON tracking_events;
+DROP FUNCTION IF EXISTS reject_tracking_event_changes();
+DROP TABLE IF EXISTS tracking_events;
+
+DROP INDEX IF EXISTS unique_tracking_code_per_carrier;
+
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS tracking_code;
@@ -0,0 +1,34 @@
+ALTER TABLE contracts
+    ADD COLUMN tracking_code TEXT;
+
+CREATE UNIQUE INDEX unique_tracking_code_per_carrier
+    ON contracts (carrier_id, tracking_code)
+    WHERE tracking_code IS NOT NULL;
+
+CREATE TABLE tracking_events
+(
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    contract_id UUID        NOT NULL REFERENCES contracts (id) ON DELETE RESTRICT,
+    order_id    UUID        NOT NULL REFERENCES orders (id) ON DELETE RESTRICT,
+    code        TEXT        NOT NULL,
+    description TEXT        NOT NULL DEFAULT '',
+    location    TEXT        NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tracking_events_order_occurred_at
+    ON tracking_events (order_id, occurred_at);
+
+CREATE FUNCTION reject_tracking_event_changes() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'tracking events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tracking_events_append_only
+    BEFORE UPDATE OR DELETE
+    ON tracking_events
+    FOR EACH ROW
+EXECUTE FUNCTION reject_tracking_event_changes();
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS unique_carrier_webhook_nonce;
+DROP TABLE IF EXISTS carrier_webhook_deliveries;
+DROP TABLE IF EXISTS carrier_webhook_secrets;
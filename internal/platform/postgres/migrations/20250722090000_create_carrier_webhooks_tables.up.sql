@@ -0,0 +1,24 @@
+CREATE TABLE carrier_webhook_secrets
+(
+    carrier_id UUID PRIMARY KEY REFERENCES carriers (id) ON DELETE CASCADE,
+    secret     TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE carrier_webhook_deliveries
+(
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    carrier_id   UUID        NOT NULL REFERENCES carriers (id) ON DELETE RESTRICT,
+    nonce        TEXT        NOT NULL,
+    signed_at    TIMESTAMPTZ NOT NULL,
+    signature    TEXT        NOT NULL,
+    payload      TEXT        NOT NULL,
+    accepted     INTEGER     NOT NULL DEFAULT 0,
+    unmatched    INTEGER     NOT NULL DEFAULT 0,
+    error        TEXT,
+    received_at  TIMESTAMPTZ NOT NULL,
+    processed_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX unique_carrier_webhook_nonce
+    ON carrier_webhook_deliveries (carrier_id, nonce);
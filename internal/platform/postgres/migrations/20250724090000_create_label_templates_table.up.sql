@@ -0,0 +1,8 @@
+CREATE TABLE label_templates
+(
+    carrier_id UUID        NOT NULL REFERENCES carriers (id) ON DELETE CASCADE,
+    format     TEXT        NOT NULL CHECK (format IN ('pdf', 'zpl')),
+    content    TEXT        NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (carrier_id, format)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS invoice_lines;
+DROP INDEX IF EXISTS unique_invoice_reference_per_carrier;
+DROP TABLE IF EXISTS invoices;
@@ -0,0 +1,27 @@
+CREATE TABLE invoices
+(
+    id                  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    carrier_id          UUID           NOT NULL REFERENCES carriers (id) ON DELETE RESTRICT,
+    reference           TEXT           NOT NULL,
+    period_from         DATE           NOT NULL,
+    period_to           DATE           NOT NULL,
+    price_tolerance     NUMERIC(10, 2) NOT NULL,
+    weight_tolerance_kg NUMERIC(10, 3) NOT NULL,
+    created_at          TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    CHECK (period_to >= period_from)
+);
+
+CREATE UNIQUE INDEX unique_invoice_reference_per_carrier
+    ON invoices (carrier_id, reference);
+
+CREATE TABLE invoice_lines
+(
+    invoice_id    UUID           NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    line_number   INTEGER        NOT NULL,
+    tracking_code TEXT,
+    order_id      UUID,
+    amount        NUMERIC(12, 2) NOT NULL,
+    weight_kg     NUMERIC(10, 3),
+    PRIMARY KEY (invoice_id, line_number),
+    CHECK (tracking_code IS NOT NULL OR order_id IS NOT NULL)
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS unique_manifest_item_contract;
+DROP TABLE IF EXISTS manifest_items;
+DROP INDEX IF EXISTS idx_manifests_carrier_pickup_date;
+DROP TABLE IF EXISTS manifests;
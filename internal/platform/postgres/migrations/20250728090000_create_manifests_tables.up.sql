@@ -0,0 +1,29 @@
+CREATE TABLE manifests
+(
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    carrier_id   UUID        NOT NULL REFERENCES carriers (id) ON DELETE RESTRICT,
+    pickup_date  DATE        NOT NULL,
+    status       TEXT        NOT NULL CHECK (status IN ('open', 'confirmed', 'cancelled')),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    confirmed_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_manifests_carrier_pickup_date ON manifests (carrier_id, pickup_date);
+
+CREATE TABLE manifest_items
+(
+    manifest_id    UUID           NOT NULL REFERENCES manifests (id) ON DELETE CASCADE,
+    contract_id    UUID           NOT NULL REFERENCES contracts (id) ON DELETE RESTRICT,
+    order_id       UUID           NOT NULL REFERENCES orders (id) ON DELETE RESTRICT,
+    tracking_code  TEXT,
+    product        TEXT           NOT NULL,
+    weight_kg      NUMERIC(10, 2) NOT NULL,
+    destination_uf CHAR(2)        NOT NULL,
+    released       BOOLEAN        NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (manifest_id, contract_id)
+);
+
+CREATE UNIQUE INDEX unique_manifest_item_contract
+    ON manifest_items (contract_id)
+    WHERE NOT released;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_contracts_load_id;
+
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS load_id;
+
+DROP INDEX IF EXISTS unique_active_load_order;
+DROP TABLE IF EXISTS load_orders;
+DROP TABLE IF EXISTS loads;
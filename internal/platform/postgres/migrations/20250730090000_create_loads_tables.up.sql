@@ -0,0 +1,51 @@
+CREATE TABLE loads
+(
+    id                        UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    carrier_id                UUID           NOT NULL REFERENCES carriers (id) ON DELETE RESTRICT,
+    region                    TEXT           NOT NULL,
+    destination_uf            CHAR(2)        NOT NULL,
+    status                    TEXT           NOT NULL CHECK (status IN ('planned', 'contracted', 'cancelled')),
+    weight_kg                 NUMERIC(10, 2) NOT NULL,
+    declared_value            NUMERIC(10, 2) NOT NULL,
+    estimated_days            INT            NOT NULL,
+    base_price                NUMERIC(10, 2) NOT NULL,
+    fuel_surcharge_percentage NUMERIC(5, 2)  NOT NULL,
+    fuel_surcharge            NUMERIC(10, 2) NOT NULL,
+    ad_valorem                NUMERIC(10, 2) NOT NULL,
+    gris                      NUMERIC(10, 2) NOT NULL,
+    toll                      NUMERIC(10, 2) NOT NULL,
+    tde                       NUMERIC(10, 2) NOT NULL,
+    discount_id               UUID REFERENCES carrier_discounts (id) ON DELETE SET NULL,
+    discount                  NUMERIC(10, 2) NOT NULL,
+    price                     NUMERIC(10, 2) NOT NULL,
+    icms                      NUMERIC(10, 2) NOT NULL,
+    price_breakdown           JSONB          NOT NULL DEFAULT '[]',
+    individual_price          NUMERIC(10, 2) NOT NULL,
+    expires_at                TIMESTAMPTZ    NOT NULL,
+    contracted_at             TIMESTAMPTZ,
+    cancelled_at              TIMESTAMPTZ,
+    created_at                TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    updated_at                TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE load_orders
+(
+    load_id        UUID           NOT NULL REFERENCES loads (id) ON DELETE CASCADE,
+    order_id       UUID           NOT NULL REFERENCES orders (id) ON DELETE RESTRICT,
+    position       INT            NOT NULL,
+    destination_uf CHAR(2)        NOT NULL,
+    weight_kg      NUMERIC(10, 2) NOT NULL,
+    declared_value NUMERIC(10, 2) NOT NULL,
+    contract_id    UUID REFERENCES contracts (id) ON DELETE RESTRICT,
+    released       BOOLEAN        NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (load_id, order_id)
+);
+
+CREATE UNIQUE INDEX unique_active_load_order
+    ON load_orders (order_id)
+    WHERE NOT released;
+
+ALTER TABLE contracts
+    ADD COLUMN load_id UUID REFERENCES loads (id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_contracts_load_id ON contracts (load_id);
@@ -0,0 +1,8 @@
+ALTER TABLE loads
+    DROP COLUMN IF EXISTS price_explanation;
+
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS price_explanation;
+
+ALTER TABLE quotes
+    DROP COLUMN IF EXISTS price_explanation;
@@ -0,0 +1,8 @@
+ALTER TABLE quotes
+    ADD COLUMN price_explanation JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE contracts
+    ADD COLUMN price_explanation JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE loads
+    ADD COLUMN price_explanation JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,15 @@
+CREATE TABLE outbox_events
+(
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sequence     BIGINT GENERATED ALWAYS AS IDENTITY,
+    type         TEXT        NOT NULL,
+    aggregate_id UUID        NOT NULL,
+    payload      JSONB       NOT NULL,
+    occurred_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    last_error   TEXT,
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events (sequence) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_events_aggregate_id ON outbox_events (aggregate_id);
@@ -0,0 +1,21 @@
+CREATE FUNCTION notify_outbox_event() RETURNS TRIGGER AS
+$$
+BEGIN
+    PERFORM pg_notify('outbox_events', json_build_object(
+            'id', NEW.id,
+            'sequence', NEW.sequence,
+            'type', NEW.type,
+            'aggregate_id', NEW.aggregate_id,
+            'payload', NEW.payload,
+            'occurred_at', NEW.occurred_at
+        )::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_events_notify
+    AFTER INSERT
+    ON outbox_events
+    FOR EACH ROW
+    WHEN (NEW.type IN ('order.status_changed', 'contract.created', 'contract.cancelled'))
+EXECUTE FUNCTION notify_outbox_event();
@@ -0,0 +1,6 @@
+ALTER TABLE outbox_events
+    ADD COLUMN locked_until TIMESTAMPTZ,
+    ADD COLUMN parked_at    TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX idx_outbox_events_pending ON outbox_events (sequence) WHERE published_at IS NULL AND parked_at IS NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_contracts_pending_booking;
+
+ALTER TABLE contracts
+    DROP COLUMN IF EXISTS next_booking_at,
+    DROP COLUMN IF EXISTS booking_error,
+    DROP COLUMN IF EXISTS booking_attempts,
+    DROP COLUMN IF EXISTS booking_status;
@@ -0,0 +1,7 @@
+ALTER TABLE contracts
+    ADD COLUMN booking_status   TEXT    NOT NULL DEFAULT 'none',
+    ADD COLUMN booking_attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN booking_error    TEXT,
+    ADD COLUMN next_booking_at  TIMESTAMPTZ;
+
+CREATE INDEX idx_contracts_pending_booking ON contracts (next_booking_at) WHERE booking_status = 'pending';
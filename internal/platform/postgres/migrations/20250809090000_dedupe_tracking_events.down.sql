@@ -0,0 +1 @@
+DROP INDEX IF EXISTS unique_tracking_event_per_contract;
@@ -0,0 +1,13 @@
+ALTER TABLE tracking_events DISABLE TRIGGER tracking_events_append_only;
+
+DELETE FROM tracking_events t
+    USING tracking_events d
+WHERE t.contract_id = d.contract_id
+  AND t.code = d.code
+  AND t.occurred_at = d.occurred_at
+  AND (t.created_at, t.id) > (d.created_at, d.id);
+
+ALTER TABLE tracking_events ENABLE TRIGGER tracking_events_append_only;
+
+CREATE UNIQUE INDEX unique_tracking_event_per_contract
+    ON tracking_events (contract_id, code, occurred_at);
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS carrier_policies_bump_version ON carrier_policies;
+DROP FUNCTION IF EXISTS bump_carrier_policy_version();
@@ -0,0 +1,19 @@
+CREATE FUNCTION bump_carrier_policy_version() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.version := OLD.version + 1;
+    NEW.updated_at := NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER carrier_policies_bump_version
+    BEFORE UPDATE
+    ON carrier_policies
+    FOR EACH ROW
+    WHEN ((OLD.region, OLD.estimated_days, OLD.price_per_kg, OLD.ad_valorem_percentage, OLD.gris_percentage,
+           OLD.gris_minimum, OLD.toll_per_fraction, OLD.tde_percentage, OLD.tde_ufs)
+        IS DISTINCT FROM
+          (NEW.region, NEW.estimated_days, NEW.price_per_kg, NEW.ad_valorem_percentage, NEW.gris_percentage,
+           NEW.gris_minimum, NEW.toll_per_fraction, NEW.tde_percentage, NEW.tde_ufs))
+EXECUTE FUNCTION bump_carrier_policy_version();
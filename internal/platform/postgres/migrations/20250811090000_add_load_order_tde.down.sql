@@ -0,0 +1,2 @@
+ALTER TABLE load_orders
+    DROP COLUMN IF EXISTS tde;
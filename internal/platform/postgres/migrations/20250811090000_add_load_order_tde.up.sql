@@ -0,0 +1,2 @@
+ALTER TABLE load_orders
+    ADD COLUMN tde NUMERIC(10, 2) NOT NULL DEFAULT 0;
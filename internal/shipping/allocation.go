@@ -0,0 +1,194 @@
+package shipping
+
+import (
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var ErrAllocationInfeasible = errors.New("no allocation satisfies the constraints")
+
+type AllocationRequest struct {
+	OrderIDs         []uuid.UUID
+	Capacity         map[uuid.UUID]int
+	MaxDays          int
+	RequiredCarriers []uuid.UUID
+	Contract         bool
+}
+
+type Allocation struct {
+	Assignments   []Assignment    `json:"assignments"`
+	Carriers      []CarrierLoad   `json:"carriers"`
+	TotalPrice    decimal.Decimal `json:"total_price"`
+	CheapestPrice decimal.Decimal `json:"cheapest_price"`
+}
+
+type Assignment struct {
+	OrderID       uuid.UUID       `json:"order_id"`
+	CarrierID     uuid.UUID       `json:"carrier_id"`
+	CarrierName   string          `json:"carrier_name"`
+	QuoteID       uuid.UUID       `json:"quote_id"`
+	TotalPrice    decimal.Decimal `json:"total_price"`
+	EstimatedDays int             `json:"estimated_days"`
+	ContractID    *uuid.UUID      `json:"contract_id"`
+	Err           error           `json:"-"`
+}
+
+type CarrierLoad struct {
+	CarrierID   uuid.UUID       `json:"carrier_id"`
+	CarrierName string          `json:"carrier_name"`
+	Orders      int             `json:"orders"`
+	TotalPrice  decimal.Decimal `json:"total_price"`
+}
+
+type flowEdge struct {
+	to, capacity int
+	cost         int64
+}
+
+type flowGraph struct {
+	edges []flowEdge
+	adj   [][]int
+}
+
+func newFlowGraph(nodes int) *flowGraph {
+	return &flowGraph{adj: make([][]int, nodes)}
+}
+
+func (g *flowGraph) addEdge(from, to, capacity int, cost int64) int {
+	g.adj[from] = append(g.adj[from], len(g.edges))
+	g.edges = append(g.edges, flowEdge{to: to, capacity: capacity, cost: cost})
+	g.adj[to] = append(g.adj[to], len(g.edges))
+	g.edges = append(g.edges, flowEdge{to: from, capacity: 0, cost: -cost})
+	return len(g.edges) - 2
+}
+
+func (g *flowGraph) minCostFlow(source, sink, demand int) int {
+	flow := 0
+	for flow < demand {
+		dist := make([]int64, len(g.adj))
+		prev := make([]int, len(g.adj))
+		inQueue := make([]bool, len(g.adj))
+		for i := range dist {
+			dist[i] = math.MaxInt64
+			prev[i] = -1
+		}
+		dist[source] = 0
+
+		queue := []int{source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			inQueue[node] = false
+
+			for _, id := range g.adj[node] {
+				e := g.edges[id]
+				if e.capacity == 0 || dist[node]+e.cost >= dist[e.to] {
+					continue
+				}
+				dist[e.to] = dist[node] + e.cost
+				prev[e.to] = id
+				if !inQueue[e.to] {
+					inQueue[e.to] = true
+					queue = append(queue, e.to)
+				}
+			}
+		}
+
+		if dist[sink] == math.MaxInt64 {
+			break
+		}
+
+		for node := sink; node != source; {
+			id := prev[node]
+			g.edges[id].capacity--
+			g.edges[id^1].capacity++
+			node = g.edges[id^1].to
+		}
+		flow++
+	}
+
+	return flow
+}
+
+func allocate(quotes [][]*Quote, capacity map[uuid.UUID]int, required []uuid.UUID) ([]*Quote, error) {
+	orders := len(quotes)
+
+	carriers := map[uuid.UUID]int{}
+	carrierIDs := []uuid.UUID{}
+	var bonus int64 = 1
+	for _, options := range quotes {
+		var highest int64
+		for _, q := range options {
+			if _, ok := carriers[q.CarrierID]; !ok {
+				carriers[q.CarrierID] = len(carrierIDs)
+				carrierIDs = append(carrierIDs, q.CarrierID)
+			}
+			highest = max(highest, cents(q.TotalPrice()))
+		}
+		bonus += highest
+	}
+
+	isRequired := map[uuid.UUID]bool{}
+	for _, id := range required {
+		if _, ok := carriers[id]; !ok {
+			return nil, ErrAllocationInfeasible
+		}
+		isRequired[id] = true
+	}
+
+	source, sink := 0, 1+orders+len(carrierIDs)
+	graph := newFlowGraph(sink + 1)
+
+	choices := make([]map[int]*Quote, orders)
+	for i, options := range quotes {
+		graph.addEdge(source, 1+i, 1, 0)
+		choices[i] = map[int]*Quote{}
+		for _, q := range options {
+			edge := graph.addEdge(1+i, 1+orders+carriers[q.CarrierID], 1, cents(q.TotalPrice()))
+			choices[i][edge] = q
+		}
+	}
+
+	for i, id := range carrierIDs {
+		limit, ok := capacity[id]
+		if !ok {
+			limit = orders
+		}
+		node := 1 + orders + i
+		if isRequired[id] && limit > 0 {
+			graph.addEdge(node, sink, 1, -bonus)
+			limit--
+		}
+		graph.addEdge(node, sink, limit, 0)
+	}
+
+	if graph.minCostFlow(source, sink, orders) < orders {
+		return nil, ErrAllocationInfeasible
+	}
+
+	assigned := make([]*Quote, orders)
+	used := map[uuid.UUID]bool{}
+	for i := range quotes {
+		for edge, q := range choices[i] {
+			if graph.edges[edge].capacity == 0 {
+				assigned[i] = q
+				used[q.CarrierID] = true
+			}
+		}
+	}
+
+	for id := range isRequired {
+		if !used[id] {
+			return nil, ErrAllocationInfeasible
+		}
+	}
+
+	return assigned, nil
+}
+
+func cents(amount decimal.Decimal) int64 {
+	return amount.Mul(hundred).Round(0).IntPart()
+}
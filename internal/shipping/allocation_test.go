@@ -0,0 +1,144 @@
+package shipping_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+)
+
+func allocationService(orders []order.Order, carriers ...carrier.Carrier) shipping.Service {
+	orderRepo, carrierRepo, shippingRepo := loadFixture(orders, carriers...)
+	shippingRepo.InsertQuotesFunc = func(ctx context.Context, r *shipping.QuoteRequest, quotes []*shipping.Quote) error {
+		for _, q := range quotes {
+			q.ID = uuid.New()
+		}
+		return nil
+	}
+	return shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+}
+
+func weightedOrders(weights ...int64) []order.Order {
+	orders := make([]order.Order, len(weights))
+	for i, w := range weights {
+		orders[i] = order.Order{
+			ID:            uuid.New(),
+			WeightKg:      decimal.NewFromInt(w),
+			DestinationUF: states.SP,
+			Status:        order.StatusCreated,
+		}
+	}
+	return orders
+}
+
+func orderIDs(orders []order.Order) []uuid.UUID {
+	ids := make([]uuid.UUID, len(orders))
+	for i, o := range orders {
+		ids[i] = o.ID
+	}
+	return ids
+}
+
+func assignedTo(allocation *shipping.Allocation, carrierID uuid.UUID) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, a := range allocation.Assignments {
+		if a.CarrierID == carrierID {
+			ids = append(ids, a.OrderID)
+		}
+	}
+	return ids
+}
+
+func TestService_Allocate_RespectsCapacityAtLowestCost(t *testing.T) {
+	orders := weightedOrders(1, 5, 10)
+	cheap := sudesteCarrier("Cheap", "1")
+	pricey := sudesteCarrier("Pricey", "2")
+	svc := allocationService(orders, cheap, pricey)
+
+	allocation, err := svc.Allocate(context.Background(), shipping.AllocationRequest{
+		OrderIDs: orderIDs(orders),
+		Capacity: map[uuid.UUID]int{cheap.ID: 1},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{orders[2].ID}, assignedTo(allocation, cheap.ID))
+	assert.Len(t, assignedTo(allocation, pricey.ID), 2)
+	assert.True(t, allocation.TotalPrice.GreaterThan(allocation.CheapestPrice))
+	assert.Len(t, allocation.Carriers, 2)
+}
+
+func TestService_Allocate_RequiredCarrierGetsCheapestExtraOrder(t *testing.T) {
+	orders := weightedOrders(1, 5, 10)
+	cheap := sudesteCarrier("Cheap", "1")
+	pricey := sudesteCarrier("Pricey", "2")
+	svc := allocationService(orders, cheap, pricey)
+
+	allocation, err := svc.Allocate(context.Background(), shipping.AllocationRequest{
+		OrderIDs:         orderIDs(orders),
+		RequiredCarriers: []uuid.UUID{pricey.ID},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{orders[0].ID}, assignedTo(allocation, pricey.ID))
+	assert.Len(t, assignedTo(allocation, cheap.ID), 2)
+}
+
+func TestService_Allocate_MaxDaysFiltersCarriers(t *testing.T) {
+	orders := weightedOrders(1, 5)
+	cheap := sudesteCarrier("Cheap", "1")
+	cheap.Policies[0].EstimatedDays = 8
+	pricey := sudesteCarrier("Pricey", "2")
+	svc := allocationService(orders, cheap, pricey)
+
+	allocation, err := svc.Allocate(context.Background(), shipping.AllocationRequest{
+		OrderIDs: orderIDs(orders),
+		MaxDays:  5,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, assignedTo(allocation, pricey.ID), 2)
+	assert.True(t, allocation.TotalPrice.Equal(allocation.CheapestPrice))
+}
+
+func TestService_Allocate_InsufficientCapacity(t *testing.T) {
+	orders := weightedOrders(1, 5)
+	only := sudesteCarrier("Only", "1")
+	svc := allocationService(orders, only)
+
+	_, err := svc.Allocate(context.Background(), shipping.AllocationRequest{
+		OrderIDs: orderIDs(orders),
+		Capacity: map[uuid.UUID]int{only.ID: 1},
+	})
+	assert.ErrorIs(t, err, shipping.ErrAllocationInfeasible)
+}
+
+func TestService_Allocate_UnknownRequiredCarrier(t *testing.T) {
+	orders := weightedOrders(1)
+	svc := allocationService(orders, sudesteCarrier("Only", "1"))
+
+	_, err := svc.Allocate(context.Background(), shipping.AllocationRequest{
+		OrderIDs:         orderIDs(orders),
+		RequiredCarriers: []uuid.UUID{uuid.New()},
+	})
+	assert.ErrorIs(t, err, shipping.ErrAllocationInfeasible)
+}
+
+func TestService_Allocate_RejectsContractedOrder(t *testing.T) {
+	orders := weightedOrders(1, 5)
+	orders[1].Status = order.StatusAwaitingPickup
+	orderRepo, carrierRepo, shippingRepo := loadFixture(orders, sudesteCarrier("Only", "1"))
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+
+	allocation, err := svc.Allocate(context.Background(), shipping.AllocationRequest{
+		OrderIDs: orderIDs(orders),
+		Contract: true,
+	})
+	assert.ErrorIs(t, err, shipping.ErrOrderNotAllocatable)
+	assert.Nil(t, allocation)
+	assert.Empty(t, shippingRepo.InsertQuotesCalls())
+	assert.Empty(t, shippingRepo.ReplaceCalls())
+	assert.Empty(t, shippingRepo.InsertCalls())
+}
@@ -0,0 +1,52 @@
+package shipping
+
+import (
+	"context"
+	"time"
+
+	log "go.uber.org/zap"
+)
+
+const (
+	defaultBookerInterval  = 30 * time.Second
+	defaultBookerBatchSize = 20
+)
+
+type BookerConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+type Booker struct {
+	service Service
+	config  BookerConfig
+}
+
+func NewBooker(service Service, config BookerConfig) *Booker {
+	if config.Interval <= 0 {
+		config.Interval = defaultBookerInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBookerBatchSize
+	}
+
+	return &Booker{service: service, config: config}
+}
+
+func (b *Booker) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := b.service.RetryBookings(ctx, b.config.BatchSize); err != nil && ctx.Err() == nil {
+			log.L().
+				Warn("failed to retry carrier bookings", log.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package shipping
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+)
+
+type pricingSnapshot struct {
+	carriers   []carrier.Carrier
+	surcharges []carrier.FuelSurcharge
+	discounts  []carrier.Discount
+	volumes    map[uuid.UUID]int
+	pricing    *PricingEngine
+	stats      map[uuid.UUID]DeliveryStats
+	loadedAt   time.Time
+}
+
+type cacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+type ttlCache[V any] struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[string]cacheEntry[V]
+}
+
+func newTTLCache[V any](ttl time.Duration) *ttlCache[V] {
+	return &ttlCache[V]{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry[V]),
+	}
+}
+
+func (c *ttlCache[V]) get(key string, now time.Time) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache[V]) set(key string, value V, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
@@ -8,6 +8,7 @@ import (
 
 type GetQuotesInput struct {
 	OrderID string `path:"order_id" doc:"Order ID"`
+	Sort    string `query:"sort"    required:"false" doc:"Ranking strategy, defaults to the configured one" enum:"cheapest,fastest,best_value"`
 }
 
 type QuotesOutput struct {
@@ -16,10 +17,117 @@ type QuotesOutput struct {
 }
 
 type QuotesOutputBody struct {
-	CarrierID     string `json:"carrier_id"     doc:"Carrier ID"              example:"123e4567-e89b-12d3-a456-426614174000"`
-	CarrierName   string `json:"carrier_name"   doc:"Carrier name"            example:"Fast Delivery"`
-	Price         string `json:"price"          doc:"Price in BRL"            example:"10.50"`
-	EstimatedDays int    `json:"estimated_days" doc:"Estimated delivery days" example:"5"`
+	ID                      string                     `json:"id"                        doc:"Quote ID, can be used to contract at the locked price" example:"333e4567-e89b-12d3-a456-426614174000"`
+	CarrierID               string                     `json:"carrier_id"                doc:"Carrier ID"                                            example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierName             string                     `json:"carrier_name"              doc:"Carrier name"                                          example:"Fast Delivery"`
+	BasePrice               string                     `json:"base_price"                doc:"Base freight in BRL"                                   example:"10.00"`
+	FuelSurchargePercentage string                     `json:"fuel_surcharge_percentage" doc:"Fuel surcharge percentage"                             example:"5.00"`
+	FuelSurcharge           string                     `json:"fuel_surcharge"            doc:"Fuel surcharge in BRL"                                 example:"0.50"`
+	AdValorem               string                     `json:"ad_valorem"                doc:"Frete-valor in BRL"                                    example:"4.50"`
+	GRIS                    string                     `json:"gris"                      doc:"GRIS in BRL"                                           example:"3.00"`
+	Toll                    string                     `json:"toll"                      doc:"Pedágio in BRL"                                        example:"4.50"`
+	TDE                     string                     `json:"tde"                       doc:"TDE in BRL"                                            example:"0.00"`
+	Discount                string                     `json:"discount"                  doc:"Negotiated carrier discount in BRL"                    example:"0.00"`
+	ICMSRate                string                     `json:"icms_rate"                 doc:"ICMS rate for the origin and destination UFs"          example:"12.00"`
+	ICMS                    string                     `json:"icms"                      doc:"ICMS in BRL, not included in price"                    example:"1.43"`
+	TotalPrice              string                     `json:"total_price"               doc:"Net freight plus ICMS in BRL"                          example:"11.93"`
+	Price                   string                     `json:"price"                     doc:"Net freight in BRL"                                    example:"10.50"`
+	EstimatedDays           int                        `json:"estimated_days"            doc:"Estimated delivery days"                               example:"5"`
+	Reliability             string                     `json:"reliability"               doc:"Carrier delivery reliability"                          example:"0.9500"`
+	Score                   string                     `json:"score"                     doc:"Best-value score"                                      example:"0.8750"`
+	Recommended             bool                       `json:"recommended"               doc:"Whether this is the recommended quote"                 example:"true"`
+	PolicyVersion           int                        `json:"policy_version"            doc:"Version of the carrier policy used"                    example:"1"`
+	ExpiresAt               time.Time                  `json:"expires_at"                doc:"Price lock expiration"                                 example:"2025-06-28T15:34:05Z"`
+	Breakdown               []PriceLineOutputBody      `json:"breakdown"                 doc:"Price breakdown, one line per pricing rule applied"`
+	Explanation             PriceExplanationOutputBody `json:"explanation"               doc:"How the price was computed"`
+}
+
+type PriceLineOutputBody struct {
+	Rule        string `json:"rule"        doc:"Pricing rule that produced the line" example:"fuel_surcharge"`
+	Description string `json:"description" doc:"Line description"                    example:"Fuel surcharge 5.00%"`
+	Amount      string `json:"amount"      doc:"Amount added to the price in BRL"    example:"0.50"`
+}
+
+type PriceExplanationOutputBody struct {
+	Source             string  `json:"source"                      doc:"Where the price came from"                  example:"policy"                               enum:"policy,carrier_rate"`
+	PolicyID           *string `json:"policy_id,omitempty"         doc:"Carrier policy matched for the destination" example:"666e4567-e89b-12d3-a456-426614174000"`
+	PolicyVersion      int     `json:"policy_version"              doc:"Version of the matched policy"              example:"1"`
+	Region             string  `json:"region"                      doc:"Region of the matched policy"               example:"Sudeste"`
+	OriginUF           string  `json:"origin_uf"                   doc:"Origin UF"                                  example:"SP"`
+	DestinationUF      string  `json:"destination_uf"              doc:"Destination UF"                             example:"RJ"`
+	ChargeableWeightKg string  `json:"chargeable_weight_kg"        doc:"Weight the rate was applied to"             example:"7.200"`
+	RatePerKg          string  `json:"rate_per_kg"                 doc:"Rate per kg applied in BRL"                 example:"1.50"`
+	CarrierReference   string  `json:"carrier_reference,omitempty" doc:"Rate reference returned by the carrier"     example:"RT-1A2B3C"`
+	Rounding           string  `json:"rounding"                    doc:"Total rounding adjustment in BRL"           example:"0.20"`
+}
+
+type BatchQuotesInput struct {
+	Sort string `query:"sort" required:"false" doc:"Ranking strategy, defaults to the configured one" enum:"cheapest,fastest,best_value"`
+	Body BatchQuotesInputBody
+}
+
+type BatchQuotesInputBody struct {
+	OrderIDs []uuid.UUID `json:"order_ids" required:"true" minItems:"1" maxItems:"100" doc:"Order IDs to quote" example:"[\"111e4567-e89b-12d3-a456-426614174000\"]"`
+}
+
+type BatchQuotesOutput struct {
+	Status int
+	Body   []BatchQuotesOutputBody
+}
+
+type BatchQuotesOutputBody struct {
+	OrderID string             `json:"order_id"        doc:"Order ID"                             example:"111e4567-e89b-12d3-a456-426614174000"`
+	Quotes  []QuotesOutputBody `json:"quotes"          doc:"Ranked quotes for the order"`
+	Error   string             `json:"error,omitempty" doc:"Reason the order could not be quoted" example:"order not found"`
+}
+
+type SimulateQuotesInput struct {
+	Sort string `query:"sort" required:"false" doc:"Ranking strategy, defaults to the configured one" enum:"cheapest,fastest,best_value"`
+	Body SimulateQuotesInputBody
+}
+
+type SimulateQuotesInputBody struct {
+	WeightKg       float64 `json:"weight_kg"                 required:"true"  doc:"Weight in kg"                                  example:"2.5"`
+	DestinationUF  string  `json:"destination_uf,omitempty"  required:"false" doc:"Destination UF, required when no CEP is given" example:"SP"`
+	DestinationCEP string  `json:"destination_cep,omitempty" required:"false" doc:"Destination CEP, used to resolve the UF"       example:"01310-100"`
+	LengthCm       float64 `json:"length_cm,omitempty"       required:"false" doc:"Package length in cm"                          example:"40"`
+	WidthCm        float64 `json:"width_cm,omitempty"        required:"false" doc:"Package width in cm"                           example:"30"`
+	HeightCm       float64 `json:"height_cm,omitempty"       required:"false" doc:"Package height in cm"                          example:"20"`
+	DeclaredValue  float64 `json:"declared_value,omitempty"  required:"false" doc:"Declared goods value in BRL"                   example:"1500.00"`
+}
+
+type SimulateQuotesOutput struct {
+	Status int
+	Body   SimulateQuotesOutputBody
+}
+
+type SimulateQuotesOutputBody struct {
+	DestinationUF      string                     `json:"destination_uf"       doc:"Resolved destination UF"            example:"SP"`
+	ChargeableWeightKg string                     `json:"chargeable_weight_kg" doc:"Greater of actual and cubic weight" example:"7.20"`
+	Quotes             []SimulatedQuoteOutputBody `json:"quotes"               doc:"Ranked quotes"`
+}
+
+type SimulatedQuoteOutputBody struct {
+	CarrierID               string                     `json:"carrier_id"                doc:"Carrier ID"                                         example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierName             string                     `json:"carrier_name"              doc:"Carrier name"                                       example:"Fast Delivery"`
+	BasePrice               string                     `json:"base_price"                doc:"Base freight in BRL"                                example:"10.00"`
+	FuelSurchargePercentage string                     `json:"fuel_surcharge_percentage" doc:"Fuel surcharge percentage"                          example:"5.00"`
+	FuelSurcharge           string                     `json:"fuel_surcharge"            doc:"Fuel surcharge in BRL"                              example:"0.50"`
+	AdValorem               string                     `json:"ad_valorem"                doc:"Frete-valor in BRL"                                 example:"4.50"`
+	GRIS                    string                     `json:"gris"                      doc:"GRIS in BRL"                                        example:"3.00"`
+	Toll                    string                     `json:"toll"                      doc:"Pedágio in BRL"                                     example:"4.50"`
+	TDE                     string                     `json:"tde"                       doc:"TDE in BRL"                                         example:"0.00"`
+	Discount                string                     `json:"discount"                  doc:"Negotiated carrier discount in BRL"                 example:"0.00"`
+	ICMSRate                string                     `json:"icms_rate"                 doc:"ICMS rate for the origin and destination UFs"       example:"12.00"`
+	ICMS                    string                     `json:"icms"                      doc:"ICMS in BRL, not included in price"                 example:"1.43"`
+	TotalPrice              string                     `json:"total_price"               doc:"Net freight plus ICMS in BRL"                       example:"11.93"`
+	Price                   string                     `json:"price"                     doc:"Net freight in BRL"                                 example:"10.50"`
+	EstimatedDays           int                        `json:"estimated_days"            doc:"Estimated delivery days"                            example:"5"`
+	Reliability             string                     `json:"reliability"               doc:"Carrier delivery reliability"                       example:"0.9500"`
+	Score                   string                     `json:"score"                     doc:"Best-value score"                                   example:"0.8750"`
+	Recommended             bool                       `json:"recommended"               doc:"Whether this is the recommended quote"              example:"true"`
+	Breakdown               []PriceLineOutputBody      `json:"breakdown"                 doc:"Price breakdown, one line per pricing rule applied"`
+	Explanation             PriceExplanationOutputBody `json:"explanation"               doc:"How the price was computed"`
 }
 
 type ContractCarrierInput struct {
@@ -27,8 +135,10 @@ type ContractCarrierInput struct {
 }
 
 type ContractCarrierInputBody struct {
-	OrderID   uuid.UUID `json:"order_id"   required:"true" doc:"Order ID"   example:"111e4567-e89b-12d3-a456-426614174000"`
-	CarrierID uuid.UUID `json:"carrier_id" required:"true" doc:"Carrier ID" example:"222e4567-e89b-12d3-a456-426614174000"`
+	OrderID   uuid.UUID  `json:"order_id"           required:"true"  doc:"Order ID"                            example:"111e4567-e89b-12d3-a456-426614174000"`
+	CarrierID uuid.UUID  `json:"carrier_id"         required:"true"  doc:"Carrier ID"                          example:"222e4567-e89b-12d3-a456-426614174000"`
+	QuoteID   *uuid.UUID `json:"quote_id,omitempty" required:"false" doc:"Quote ID to honour its locked price" example:"333e4567-e89b-12d3-a456-426614174000"`
+	Replace   bool       `json:"replace,omitempty"  required:"false" doc:"Replace the order's active contract" example:"false"`
 }
 
 type ContractCarrierOutput struct {
@@ -37,12 +147,197 @@ type ContractCarrierOutput struct {
 }
 
 type ContractCarrierOutputBody struct {
-	ID            string    `json:"id"             doc:"Contract ID"                 example:"123e4567-e89b-12d3-a456-426614174000"`
-	OrderID       string    `json:"order_id"       doc:"Order ID"                    example:"111e4567-e89b-12d3-a456-426614174000"`
-	CarrierID     string    `json:"carrier_id"     doc:"Carrier ID"                  example:"222e4567-e89b-12d3-a456-426614174000"`
-	Price         string    `json:"price"          doc:"Total price"                 example:"25.50"`
-	EstimatedDays int       `json:"estimated_days" doc:"Delivery estimation in days" example:"4"`
-	ContractedAt  time.Time `json:"contracted_at"  doc:"Contract date"               example:"2025-06-28T15:04:05Z"`
-	CreatedAt     time.Time `json:"created_at"     doc:"Creation timestamp"          example:"2025-06-28T15:04:05Z"`
-	UpdatedAt     time.Time `json:"updated_at"     doc:"Last update timestamp"       example:"2025-06-28T15:04:05Z"`
+	ID                      string                     `json:"id"                        doc:"Contract ID"                                        example:"123e4567-e89b-12d3-a456-426614174000"`
+	OrderID                 string                     `json:"order_id"                  doc:"Order ID"                                           example:"111e4567-e89b-12d3-a456-426614174000"`
+	CarrierID               string                     `json:"carrier_id"                doc:"Carrier ID"                                         example:"222e4567-e89b-12d3-a456-426614174000"`
+	Price                   string                     `json:"price"                     doc:"Net freight in BRL"                                 example:"25.50"`
+	EstimatedDays           int                        `json:"estimated_days"            doc:"Delivery estimation in days"                        example:"4"`
+	Status                  string                     `json:"status"                    doc:"Contract status"                                    example:"active"`
+	QuoteID                 *string                    `json:"quote_id,omitempty"        doc:"Quote ID the price was locked from"                 example:"333e4567-e89b-12d3-a456-426614174000"`
+	TrackingCode            *string                    `json:"tracking_code,omitempty"   doc:"Tracking code issued by the carrier"                example:"BR123456789XX"`
+	CancelReason            *string                    `json:"cancel_reason,omitempty"   doc:"Reason given when the contract was cancelled"       example:"Customer asked to hold the shipment"`
+	CancelledAt             *time.Time                 `json:"cancelled_at,omitempty"    doc:"Cancellation date"                                  example:"2025-06-29T10:00:00Z"`
+	ReplacedBy              *string                    `json:"replaced_by,omitempty"     doc:"Contract that voided this one on re-contract"       example:"444e4567-e89b-12d3-a456-426614174000"`
+	LoadID                  *string                    `json:"load_id,omitempty"         doc:"Load the order was contracted in"                   example:"555e4567-e89b-12d3-a456-426614174000"`
+	BookingStatus           string                     `json:"booking_status"            doc:"Carrier booking status"                             example:"booked"`
+	BookingError            *string                    `json:"booking_error,omitempty"   doc:"Last carrier booking failure"                       example:"carrier responded 503: unavailable"`
+	ContractedAt            time.Time                  `json:"contracted_at"             doc:"Contract date"                                      example:"2025-06-28T15:04:05Z"`
+	CreatedAt               time.Time                  `json:"created_at"                doc:"Creation timestamp"                                 example:"2025-06-28T15:04:05Z"`
+	UpdatedAt               time.Time                  `json:"updated_at"                doc:"Last update timestamp"                              example:"2025-06-28T15:04:05Z"`
+	FuelSurchargePercentage string                     `json:"fuel_surcharge_percentage" doc:"Fuel surcharge percentage"                          example:"5.00"`
+	FuelSurcharge           string                     `json:"fuel_surcharge"            doc:"Fuel surcharge in BRL"                              example:"1.21"`
+	AdValorem               string                     `json:"ad_valorem"                doc:"Frete-valor in BRL"                                 example:"4.50"`
+	GRIS                    string                     `json:"gris"                      doc:"GRIS in BRL"                                        example:"3.00"`
+	Toll                    string                     `json:"toll"                      doc:"Pedágio in BRL"                                     example:"4.50"`
+	TDE                     string                     `json:"tde"                       doc:"TDE in BRL"                                         example:"0.00"`
+	Discount                string                     `json:"discount"                  doc:"Negotiated carrier discount in BRL"                 example:"0.00"`
+	ICMSRate                string                     `json:"icms_rate"                 doc:"ICMS rate for the origin and destination UFs"       example:"12.00"`
+	ICMS                    string                     `json:"icms"                      doc:"ICMS in BRL, not included in price"                 example:"1.43"`
+	TotalPrice              string                     `json:"total_price"               doc:"Net freight plus ICMS in BRL"                       example:"11.93"`
+	Breakdown               []PriceLineOutputBody      `json:"breakdown"                 doc:"Price breakdown, one line per pricing rule applied"`
+	Explanation             PriceExplanationOutputBody `json:"explanation"               doc:"How the price was computed"`
+}
+
+type GetContractInput struct {
+	ID uuid.UUID `path:"id" doc:"Contract ID"`
+}
+
+type ListContractsInput struct {
+	OrderID   uuid.UUID `query:"order_id"   required:"false" doc:"Filter by order ID"`
+	CarrierID uuid.UUID `query:"carrier_id" required:"false" doc:"Filter by carrier ID"`
+	Status    string    `query:"status"     required:"false" doc:"Filter by contract status"        enum:"active,cancelled,voided"`
+	From      time.Time `query:"from"       required:"false" doc:"Contracted at or after this date"`
+	To        time.Time `query:"to"         required:"false" doc:"Contracted before this date"`
+	Limit     int       `query:"limit"      required:"false" doc:"Maximum number of contracts"      default:"50"                   minimum:"1" maximum:"200"`
+	Offset    int       `query:"offset"     required:"false" doc:"Number of contracts to skip"      minimum:"0"`
+}
+
+type ListContractsOutput struct {
+	Status int
+	Body   []ContractCarrierOutputBody
+}
+
+type CancelContractInput struct {
+	ID   uuid.UUID `path:"id" doc:"Contract ID"`
+	Body CancelContractInputBody
+}
+
+type CancelContractInputBody struct {
+	Reason string `json:"reason" required:"true" minLength:"1" doc:"Why the contract is being cancelled" example:"Customer asked to hold the shipment"`
+}
+
+type CreatePricingRuleInput struct {
+	Body CreatePricingRuleInputBody
+}
+
+type CreatePricingRuleInputBody struct {
+	CarrierID *uuid.UUID `json:"carrier_id,omitempty" required:"false" doc:"Carrier ID, omit for a global rule"                             example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name      string     `json:"name,omitempty"       required:"false" doc:"Description shown on the price breakdown"                       example:"Holiday season surcharge"`
+	Kind      string     `json:"kind"                 required:"true"  doc:"Rule kind"                                                      example:"surcharge_percentage"                 enum:"surcharge_percentage,surcharge_fixed,discount_percentage,discount_fixed,minimum_price,rounding"`
+	Value     float64    `json:"value"                required:"true"  doc:"Percentage, amount in BRL, minimum price or rounding increment" example:"5"`
+	Position  int        `json:"position,omitempty"   required:"false" doc:"Order among rules of the same stage, lower runs first"          example:"1"`
+}
+
+type PricingRuleResponseOutput struct {
+	Status int
+	Body   PricingRuleResponse
+}
+
+type ListPricingRulesOutput struct {
+	Status int
+	Body   []PricingRuleResponse
+}
+
+type PricingRuleResponse struct {
+	ID        uuid.UUID  `json:"id"                   doc:"Pricing rule ID"                     example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierID *uuid.UUID `json:"carrier_id,omitempty" doc:"Carrier ID, empty for global rules"  example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name      string     `json:"name"                 doc:"Rule name"                           example:"Holiday season surcharge"`
+	Kind      string     `json:"kind"                 doc:"Rule kind"                           example:"surcharge_percentage"`
+	Value     string     `json:"value"                doc:"Rule value"                          example:"5.00"`
+	Position  int        `json:"position"             doc:"Order among rules of the same stage" example:"1"`
+	CreatedAt time.Time  `json:"created_at"           doc:"Creation date"                       example:"2025-06-28T15:04:05Z"`
+}
+
+type PlanLoadInput struct {
+	Body PlanLoadInputBody
+}
+
+type PlanLoadInputBody struct {
+	Region    string      `json:"region"               required:"true"  doc:"Destination region to consolidate"                                    example:"Sudeste"                                    enum:"Norte,Nordeste,Centro-Oeste,Sudeste,Sul"`
+	CarrierID *uuid.UUID  `json:"carrier_id,omitempty" required:"false" doc:"Carrier ID, defaults to the top ranked carrier for the load"          example:"222e4567-e89b-12d3-a456-426614174000"`
+	OrderIDs  []uuid.UUID `json:"order_ids,omitempty"  required:"false" doc:"Orders to consolidate, defaults to every created order in the region" example:"[\"111e4567-e89b-12d3-a456-426614174000\"]" maxItems:"500"`
+}
+
+type LoadInput struct {
+	ID uuid.UUID `path:"id" doc:"Load ID"`
+}
+
+type LoadOutput struct {
+	Status int
+	Body   LoadResponse
+}
+
+type LoadResponse struct {
+	ID              string                     `json:"id"                      doc:"Load ID"                                                     example:"555e4567-e89b-12d3-a456-426614174000"`
+	CarrierID       string                     `json:"carrier_id"              doc:"Carrier ID"                                                  example:"222e4567-e89b-12d3-a456-426614174000"`
+	CarrierName     string                     `json:"carrier_name"            doc:"Carrier name"                                                example:"Fast Delivery"`
+	Region          string                     `json:"region"                  doc:"Destination region"                                          example:"Sudeste"`
+	DestinationUF   string                     `json:"destination_uf"          doc:"UF receiving most of the weight, used to quote the load"     example:"SP"`
+	Status          string                     `json:"status"                  doc:"Load status"                                                 example:"planned"`
+	WeightKg        string                     `json:"weight_kg"               doc:"Combined weight in kg"                                       example:"42.50"`
+	DeclaredValue   string                     `json:"declared_value"          doc:"Combined declared value in BRL"                              example:"3500.00"`
+	EstimatedDays   int                        `json:"estimated_days"          doc:"Estimated delivery days"                                     example:"4"`
+	Price           string                     `json:"price"                   doc:"Net freight of the load in BRL"                              example:"180.40"`
+	ICMS            string                     `json:"icms"                    doc:"ICMS of the load orders in BRL"                              example:"12.63"`
+	TotalPrice      string                     `json:"total_price"             doc:"Net freight plus ICMS in BRL"                                example:"193.03"`
+	IndividualPrice string                     `json:"individual_price"        doc:"Total of contracting each order on its own with the carrier" example:"260.10"`
+	Savings         string                     `json:"savings"                 doc:"Individual price minus the load total price"                 example:"67.07"`
+	Breakdown       []PriceLineOutputBody      `json:"breakdown"               doc:"Price breakdown of the load"`
+	Explanation     PriceExplanationOutputBody `json:"explanation"             doc:"How the load price was computed"`
+	Orders          []LoadOrderResponse        `json:"orders"                  doc:"Orders in the load with their share of the cost"`
+	ExpiresAt       time.Time                  `json:"expires_at"              doc:"Price lock expiration"                                       example:"2025-06-28T15:34:05Z"`
+	ContractedAt    *time.Time                 `json:"contracted_at,omitempty" doc:"Contract date"                                               example:"2025-06-28T15:10:00Z"`
+	CancelledAt     *time.Time                 `json:"cancelled_at,omitempty"  doc:"Cancellation date"                                           example:"2025-06-28T15:10:00Z"`
+	CreatedAt       time.Time                  `json:"created_at"              doc:"Creation date"                                               example:"2025-06-28T15:04:05Z"`
+	UpdatedAt       time.Time                  `json:"updated_at"              doc:"Last update date"                                            example:"2025-06-28T15:04:05Z"`
+}
+
+type LoadOrderResponse struct {
+	OrderID       string                     `json:"order_id"              doc:"Order ID"                             example:"111e4567-e89b-12d3-a456-426614174000"`
+	DestinationUF string                     `json:"destination_uf"        doc:"Order destination UF"                 example:"RJ"`
+	WeightKg      string                     `json:"weight_kg"             doc:"Order weight in kg"                   example:"12.00"`
+	Price         string                     `json:"price"                 doc:"Share of the load net freight in BRL" example:"50.94"`
+	ICMSRate      string                     `json:"icms_rate"             doc:"ICMS rate for the order destination"  example:"12.00"`
+	ICMS          string                     `json:"icms"                  doc:"ICMS in BRL, not included in price"   example:"6.11"`
+	TotalPrice    string                     `json:"total_price"           doc:"Net freight share plus ICMS in BRL"   example:"57.05"`
+	ContractID    *string                    `json:"contract_id,omitempty" doc:"Contract created for the order"       example:"123e4567-e89b-12d3-a456-426614174000"`
+	Breakdown     []PriceLineOutputBody      `json:"breakdown"             doc:"Share of each price line"`
+	Explanation   PriceExplanationOutputBody `json:"explanation"           doc:"How the order share was computed"`
+}
+
+type AllocateInput struct {
+	Body AllocateInputBody
+}
+
+type AllocateInputBody struct {
+	OrderIDs           []uuid.UUID                `json:"order_ids"                      required:"true"  doc:"Orders to allocate"                                                      example:"[\"111e4567-e89b-12d3-a456-426614174000\"]" minItems:"1" maxItems:"500"`
+	Capacities         []CarrierCapacityInputBody `json:"capacities,omitempty"           required:"false" doc:"Maximum number of orders per carrier, carriers not listed are unlimited"`
+	MaxDays            int                        `json:"max_days,omitempty"             required:"false" doc:"Only carriers delivering within this many days"                          example:"5"                                          minimum:"0"`
+	RequiredCarrierIDs []uuid.UUID                `json:"required_carrier_ids,omitempty" required:"false" doc:"Carriers that must receive at least one order"                           example:"[\"222e4567-e89b-12d3-a456-426614174000\"]"`
+	Contract           bool                       `json:"contract,omitempty"             required:"false" doc:"Contract every assignment at its quoted price"                           example:"false"`
+}
+
+type CarrierCapacityInputBody struct {
+	CarrierID uuid.UUID `json:"carrier_id" required:"true" doc:"Carrier ID"                               example:"222e4567-e89b-12d3-a456-426614174000"`
+	MaxOrders int       `json:"max_orders" required:"true" doc:"Maximum number of orders for the carrier" example:"20"                                   minimum:"0"`
+}
+
+type AllocationOutput struct {
+	Status int
+	Body   AllocationResponse
+}
+
+type AllocationResponse struct {
+	Assignments   []AssignmentResponse  `json:"assignments"    doc:"Carrier assigned to each order"`
+	Carriers      []CarrierLoadResponse `json:"carriers"       doc:"Orders and cost per carrier"`
+	TotalPrice    string                `json:"total_price"    doc:"Total price of the allocation in BRL"                                              example:"1520.40"`
+	CheapestPrice string                `json:"cheapest_price" doc:"Total of the cheapest carrier per order ignoring capacities and required carriers" example:"1490.10"`
+}
+
+type AssignmentResponse struct {
+	OrderID       string  `json:"order_id"              doc:"Order ID"                                      example:"111e4567-e89b-12d3-a456-426614174000"`
+	CarrierID     string  `json:"carrier_id"            doc:"Carrier ID"                                    example:"222e4567-e89b-12d3-a456-426614174000"`
+	CarrierName   string  `json:"carrier_name"          doc:"Carrier name"                                  example:"Fast Delivery"`
+	QuoteID       string  `json:"quote_id"              doc:"Quote locking the price of the assignment"     example:"333e4567-e89b-12d3-a456-426614174000"`
+	TotalPrice    string  `json:"total_price"           doc:"Net freight plus ICMS in BRL"                  example:"25.80"`
+	EstimatedDays int     `json:"estimated_days"        doc:"Estimated delivery days"                       example:"3"`
+	ContractID    *string `json:"contract_id,omitempty" doc:"Contract created for the assignment"           example:"123e4567-e89b-12d3-a456-426614174000"`
+	Error         string  `json:"error,omitempty"       doc:"Reason the assignment could not be contracted" example:"invalid status transition"`
+}
+
+type CarrierLoadResponse struct {
+	CarrierID   string `json:"carrier_id"   doc:"Carrier ID"                               example:"222e4567-e89b-12d3-a456-426614174000"`
+	CarrierName string `json:"carrier_name" doc:"Carrier name"                             example:"Fast Delivery"`
+	Orders      int    `json:"orders"       doc:"Orders assigned to the carrier"           example:"12"`
+	TotalPrice  string `json:"total_price"  doc:"Total price of the carrier orders in BRL" example:"310.20"`
 }
@@ -0,0 +1,255 @@
+package shipping
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	"github.com/victorvcruz/shipment-coordinator/pkg/tax"
+)
+
+const minLoadOrders = 2
+
+var freightComponents = map[string]bool{
+	PriceLineBaseFreight:   true,
+	PriceLineFuelSurcharge: true,
+	PriceLineAdValorem:     true,
+	PriceLineGRIS:          true,
+	PriceLineToll:          true,
+}
+
+type LoadStatus string
+
+const (
+	LoadStatusPlanned    LoadStatus = "planned"
+	LoadStatusContracted LoadStatus = "contracted"
+	LoadStatusCancelled  LoadStatus = "cancelled"
+)
+
+type LoadRequest struct {
+	Region    string
+	CarrierID *uuid.UUID
+	OrderIDs  []uuid.UUID
+}
+
+type Load struct {
+	ID                      uuid.UUID        `json:"id"`
+	CarrierID               uuid.UUID        `json:"carrier_id"`
+	CarrierName             string           `json:"carrier_name"`
+	Region                  string           `json:"region"`
+	DestinationUF           states.State     `json:"destination_uf"`
+	Status                  LoadStatus       `json:"status"`
+	WeightKg                decimal.Decimal  `json:"weight_kg"`
+	DeclaredValue           decimal.Decimal  `json:"declared_value"`
+	EstimatedDays           int              `json:"estimated_days"`
+	BasePrice               decimal.Decimal  `json:"base_price"`
+	FuelSurchargePercentage decimal.Decimal  `json:"fuel_surcharge_percentage"`
+	FuelSurcharge           decimal.Decimal  `json:"fuel_surcharge"`
+	AdValorem               decimal.Decimal  `json:"ad_valorem"`
+	GRIS                    decimal.Decimal  `json:"gris"`
+	Toll                    decimal.Decimal  `json:"toll"`
+	TDE                     decimal.Decimal  `json:"tde"`
+	DiscountID              *uuid.UUID       `json:"discount_id"`
+	Discount                decimal.Decimal  `json:"discount"`
+	Price                   decimal.Decimal  `json:"price"`
+	ICMS                    decimal.Decimal  `json:"icms"`
+	Breakdown               []PriceLine      `json:"breakdown"`
+	Explanation             PriceExplanation `json:"explanation"`
+	IndividualPrice         decimal.Decimal  `json:"individual_price"`
+	Orders                  []LoadOrder      `json:"orders"`
+	ExpiresAt               time.Time        `json:"expires_at"`
+	ContractedAt            *time.Time       `json:"contracted_at"`
+	CancelledAt             *time.Time       `json:"cancelled_at"`
+	CreatedAt               time.Time        `json:"created_at"`
+	UpdatedAt               time.Time        `json:"updated_at"`
+}
+
+type LoadOrder struct {
+	OrderID       uuid.UUID        `json:"order_id"`
+	DestinationUF states.State     `json:"destination_uf"`
+	WeightKg      decimal.Decimal  `json:"weight_kg"`
+	DeclaredValue decimal.Decimal  `json:"declared_value"`
+	TDE           decimal.Decimal  `json:"tde"`
+	ContractID    *uuid.UUID       `json:"contract_id"`
+	Share         PriceCalculation `json:"-"`
+}
+
+func (l Load) TotalPrice() decimal.Decimal {
+	return l.Price.Add(l.ICMS)
+}
+
+func (l Load) Savings() decimal.Decimal {
+	return l.IndividualPrice.Sub(l.TotalPrice())
+}
+
+func (l *Load) applyPrice(calc PriceCalculation) {
+	l.BasePrice = calc.BasePrice
+	l.FuelSurchargePercentage = calc.FuelSurchargePercentage
+	l.FuelSurcharge = calc.FuelSurcharge
+	l.AdValorem = calc.AdValorem
+	l.GRIS = calc.GRIS
+	l.Toll = calc.Toll
+	l.TDE = calc.TDE
+	l.DiscountID = calc.DiscountID
+	l.Discount = calc.Discount
+	l.Price = calc.Price
+	l.Breakdown = calc.Breakdown
+	l.Explanation = calc.Explanation
+}
+
+func (l Load) priceCalculation() PriceCalculation {
+	return PriceCalculation{
+		BasePrice:               l.BasePrice,
+		FuelSurchargePercentage: l.FuelSurchargePercentage,
+		FuelSurcharge:           l.FuelSurcharge,
+		AdValorem:               l.AdValorem,
+		GRIS:                    l.GRIS,
+		Toll:                    l.Toll,
+		TDE:                     l.TDE,
+		DiscountID:              l.DiscountID,
+		Discount:                l.Discount,
+		Price:                   l.Price,
+		Breakdown:               l.Breakdown,
+		Explanation:             l.Explanation,
+	}
+}
+
+func (l *Load) applyOrderTDE(policy carrier.Policy) {
+	total := decimal.Zero
+	for i, o := range l.Orders {
+		l.Orders[i].TDE = decimal.Zero
+		if policy.AppliesTDE(o.DestinationUF) {
+			l.Orders[i].TDE = percentageOf(policy.PricePerKg.Mul(o.WeightKg), policy.TDEPercentage)
+		}
+		total = total.Add(l.Orders[i].TDE)
+	}
+
+	l.Price = l.Price.Sub(l.TDE).Add(total)
+	l.TDE = total
+
+	breakdown := make([]PriceLine, 0, len(l.Breakdown)+1)
+	for _, line := range l.Breakdown {
+		if line.Rule != PriceLineTDE {
+			breakdown = append(breakdown, line)
+		}
+	}
+	if !total.IsZero() {
+		at := 0
+		for at < len(breakdown) && freightComponents[breakdown[at].Rule] {
+			at++
+		}
+		breakdown = slices.Insert(breakdown, at, PriceLine{Rule: PriceLineTDE, Description: "TDE", Amount: total})
+	}
+	l.Breakdown = breakdown
+}
+
+func (l *Load) split(origin states.State) {
+	weights := make([]decimal.Decimal, len(l.Orders))
+	orderTDE := decimal.Zero
+	for i, o := range l.Orders {
+		weights[i] = o.WeightKg
+		orderTDE = orderTDE.Add(o.TDE)
+	}
+
+	l.ICMS = decimal.Zero
+	for i, share := range splitPrice(l.priceCalculation(), weights) {
+		if orderTDE.Equal(l.TDE) {
+			share.assignTDE(l.Orders[i].TDE, l.Orders[i].DestinationUF)
+		}
+
+		icms := tax.Route{Origin: origin, Destination: l.Orders[i].DestinationUF}.ICMS(share.Price)
+		share.ICMSRate = icms.Rate
+		share.ICMS = icms.Amount
+
+		l.Orders[i].Share = share
+		l.ICMS = l.ICMS.Add(share.ICMS)
+	}
+}
+
+func (c *PriceCalculation) assignTDE(amount decimal.Decimal, destination states.State) {
+	c.Price = c.Price.Sub(c.TDE).Add(amount)
+	c.TDE = amount
+	for i, line := range c.Breakdown {
+		if line.Rule == PriceLineTDE {
+			c.Breakdown[i].Description = "TDE " + destination.Sigla
+			c.Breakdown[i].Amount = amount
+		}
+	}
+}
+
+func splitPrice(calc PriceCalculation, weights []decimal.Decimal) []PriceCalculation {
+	shares := make([]PriceCalculation, len(weights))
+	if len(weights) == 0 {
+		return shares
+	}
+
+	components := []decimal.Decimal{
+		calc.BasePrice,
+		calc.FuelSurcharge,
+		calc.AdValorem,
+		calc.GRIS,
+		calc.Toll,
+		calc.TDE,
+		calc.Discount,
+	}
+	parts := make([][]decimal.Decimal, len(components))
+	for i, amount := range components {
+		parts[i] = proportional(amount, weights)
+	}
+
+	lines := make([][]decimal.Decimal, len(calc.Breakdown))
+	for i, line := range calc.Breakdown {
+		lines[i] = proportional(line.Amount, weights)
+	}
+
+	for i := range shares {
+		share := PriceCalculation{
+			BasePrice:               parts[0][i],
+			FuelSurchargePercentage: calc.FuelSurchargePercentage,
+			FuelSurcharge:           parts[1][i],
+			AdValorem:               parts[2][i],
+			GRIS:                    parts[3][i],
+			Toll:                    parts[4][i],
+			TDE:                     parts[5][i],
+			DiscountID:              calc.DiscountID,
+			Discount:                parts[6][i],
+			Price:                   decimal.Zero,
+			Breakdown:               make([]PriceLine, len(calc.Breakdown)),
+		}
+		for j, line := range calc.Breakdown {
+			line.Amount = lines[j][i]
+			share.Breakdown[j] = line
+			share.Price = share.Price.Add(line.Amount)
+		}
+		share.Explanation = calc.Explanation
+		share.Explanation.ChargeableWeightKg = weights[i]
+		share.Explanation.Rounding = share.rounding()
+		shares[i] = share
+	}
+
+	return shares
+}
+
+func proportional(amount decimal.Decimal, weights []decimal.Decimal) []decimal.Decimal {
+	total := decimal.Zero
+	for _, w := range weights {
+		total = total.Add(w)
+	}
+
+	parts := make([]decimal.Decimal, len(weights))
+	remaining := amount
+	for i, w := range weights {
+		if i == len(weights)-1 || total.IsZero() {
+			parts[i] = remaining
+			remaining = decimal.Zero
+			continue
+		}
+		parts[i] = amount.Mul(w).Div(total).Round(2)
+		remaining = remaining.Sub(parts[i])
+	}
+
+	return parts
+}
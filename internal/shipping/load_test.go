@@ -0,0 +1,247 @@
+package shipping_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	carriermock "github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping/mocks"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+)
+
+func loadFixture(orders []order.Order, carriers ...carrier.Carrier) (
+	*ordermock.RepositoryMock,
+	*carriermock.RepositoryMock,
+	*mocks.RepositoryMock,
+) {
+	orderRepo := &ordermock.RepositoryMock{
+		ListByStatusFunc: func(ctx context.Context, status order.Status, ufs []string) ([]order.Order, error) {
+			return orders, nil
+		},
+		ListByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]order.Order, error) {
+			return orders, nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
+			return carriers, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return []shipping.PricingRule{
+				{Name: "Handling", Kind: shipping.PricingRuleSurchargeFixed, Value: decimal.RequireFromString("0.10")},
+			}, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		ListDeliveryStatsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+			return map[uuid.UUID]shipping.DeliveryStats{}, nil
+		},
+		ListLoadedOrderIDsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+			return map[uuid.UUID]bool{}, nil
+		},
+		InsertLoadFunc: func(ctx context.Context, l *shipping.Load) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+	}
+	return orderRepo, carrierRepo, shippingRepo
+}
+
+func sudesteCarrier(name string, pricePerKg string) carrier.Carrier {
+	return carrier.Carrier{
+		ID:   uuid.New(),
+		Name: name,
+		Policies: []carrier.Policy{{
+			ID:            uuid.New(),
+			Region:        states.Sudeste,
+			EstimatedDays: 3,
+			PricePerKg:    decimal.RequireFromString(pricePerKg),
+		}},
+	}
+}
+
+func createdOrders(ufs ...states.State) []order.Order {
+	orders := make([]order.Order, len(ufs))
+	for i, uf := range ufs {
+		orders[i] = order.Order{
+			ID:            uuid.New(),
+			WeightKg:      decimal.NewFromInt(1),
+			DestinationUF: uf,
+			DeclaredValue: decimal.Zero,
+			Status:        order.StatusCreated,
+		}
+	}
+	return orders
+}
+
+func TestService_PlanLoad_SplitsCostByWeight(t *testing.T) {
+	orders := createdOrders(states.SP, states.RJ, states.RJ)
+	cheap := sudesteCarrier("Cheap", "3.34")
+	orderRepo, carrierRepo, shippingRepo := loadFixture(orders, cheap, sudesteCarrier("Pricey", "9"))
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{Origin: states.SP})
+	load, err := svc.PlanLoad(context.Background(), shipping.LoadRequest{Region: "Sudeste"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, cheap.ID, load.CarrierID)
+	assert.Equal(t, shipping.LoadStatusPlanned, load.Status)
+	assert.Equal(t, states.RJ, load.DestinationUF)
+	assert.True(t, decimal.NewFromInt(3).Equal(load.WeightKg))
+	assert.Equal(t, "10.12", load.Price.StringFixed(2))
+	assert.Len(t, load.Orders, 3)
+
+	price, icms := decimal.Zero, decimal.Zero
+	for _, o := range load.Orders {
+		price = price.Add(o.Share.Price)
+		icms = icms.Add(o.Share.ICMS)
+	}
+	assert.Equal(t, "3.37", load.Orders[0].Share.Price.StringFixed(2))
+	assert.Equal(t, "3.38", load.Orders[2].Share.Price.StringFixed(2))
+	assert.True(t, load.Price.Equal(price))
+	assert.True(t, load.ICMS.Equal(icms))
+	assert.True(t, load.Savings().IsPositive())
+	assert.Len(t, shippingRepo.InsertLoadCalls(), 1)
+}
+
+func TestService_PlanLoad_SkipsOrdersAlreadyLoaded(t *testing.T) {
+	orders := createdOrders(states.SP, states.RJ)
+	orderRepo, carrierRepo, shippingRepo := loadFixture(orders, sudesteCarrier("Cheap", "3"))
+	shippingRepo.ListLoadedOrderIDsFunc = func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+		return map[uuid.UUID]bool{orders[0].ID: true}, nil
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	_, err := svc.PlanLoad(context.Background(), shipping.LoadRequest{Region: "Sudeste"})
+	assert.ErrorIs(t, err, shipping.ErrLoadTooSmall)
+	assert.Empty(t, shippingRepo.InsertLoadCalls())
+}
+
+func TestService_PlanLoad_RejectsOrderOutsideRegion(t *testing.T) {
+	orders := createdOrders(states.SP, states.BA)
+	orderRepo, carrierRepo, shippingRepo := loadFixture(orders, sudesteCarrier("Cheap", "3"))
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	_, err := svc.PlanLoad(context.Background(), shipping.LoadRequest{
+		Region:   "Sudeste",
+		OrderIDs: []uuid.UUID{orders[0].ID, orders[1].ID},
+	})
+	assert.ErrorIs(t, err, shipping.ErrLoadRegionMismatch)
+}
+
+func TestService_PlanLoad_UnknownCarrier(t *testing.T) {
+	orders := createdOrders(states.SP, states.RJ)
+	orderRepo, carrierRepo, shippingRepo := loadFixture(orders, sudesteCarrier("Cheap", "3"))
+
+	carrierID := uuid.New()
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	_, err := svc.PlanLoad(context.Background(), shipping.LoadRequest{Region: "Sudeste", CarrierID: &carrierID})
+	assert.ErrorIs(t, err, shipping.ErrNoValidPolicy)
+}
+
+func TestService_ContractLoad_ContractsEveryOrderAtItsShare(t *testing.T) {
+	loadID := uuid.New()
+	load := &shipping.Load{
+		ID:            loadID,
+		CarrierID:     uuid.New(),
+		Status:        shipping.LoadStatusPlanned,
+		EstimatedDays: 4,
+		BasePrice:     decimal.RequireFromString("10.00"),
+		Price:         decimal.RequireFromString("10.00"),
+		Breakdown: []shipping.PriceLine{
+			{Rule: shipping.PriceLineBaseFreight, Amount: decimal.RequireFromString("10.00")},
+		},
+		Orders: []shipping.LoadOrder{
+			{OrderID: uuid.New(), DestinationUF: states.SP, WeightKg: decimal.NewFromInt(1)},
+			{OrderID: uuid.New(), DestinationUF: states.RJ, WeightKg: decimal.NewFromInt(3)},
+		},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	shippingRepo := &mocks.RepositoryMock{
+		GetLoadByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+			return load, nil
+		},
+		ContractLoadFunc: func(ctx context.Context, id uuid.UUID, contracts []*shipping.Contract, at time.Time) error {
+			for _, c := range contracts {
+				c.ID = uuid.New()
+			}
+			return nil
+		},
+	}
+
+	svc := shipping.NewService(nil, nil, shippingRepo, shipping.Config{Origin: states.SP})
+	contracted, err := svc.ContractLoad(context.Background(), loadID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, shipping.LoadStatusContracted, contracted.Status)
+	assert.Len(t, shippingRepo.ContractLoadCalls(), 1)
+	contracts := shippingRepo.ContractLoadCalls()[0].Contracts
+	assert.Len(t, contracts, 2)
+	assert.Equal(t, "2.50", contracts[0].Price.StringFixed(2))
+	assert.Equal(t, "7.50", contracts[1].Price.StringFixed(2))
+	assert.Equal(t, &loadID, contracts[1].LoadID)
+	assert.Equal(t, 4, contracts[1].EstimatedDays)
+	for i, o := range contracted.Orders {
+		assert.Equal(t, &contracts[i].ID, o.ContractID)
+	}
+}
+
+func TestService_ContractLoad_Expired(t *testing.T) {
+	shippingRepo := &mocks.RepositoryMock{
+		GetLoadByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+			return &shipping.Load{ID: id, Status: shipping.LoadStatusPlanned, ExpiresAt: time.Now().Add(-time.Minute)}, nil
+		},
+	}
+
+	svc := shipping.NewService(nil, nil, shippingRepo, shipping.Config{})
+	_, err := svc.ContractLoad(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, shipping.ErrLoadExpired)
+	assert.Empty(t, shippingRepo.ContractLoadCalls())
+}
+
+func TestService_PlanLoad_ChargesTDEPerOrderDestination(t *testing.T) {
+	for _, ufs := range [][]states.State{
+		{states.RJ, states.RJ, states.SP},
+		{states.SP, states.SP, states.RJ},
+	} {
+		orders := createdOrders(ufs...)
+		tde := sudesteCarrier("TDE", "10")
+		tde.Policies[0].TDEPercentage = decimal.NewFromInt(10)
+		tde.Policies[0].TDEUFs = []string{"SP"}
+		orderRepo, carrierRepo, shippingRepo := loadFixture(orders, tde)
+
+		svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{Origin: states.SP})
+		load, err := svc.PlanLoad(context.Background(), shipping.LoadRequest{Region: "Sudeste"})
+		assert.NoError(t, err)
+
+		total, price := decimal.Zero, decimal.Zero
+		for _, o := range load.Orders {
+			expected := "0.00"
+			if o.DestinationUF == states.SP {
+				expected = "1.00"
+			}
+			assert.Equal(t, expected, o.TDE.StringFixed(2))
+			assert.Equal(t, expected, o.Share.TDE.StringFixed(2))
+			total = total.Add(o.Share.TDE)
+			price = price.Add(o.Share.Price)
+		}
+		assert.True(t, load.TDE.Equal(total))
+		assert.True(t, load.Price.Equal(price))
+	}
+}
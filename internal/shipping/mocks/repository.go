@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
 	"sync"
+	"time"
 )
 
 // Ensure, that RepositoryMock does implement shipping.Repository.
@@ -20,9 +21,69 @@ var _ shipping.Repository = &RepositoryMock{}
 //
 //		// make and configure a mocked shipping.Repository
 //		mockedRepository := &RepositoryMock{
+//			CancelContractFunc: func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+//				panic("mock out the CancelContract method")
+//			},
+//			CancelLoadFunc: func(ctx context.Context, id uuid.UUID, at time.Time) error {
+//				panic("mock out the CancelLoad method")
+//			},
+//			ClaimPendingBookingsFunc: func(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]shipping.Contract, error) {
+//				panic("mock out the ClaimPendingBookings method")
+//			},
+//			ContractLoadFunc: func(ctx context.Context, id uuid.UUID, contracts []*shipping.Contract, at time.Time) error {
+//				panic("mock out the ContractLoad method")
+//			},
+//			CountContractsSinceFunc: func(ctx context.Context, carrierIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+//				panic("mock out the CountContractsSince method")
+//			},
+//			GetActiveContractByOrderFunc: func(ctx context.Context, orderID uuid.UUID) (*shipping.Contract, error) {
+//				panic("mock out the GetActiveContractByOrder method")
+//			},
+//			GetContractByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+//				panic("mock out the GetContractByID method")
+//			},
+//			GetContractByTrackingCodeFunc: func(ctx context.Context, carrierID uuid.UUID, code string) (*shipping.Contract, error) {
+//				panic("mock out the GetContractByTrackingCode method")
+//			},
+//			GetLoadByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+//				panic("mock out the GetLoadByID method")
+//			},
+//			GetQuoteByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Quote, error) {
+//				panic("mock out the GetQuoteByID method")
+//			},
 //			InsertFunc: func(ctx context.Context, c *shipping.Contract) (uuid.UUID, error) {
 //				panic("mock out the Insert method")
 //			},
+//			InsertLoadFunc: func(ctx context.Context, l *shipping.Load) (uuid.UUID, error) {
+//				panic("mock out the InsertLoad method")
+//			},
+//			InsertPricingRuleFunc: func(ctx context.Context, rule *shipping.PricingRule) (uuid.UUID, error) {
+//				panic("mock out the InsertPricingRule method")
+//			},
+//			InsertQuotesFunc: func(ctx context.Context, request *shipping.QuoteRequest, quotes []*shipping.Quote) error {
+//				panic("mock out the InsertQuotes method")
+//			},
+//			ListContractsFunc: func(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error) {
+//				panic("mock out the ListContracts method")
+//			},
+//			ListDeliveryStatsFunc: func(ctx context.Context, carrierIDs []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+//				panic("mock out the ListDeliveryStats method")
+//			},
+//			ListLoadedOrderIDsFunc: func(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+//				panic("mock out the ListLoadedOrderIDs method")
+//			},
+//			ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+//				panic("mock out the ListPricingRules method")
+//			},
+//			RecordBookingFailureFunc: func(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time, at time.Time) (int, error) {
+//				panic("mock out the RecordBookingFailure method")
+//			},
+//			ReplaceFunc: func(ctx context.Context, previousID uuid.UUID, c *shipping.Contract) (uuid.UUID, error) {
+//				panic("mock out the Replace method")
+//			},
+//			SetTrackingCodeFunc: func(ctx context.Context, id uuid.UUID, code string, at time.Time) error {
+//				panic("mock out the SetTrackingCode method")
+//			},
 //		}
 //
 //		// use mockedRepository in code that requires shipping.Repository
@@ -30,11 +91,159 @@ var _ shipping.Repository = &RepositoryMock{}
 //
 //	}
 type RepositoryMock struct {
+	// CancelContractFunc mocks the CancelContract method.
+	CancelContractFunc func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error
+
+	// CancelLoadFunc mocks the CancelLoad method.
+	CancelLoadFunc func(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// ClaimPendingBookingsFunc mocks the ClaimPendingBookings method.
+	ClaimPendingBookingsFunc func(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]shipping.Contract, error)
+
+	// ContractLoadFunc mocks the ContractLoad method.
+	ContractLoadFunc func(ctx context.Context, id uuid.UUID, contracts []*shipping.Contract, at time.Time) error
+
+	// CountContractsSinceFunc mocks the CountContractsSince method.
+	CountContractsSinceFunc func(ctx context.Context, carrierIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int, error)
+
+	// GetActiveContractByOrderFunc mocks the GetActiveContractByOrder method.
+	GetActiveContractByOrderFunc func(ctx context.Context, orderID uuid.UUID) (*shipping.Contract, error)
+
+	// GetContractByIDFunc mocks the GetContractByID method.
+	GetContractByIDFunc func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error)
+
+	// GetContractByTrackingCodeFunc mocks the GetContractByTrackingCode method.
+	GetContractByTrackingCodeFunc func(ctx context.Context, carrierID uuid.UUID, code string) (*shipping.Contract, error)
+
+	// GetLoadByIDFunc mocks the GetLoadByID method.
+	GetLoadByIDFunc func(ctx context.Context, id uuid.UUID) (*shipping.Load, error)
+
+	// GetQuoteByIDFunc mocks the GetQuoteByID method.
+	GetQuoteByIDFunc func(ctx context.Context, id uuid.UUID) (*shipping.Quote, error)
+
 	// InsertFunc mocks the Insert method.
 	InsertFunc func(ctx context.Context, c *shipping.Contract) (uuid.UUID, error)
 
+	// InsertLoadFunc mocks the InsertLoad method.
+	InsertLoadFunc func(ctx context.Context, l *shipping.Load) (uuid.UUID, error)
+
+	// InsertPricingRuleFunc mocks the InsertPricingRule method.
+	InsertPricingRuleFunc func(ctx context.Context, rule *shipping.PricingRule) (uuid.UUID, error)
+
+	// InsertQuotesFunc mocks the InsertQuotes method.
+	InsertQuotesFunc func(ctx context.Context, request *shipping.QuoteRequest, quotes []*shipping.Quote) error
+
+	// ListContractsFunc mocks the ListContracts method.
+	ListContractsFunc func(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error)
+
+	// ListDeliveryStatsFunc mocks the ListDeliveryStats method.
+	ListDeliveryStatsFunc func(ctx context.Context, carrierIDs []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error)
+
+	// ListLoadedOrderIDsFunc mocks the ListLoadedOrderIDs method.
+	ListLoadedOrderIDsFunc func(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+
+	// ListPricingRulesFunc mocks the ListPricingRules method.
+	ListPricingRulesFunc func(ctx context.Context) ([]shipping.PricingRule, error)
+
+	// RecordBookingFailureFunc mocks the RecordBookingFailure method.
+	RecordBookingFailureFunc func(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time, at time.Time) (int, error)
+
+	// ReplaceFunc mocks the Replace method.
+	ReplaceFunc func(ctx context.Context, previousID uuid.UUID, c *shipping.Contract) (uuid.UUID, error)
+
+	// SetTrackingCodeFunc mocks the SetTrackingCode method.
+	SetTrackingCodeFunc func(ctx context.Context, id uuid.UUID, code string, at time.Time) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// CancelContract holds details about calls to the CancelContract method.
+		CancelContract []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Reason is the reason argument value.
+			Reason string
+			// At is the at argument value.
+			At time.Time
+		}
+		// CancelLoad holds details about calls to the CancelLoad method.
+		CancelLoad []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// At is the at argument value.
+			At time.Time
+		}
+		// ClaimPendingBookings holds details about calls to the ClaimPendingBookings method.
+		ClaimPendingBookings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+			// Limit is the limit argument value.
+			Limit int
+			// Lease is the lease argument value.
+			Lease time.Duration
+		}
+		// ContractLoad holds details about calls to the ContractLoad method.
+		ContractLoad []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Contracts is the contracts argument value.
+			Contracts []*shipping.Contract
+			// At is the at argument value.
+			At time.Time
+		}
+		// CountContractsSince holds details about calls to the CountContractsSince method.
+		CountContractsSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierIDs is the carrierIDs argument value.
+			CarrierIDs []uuid.UUID
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// GetActiveContractByOrder holds details about calls to the GetActiveContractByOrder method.
+		GetActiveContractByOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID uuid.UUID
+		}
+		// GetContractByID holds details about calls to the GetContractByID method.
+		GetContractByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// GetContractByTrackingCode holds details about calls to the GetContractByTrackingCode method.
+		GetContractByTrackingCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierID is the carrierID argument value.
+			CarrierID uuid.UUID
+			// Code is the code argument value.
+			Code string
+		}
+		// GetLoadByID holds details about calls to the GetLoadByID method.
+		GetLoadByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// GetQuoteByID holds details about calls to the GetQuoteByID method.
+		GetQuoteByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// Insert holds details about calls to the Insert method.
 		Insert []struct {
 			// Ctx is the ctx argument value.
@@ -42,8 +251,506 @@ type RepositoryMock struct {
 			// C is the c argument value.
 			C *shipping.Contract
 		}
+		// InsertLoad holds details about calls to the InsertLoad method.
+		InsertLoad []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// L is the l argument value.
+			L *shipping.Load
+		}
+		// InsertPricingRule holds details about calls to the InsertPricingRule method.
+		InsertPricingRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rule is the rule argument value.
+			Rule *shipping.PricingRule
+		}
+		// InsertQuotes holds details about calls to the InsertQuotes method.
+		InsertQuotes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request *shipping.QuoteRequest
+			// Quotes is the quotes argument value.
+			Quotes []*shipping.Quote
+		}
+		// ListContracts holds details about calls to the ListContracts method.
+		ListContracts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter shipping.ContractFilter
+		}
+		// ListDeliveryStats holds details about calls to the ListDeliveryStats method.
+		ListDeliveryStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CarrierIDs is the carrierIDs argument value.
+			CarrierIDs []uuid.UUID
+		}
+		// ListLoadedOrderIDs holds details about calls to the ListLoadedOrderIDs method.
+		ListLoadedOrderIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderIDs is the orderIDs argument value.
+			OrderIDs []uuid.UUID
+		}
+		// ListPricingRules holds details about calls to the ListPricingRules method.
+		ListPricingRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// RecordBookingFailure holds details about calls to the RecordBookingFailure method.
+		RecordBookingFailure []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Reason is the reason argument value.
+			Reason string
+			// RetryAt is the retryAt argument value.
+			RetryAt *time.Time
+			// At is the at argument value.
+			At time.Time
+		}
+		// Replace holds details about calls to the Replace method.
+		Replace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PreviousID is the previousID argument value.
+			PreviousID uuid.UUID
+			// C is the c argument value.
+			C *shipping.Contract
+		}
+		// SetTrackingCode holds details about calls to the SetTrackingCode method.
+		SetTrackingCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Code is the code argument value.
+			Code string
+			// At is the at argument value.
+			At time.Time
+		}
+	}
+	lockCancelContract            sync.RWMutex
+	lockCancelLoad                sync.RWMutex
+	lockClaimPendingBookings      sync.RWMutex
+	lockContractLoad              sync.RWMutex
+	lockCountContractsSince       sync.RWMutex
+	lockGetActiveContractByOrder  sync.RWMutex
+	lockGetContractByID           sync.RWMutex
+	lockGetContractByTrackingCode sync.RWMutex
+	lockGetLoadByID               sync.RWMutex
+	lockGetQuoteByID              sync.RWMutex
+	lockInsert                    sync.RWMutex
+	lockInsertLoad                sync.RWMutex
+	lockInsertPricingRule         sync.RWMutex
+	lockInsertQuotes              sync.RWMutex
+	lockListContracts             sync.RWMutex
+	lockListDeliveryStats         sync.RWMutex
+	lockListLoadedOrderIDs        sync.RWMutex
+	lockListPricingRules          sync.RWMutex
+	lockRecordBookingFailure      sync.RWMutex
+	lockReplace                   sync.RWMutex
+	lockSetTrackingCode           sync.RWMutex
+}
+
+// CancelContract calls CancelContractFunc.
+func (mock *RepositoryMock) CancelContract(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+	if mock.CancelContractFunc == nil {
+		panic("RepositoryMock.CancelContractFunc: method is nil but Repository.CancelContract was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Reason string
+		At     time.Time
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Reason: reason,
+		At:     at,
+	}
+	mock.lockCancelContract.Lock()
+	mock.calls.CancelContract = append(mock.calls.CancelContract, callInfo)
+	mock.lockCancelContract.Unlock()
+	return mock.CancelContractFunc(ctx, id, reason, at)
+}
+
+// CancelContractCalls gets all the calls that were made to CancelContract.
+// Check the length with:
+//
+//	len(mockedRepository.CancelContractCalls())
+func (mock *RepositoryMock) CancelContractCalls() []struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Reason string
+	At     time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Reason string
+		At     time.Time
+	}
+	mock.lockCancelContract.RLock()
+	calls = mock.calls.CancelContract
+	mock.lockCancelContract.RUnlock()
+	return calls
+}
+
+// CancelLoad calls CancelLoadFunc.
+func (mock *RepositoryMock) CancelLoad(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if mock.CancelLoadFunc == nil {
+		panic("RepositoryMock.CancelLoadFunc: method is nil but Repository.CancelLoad was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		At:  at,
+	}
+	mock.lockCancelLoad.Lock()
+	mock.calls.CancelLoad = append(mock.calls.CancelLoad, callInfo)
+	mock.lockCancelLoad.Unlock()
+	return mock.CancelLoadFunc(ctx, id, at)
+}
+
+// CancelLoadCalls gets all the calls that were made to CancelLoad.
+// Check the length with:
+//
+//	len(mockedRepository.CancelLoadCalls())
+func (mock *RepositoryMock) CancelLoadCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}
+	mock.lockCancelLoad.RLock()
+	calls = mock.calls.CancelLoad
+	mock.lockCancelLoad.RUnlock()
+	return calls
+}
+
+// ClaimPendingBookings calls ClaimPendingBookingsFunc.
+func (mock *RepositoryMock) ClaimPendingBookings(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]shipping.Contract, error) {
+	if mock.ClaimPendingBookingsFunc == nil {
+		panic("RepositoryMock.ClaimPendingBookingsFunc: method is nil but Repository.ClaimPendingBookings was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+		Lease time.Duration
+	}{
+		Ctx:   ctx,
+		Now:   now,
+		Limit: limit,
+		Lease: lease,
+	}
+	mock.lockClaimPendingBookings.Lock()
+	mock.calls.ClaimPendingBookings = append(mock.calls.ClaimPendingBookings, callInfo)
+	mock.lockClaimPendingBookings.Unlock()
+	return mock.ClaimPendingBookingsFunc(ctx, now, limit, lease)
+}
+
+// ClaimPendingBookingsCalls gets all the calls that were made to ClaimPendingBookings.
+// Check the length with:
+//
+//	len(mockedRepository.ClaimPendingBookingsCalls())
+func (mock *RepositoryMock) ClaimPendingBookingsCalls() []struct {
+	Ctx   context.Context
+	Now   time.Time
+	Limit int
+	Lease time.Duration
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+		Lease time.Duration
+	}
+	mock.lockClaimPendingBookings.RLock()
+	calls = mock.calls.ClaimPendingBookings
+	mock.lockClaimPendingBookings.RUnlock()
+	return calls
+}
+
+// ContractLoad calls ContractLoadFunc.
+func (mock *RepositoryMock) ContractLoad(ctx context.Context, id uuid.UUID, contracts []*shipping.Contract, at time.Time) error {
+	if mock.ContractLoadFunc == nil {
+		panic("RepositoryMock.ContractLoadFunc: method is nil but Repository.ContractLoad was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ID        uuid.UUID
+		Contracts []*shipping.Contract
+		At        time.Time
+	}{
+		Ctx:       ctx,
+		ID:        id,
+		Contracts: contracts,
+		At:        at,
+	}
+	mock.lockContractLoad.Lock()
+	mock.calls.ContractLoad = append(mock.calls.ContractLoad, callInfo)
+	mock.lockContractLoad.Unlock()
+	return mock.ContractLoadFunc(ctx, id, contracts, at)
+}
+
+// ContractLoadCalls gets all the calls that were made to ContractLoad.
+// Check the length with:
+//
+//	len(mockedRepository.ContractLoadCalls())
+func (mock *RepositoryMock) ContractLoadCalls() []struct {
+	Ctx       context.Context
+	ID        uuid.UUID
+	Contracts []*shipping.Contract
+	At        time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ID        uuid.UUID
+		Contracts []*shipping.Contract
+		At        time.Time
+	}
+	mock.lockContractLoad.RLock()
+	calls = mock.calls.ContractLoad
+	mock.lockContractLoad.RUnlock()
+	return calls
+}
+
+// CountContractsSince calls CountContractsSinceFunc.
+func (mock *RepositoryMock) CountContractsSince(ctx context.Context, carrierIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+	if mock.CountContractsSinceFunc == nil {
+		panic("RepositoryMock.CountContractsSinceFunc: method is nil but Repository.CountContractsSince was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		CarrierIDs []uuid.UUID
+		Since      time.Time
+	}{
+		Ctx:        ctx,
+		CarrierIDs: carrierIDs,
+		Since:      since,
+	}
+	mock.lockCountContractsSince.Lock()
+	mock.calls.CountContractsSince = append(mock.calls.CountContractsSince, callInfo)
+	mock.lockCountContractsSince.Unlock()
+	return mock.CountContractsSinceFunc(ctx, carrierIDs, since)
+}
+
+// CountContractsSinceCalls gets all the calls that were made to CountContractsSince.
+// Check the length with:
+//
+//	len(mockedRepository.CountContractsSinceCalls())
+func (mock *RepositoryMock) CountContractsSinceCalls() []struct {
+	Ctx        context.Context
+	CarrierIDs []uuid.UUID
+	Since      time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		CarrierIDs []uuid.UUID
+		Since      time.Time
+	}
+	mock.lockCountContractsSince.RLock()
+	calls = mock.calls.CountContractsSince
+	mock.lockCountContractsSince.RUnlock()
+	return calls
+}
+
+// GetActiveContractByOrder calls GetActiveContractByOrderFunc.
+func (mock *RepositoryMock) GetActiveContractByOrder(ctx context.Context, orderID uuid.UUID) (*shipping.Contract, error) {
+	if mock.GetActiveContractByOrderFunc == nil {
+		panic("RepositoryMock.GetActiveContractByOrderFunc: method is nil but Repository.GetActiveContractByOrder was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OrderID uuid.UUID
+	}{
+		Ctx:     ctx,
+		OrderID: orderID,
+	}
+	mock.lockGetActiveContractByOrder.Lock()
+	mock.calls.GetActiveContractByOrder = append(mock.calls.GetActiveContractByOrder, callInfo)
+	mock.lockGetActiveContractByOrder.Unlock()
+	return mock.GetActiveContractByOrderFunc(ctx, orderID)
+}
+
+// GetActiveContractByOrderCalls gets all the calls that were made to GetActiveContractByOrder.
+// Check the length with:
+//
+//	len(mockedRepository.GetActiveContractByOrderCalls())
+func (mock *RepositoryMock) GetActiveContractByOrderCalls() []struct {
+	Ctx     context.Context
+	OrderID uuid.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OrderID uuid.UUID
+	}
+	mock.lockGetActiveContractByOrder.RLock()
+	calls = mock.calls.GetActiveContractByOrder
+	mock.lockGetActiveContractByOrder.RUnlock()
+	return calls
+}
+
+// GetContractByID calls GetContractByIDFunc.
+func (mock *RepositoryMock) GetContractByID(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+	if mock.GetContractByIDFunc == nil {
+		panic("RepositoryMock.GetContractByIDFunc: method is nil but Repository.GetContractByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetContractByID.Lock()
+	mock.calls.GetContractByID = append(mock.calls.GetContractByID, callInfo)
+	mock.lockGetContractByID.Unlock()
+	return mock.GetContractByIDFunc(ctx, id)
+}
+
+// GetContractByIDCalls gets all the calls that were made to GetContractByID.
+// Check the length with:
+//
+//	len(mockedRepository.GetContractByIDCalls())
+func (mock *RepositoryMock) GetContractByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetContractByID.RLock()
+	calls = mock.calls.GetContractByID
+	mock.lockGetContractByID.RUnlock()
+	return calls
+}
+
+// GetContractByTrackingCode calls GetContractByTrackingCodeFunc.
+func (mock *RepositoryMock) GetContractByTrackingCode(ctx context.Context, carrierID uuid.UUID, code string) (*shipping.Contract, error) {
+	if mock.GetContractByTrackingCodeFunc == nil {
+		panic("RepositoryMock.GetContractByTrackingCodeFunc: method is nil but Repository.GetContractByTrackingCode was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Code      string
+	}{
+		Ctx:       ctx,
+		CarrierID: carrierID,
+		Code:      code,
+	}
+	mock.lockGetContractByTrackingCode.Lock()
+	mock.calls.GetContractByTrackingCode = append(mock.calls.GetContractByTrackingCode, callInfo)
+	mock.lockGetContractByTrackingCode.Unlock()
+	return mock.GetContractByTrackingCodeFunc(ctx, carrierID, code)
+}
+
+// GetContractByTrackingCodeCalls gets all the calls that were made to GetContractByTrackingCode.
+// Check the length with:
+//
+//	len(mockedRepository.GetContractByTrackingCodeCalls())
+func (mock *RepositoryMock) GetContractByTrackingCodeCalls() []struct {
+	Ctx       context.Context
+	CarrierID uuid.UUID
+	Code      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		CarrierID uuid.UUID
+		Code      string
+	}
+	mock.lockGetContractByTrackingCode.RLock()
+	calls = mock.calls.GetContractByTrackingCode
+	mock.lockGetContractByTrackingCode.RUnlock()
+	return calls
+}
+
+// GetLoadByID calls GetLoadByIDFunc.
+func (mock *RepositoryMock) GetLoadByID(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+	if mock.GetLoadByIDFunc == nil {
+		panic("RepositoryMock.GetLoadByIDFunc: method is nil but Repository.GetLoadByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetLoadByID.Lock()
+	mock.calls.GetLoadByID = append(mock.calls.GetLoadByID, callInfo)
+	mock.lockGetLoadByID.Unlock()
+	return mock.GetLoadByIDFunc(ctx, id)
+}
+
+// GetLoadByIDCalls gets all the calls that were made to GetLoadByID.
+// Check the length with:
+//
+//	len(mockedRepository.GetLoadByIDCalls())
+func (mock *RepositoryMock) GetLoadByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetLoadByID.RLock()
+	calls = mock.calls.GetLoadByID
+	mock.lockGetLoadByID.RUnlock()
+	return calls
+}
+
+// GetQuoteByID calls GetQuoteByIDFunc.
+func (mock *RepositoryMock) GetQuoteByID(ctx context.Context, id uuid.UUID) (*shipping.Quote, error) {
+	if mock.GetQuoteByIDFunc == nil {
+		panic("RepositoryMock.GetQuoteByIDFunc: method is nil but Repository.GetQuoteByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetQuoteByID.Lock()
+	mock.calls.GetQuoteByID = append(mock.calls.GetQuoteByID, callInfo)
+	mock.lockGetQuoteByID.Unlock()
+	return mock.GetQuoteByIDFunc(ctx, id)
+}
+
+// GetQuoteByIDCalls gets all the calls that were made to GetQuoteByID.
+// Check the length with:
+//
+//	len(mockedRepository.GetQuoteByIDCalls())
+func (mock *RepositoryMock) GetQuoteByIDCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
 	}
-	lockInsert sync.RWMutex
+	mock.lockGetQuoteByID.RLock()
+	calls = mock.calls.GetQuoteByID
+	mock.lockGetQuoteByID.RUnlock()
+	return calls
 }
 
 // Insert calls InsertFunc.
@@ -81,3 +788,387 @@ func (mock *RepositoryMock) InsertCalls() []struct {
 	mock.lockInsert.RUnlock()
 	return calls
 }
+
+// InsertLoad calls InsertLoadFunc.
+func (mock *RepositoryMock) InsertLoad(ctx context.Context, l *shipping.Load) (uuid.UUID, error) {
+	if mock.InsertLoadFunc == nil {
+		panic("RepositoryMock.InsertLoadFunc: method is nil but Repository.InsertLoad was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		L   *shipping.Load
+	}{
+		Ctx: ctx,
+		L:   l,
+	}
+	mock.lockInsertLoad.Lock()
+	mock.calls.InsertLoad = append(mock.calls.InsertLoad, callInfo)
+	mock.lockInsertLoad.Unlock()
+	return mock.InsertLoadFunc(ctx, l)
+}
+
+// InsertLoadCalls gets all the calls that were made to InsertLoad.
+// Check the length with:
+//
+//	len(mockedRepository.InsertLoadCalls())
+func (mock *RepositoryMock) InsertLoadCalls() []struct {
+	Ctx context.Context
+	L   *shipping.Load
+} {
+	var calls []struct {
+		Ctx context.Context
+		L   *shipping.Load
+	}
+	mock.lockInsertLoad.RLock()
+	calls = mock.calls.InsertLoad
+	mock.lockInsertLoad.RUnlock()
+	return calls
+}
+
+// InsertPricingRule calls InsertPricingRuleFunc.
+func (mock *RepositoryMock) InsertPricingRule(ctx context.Context, rule *shipping.PricingRule) (uuid.UUID, error) {
+	if mock.InsertPricingRuleFunc == nil {
+		panic("RepositoryMock.InsertPricingRuleFunc: method is nil but Repository.InsertPricingRule was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Rule *shipping.PricingRule
+	}{
+		Ctx:  ctx,
+		Rule: rule,
+	}
+	mock.lockInsertPricingRule.Lock()
+	mock.calls.InsertPricingRule = append(mock.calls.InsertPricingRule, callInfo)
+	mock.lockInsertPricingRule.Unlock()
+	return mock.InsertPricingRuleFunc(ctx, rule)
+}
+
+// InsertPricingRuleCalls gets all the calls that were made to InsertPricingRule.
+// Check the length with:
+//
+//	len(mockedRepository.InsertPricingRuleCalls())
+func (mock *RepositoryMock) InsertPricingRuleCalls() []struct {
+	Ctx  context.Context
+	Rule *shipping.PricingRule
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Rule *shipping.PricingRule
+	}
+	mock.lockInsertPricingRule.RLock()
+	calls = mock.calls.InsertPricingRule
+	mock.lockInsertPricingRule.RUnlock()
+	return calls
+}
+
+// InsertQuotes calls InsertQuotesFunc.
+func (mock *RepositoryMock) InsertQuotes(ctx context.Context, request *shipping.QuoteRequest, quotes []*shipping.Quote) error {
+	if mock.InsertQuotesFunc == nil {
+		panic("RepositoryMock.InsertQuotesFunc: method is nil but Repository.InsertQuotes was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request *shipping.QuoteRequest
+		Quotes  []*shipping.Quote
+	}{
+		Ctx:     ctx,
+		Request: request,
+		Quotes:  quotes,
+	}
+	mock.lockInsertQuotes.Lock()
+	mock.calls.InsertQuotes = append(mock.calls.InsertQuotes, callInfo)
+	mock.lockInsertQuotes.Unlock()
+	return mock.InsertQuotesFunc(ctx, request, quotes)
+}
+
+// InsertQuotesCalls gets all the calls that were made to InsertQuotes.
+// Check the length with:
+//
+//	len(mockedRepository.InsertQuotesCalls())
+func (mock *RepositoryMock) InsertQuotesCalls() []struct {
+	Ctx     context.Context
+	Request *shipping.QuoteRequest
+	Quotes  []*shipping.Quote
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request *shipping.QuoteRequest
+		Quotes  []*shipping.Quote
+	}
+	mock.lockInsertQuotes.RLock()
+	calls = mock.calls.InsertQuotes
+	mock.lockInsertQuotes.RUnlock()
+	return calls
+}
+
+// ListContracts calls ListContractsFunc.
+func (mock *RepositoryMock) ListContracts(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error) {
+	if mock.ListContractsFunc == nil {
+		panic("RepositoryMock.ListContractsFunc: method is nil but Repository.ListContracts was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter shipping.ContractFilter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockListContracts.Lock()
+	mock.calls.ListContracts = append(mock.calls.ListContracts, callInfo)
+	mock.lockListContracts.Unlock()
+	return mock.ListContractsFunc(ctx, filter)
+}
+
+// ListContractsCalls gets all the calls that were made to ListContracts.
+// Check the length with:
+//
+//	len(mockedRepository.ListContractsCalls())
+func (mock *RepositoryMock) ListContractsCalls() []struct {
+	Ctx    context.Context
+	Filter shipping.ContractFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter shipping.ContractFilter
+	}
+	mock.lockListContracts.RLock()
+	calls = mock.calls.ListContracts
+	mock.lockListContracts.RUnlock()
+	return calls
+}
+
+// ListDeliveryStats calls ListDeliveryStatsFunc.
+func (mock *RepositoryMock) ListDeliveryStats(ctx context.Context, carrierIDs []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+	if mock.ListDeliveryStatsFunc == nil {
+		panic("RepositoryMock.ListDeliveryStatsFunc: method is nil but Repository.ListDeliveryStats was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		CarrierIDs []uuid.UUID
+	}{
+		Ctx:        ctx,
+		CarrierIDs: carrierIDs,
+	}
+	mock.lockListDeliveryStats.Lock()
+	mock.calls.ListDeliveryStats = append(mock.calls.ListDeliveryStats, callInfo)
+	mock.lockListDeliveryStats.Unlock()
+	return mock.ListDeliveryStatsFunc(ctx, carrierIDs)
+}
+
+// ListDeliveryStatsCalls gets all the calls that were made to ListDeliveryStats.
+// Check the length with:
+//
+//	len(mockedRepository.ListDeliveryStatsCalls())
+func (mock *RepositoryMock) ListDeliveryStatsCalls() []struct {
+	Ctx        context.Context
+	CarrierIDs []uuid.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		CarrierIDs []uuid.UUID
+	}
+	mock.lockListDeliveryStats.RLock()
+	calls = mock.calls.ListDeliveryStats
+	mock.lockListDeliveryStats.RUnlock()
+	return calls
+}
+
+// ListLoadedOrderIDs calls ListLoadedOrderIDsFunc.
+func (mock *RepositoryMock) ListLoadedOrderIDs(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	if mock.ListLoadedOrderIDsFunc == nil {
+		panic("RepositoryMock.ListLoadedOrderIDsFunc: method is nil but Repository.ListLoadedOrderIDs was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		OrderIDs []uuid.UUID
+	}{
+		Ctx:      ctx,
+		OrderIDs: orderIDs,
+	}
+	mock.lockListLoadedOrderIDs.Lock()
+	mock.calls.ListLoadedOrderIDs = append(mock.calls.ListLoadedOrderIDs, callInfo)
+	mock.lockListLoadedOrderIDs.Unlock()
+	return mock.ListLoadedOrderIDsFunc(ctx, orderIDs)
+}
+
+// ListLoadedOrderIDsCalls gets all the calls that were made to ListLoadedOrderIDs.
+// Check the length with:
+//
+//	len(mockedRepository.ListLoadedOrderIDsCalls())
+func (mock *RepositoryMock) ListLoadedOrderIDsCalls() []struct {
+	Ctx      context.Context
+	OrderIDs []uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		OrderIDs []uuid.UUID
+	}
+	mock.lockListLoadedOrderIDs.RLock()
+	calls = mock.calls.ListLoadedOrderIDs
+	mock.lockListLoadedOrderIDs.RUnlock()
+	return calls
+}
+
+// ListPricingRules calls ListPricingRulesFunc.
+func (mock *RepositoryMock) ListPricingRules(ctx context.Context) ([]shipping.PricingRule, error) {
+	if mock.ListPricingRulesFunc == nil {
+		panic("RepositoryMock.ListPricingRulesFunc: method is nil but Repository.ListPricingRules was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListPricingRules.Lock()
+	mock.calls.ListPricingRules = append(mock.calls.ListPricingRules, callInfo)
+	mock.lockListPricingRules.Unlock()
+	return mock.ListPricingRulesFunc(ctx)
+}
+
+// ListPricingRulesCalls gets all the calls that were made to ListPricingRules.
+// Check the length with:
+//
+//	len(mockedRepository.ListPricingRulesCalls())
+func (mock *RepositoryMock) ListPricingRulesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListPricingRules.RLock()
+	calls = mock.calls.ListPricingRules
+	mock.lockListPricingRules.RUnlock()
+	return calls
+}
+
+// RecordBookingFailure calls RecordBookingFailureFunc.
+func (mock *RepositoryMock) RecordBookingFailure(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time, at time.Time) (int, error) {
+	if mock.RecordBookingFailureFunc == nil {
+		panic("RepositoryMock.RecordBookingFailureFunc: method is nil but Repository.RecordBookingFailure was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      uuid.UUID
+		Reason  string
+		RetryAt *time.Time
+		At      time.Time
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		Reason:  reason,
+		RetryAt: retryAt,
+		At:      at,
+	}
+	mock.lockRecordBookingFailure.Lock()
+	mock.calls.RecordBookingFailure = append(mock.calls.RecordBookingFailure, callInfo)
+	mock.lockRecordBookingFailure.Unlock()
+	return mock.RecordBookingFailureFunc(ctx, id, reason, retryAt, at)
+}
+
+// RecordBookingFailureCalls gets all the calls that were made to RecordBookingFailure.
+// Check the length with:
+//
+//	len(mockedRepository.RecordBookingFailureCalls())
+func (mock *RepositoryMock) RecordBookingFailureCalls() []struct {
+	Ctx     context.Context
+	ID      uuid.UUID
+	Reason  string
+	RetryAt *time.Time
+	At      time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      uuid.UUID
+		Reason  string
+		RetryAt *time.Time
+		At      time.Time
+	}
+	mock.lockRecordBookingFailure.RLock()
+	calls = mock.calls.RecordBookingFailure
+	mock.lockRecordBookingFailure.RUnlock()
+	return calls
+}
+
+// Replace calls ReplaceFunc.
+func (mock *RepositoryMock) Replace(ctx context.Context, previousID uuid.UUID, c *shipping.Contract) (uuid.UUID, error) {
+	if mock.ReplaceFunc == nil {
+		panic("RepositoryMock.ReplaceFunc: method is nil but Repository.Replace was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PreviousID uuid.UUID
+		C          *shipping.Contract
+	}{
+		Ctx:        ctx,
+		PreviousID: previousID,
+		C:          c,
+	}
+	mock.lockReplace.Lock()
+	mock.calls.Replace = append(mock.calls.Replace, callInfo)
+	mock.lockReplace.Unlock()
+	return mock.ReplaceFunc(ctx, previousID, c)
+}
+
+// ReplaceCalls gets all the calls that were made to Replace.
+// Check the length with:
+//
+//	len(mockedRepository.ReplaceCalls())
+func (mock *RepositoryMock) ReplaceCalls() []struct {
+	Ctx        context.Context
+	PreviousID uuid.UUID
+	C          *shipping.Contract
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PreviousID uuid.UUID
+		C          *shipping.Contract
+	}
+	mock.lockReplace.RLock()
+	calls = mock.calls.Replace
+	mock.lockReplace.RUnlock()
+	return calls
+}
+
+// SetTrackingCode calls SetTrackingCodeFunc.
+func (mock *RepositoryMock) SetTrackingCode(ctx context.Context, id uuid.UUID, code string, at time.Time) error {
+	if mock.SetTrackingCodeFunc == nil {
+		panic("RepositoryMock.SetTrackingCodeFunc: method is nil but Repository.SetTrackingCode was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Code string
+		At   time.Time
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Code: code,
+		At:   at,
+	}
+	mock.lockSetTrackingCode.Lock()
+	mock.calls.SetTrackingCode = append(mock.calls.SetTrackingCode, callInfo)
+	mock.lockSetTrackingCode.Unlock()
+	return mock.SetTrackingCodeFunc(ctx, id, code, at)
+}
+
+// SetTrackingCodeCalls gets all the calls that were made to SetTrackingCode.
+// Check the length with:
+//
+//	len(mockedRepository.SetTrackingCodeCalls())
+func (mock *RepositoryMock) SetTrackingCodeCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	Code string
+	At   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Code string
+		At   time.Time
+	}
+	mock.lockSetTrackingCode.RLock()
+	calls = mock.calls.SetTrackingCode
+	mock.lockSetTrackingCode.RUnlock()
+	return calls
+}
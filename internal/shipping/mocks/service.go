@@ -20,12 +20,54 @@ var _ shipping.Service = &ServiceMock{}
 //
 //		// make and configure a mocked shipping.Service
 //		mockedService := &ServiceMock{
-//			ContractCarrierFunc: func(ctx context.Context, orderID uuid.UUID, carrierID uuid.UUID) (*shipping.Contract, error) {
+//			AllocateFunc: func(ctx context.Context, request shipping.AllocationRequest) (*shipping.Allocation, error) {
+//				panic("mock out the Allocate method")
+//			},
+//			AutoContractFunc: func(ctx context.Context, orderID uuid.UUID, requested *bool) (*shipping.Contract, error) {
+//				panic("mock out the AutoContract method")
+//			},
+//			CancelContractFunc: func(ctx context.Context, id uuid.UUID, reason string) (*shipping.Contract, error) {
+//				panic("mock out the CancelContract method")
+//			},
+//			CancelLoadFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+//				panic("mock out the CancelLoad method")
+//			},
+//			ContractCarrierFunc: func(ctx context.Context, orderID uuid.UUID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
 //				panic("mock out the ContractCarrier method")
 //			},
-//			QuoteAllFunc: func(ctx context.Context, orderID uuid.UUID) ([]*shipping.Quote, error) {
+//			ContractLoadFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+//				panic("mock out the ContractLoad method")
+//			},
+//			CreatePricingRuleFunc: func(ctx context.Context, rule *shipping.PricingRule) (*shipping.PricingRule, error) {
+//				panic("mock out the CreatePricingRule method")
+//			},
+//			GetContractFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+//				panic("mock out the GetContract method")
+//			},
+//			GetLoadFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+//				panic("mock out the GetLoad method")
+//			},
+//			ListContractsFunc: func(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error) {
+//				panic("mock out the ListContracts method")
+//			},
+//			ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+//				panic("mock out the ListPricingRules method")
+//			},
+//			PlanLoadFunc: func(ctx context.Context, request shipping.LoadRequest) (*shipping.Load, error) {
+//				panic("mock out the PlanLoad method")
+//			},
+//			QuoteAllFunc: func(ctx context.Context, orderID uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.Quote, error) {
 //				panic("mock out the QuoteAll method")
 //			},
+//			QuoteBatchFunc: func(ctx context.Context, orderIDs []uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.OrderQuotes, error) {
+//				panic("mock out the QuoteBatch method")
+//			},
+//			RetryBookingsFunc: func(ctx context.Context, limit int) (int, error) {
+//				panic("mock out the RetryBookings method")
+//			},
+//			SimulateFunc: func(ctx context.Context, simulation shipping.Simulation, strategy shipping.RankingStrategy) ([]*shipping.Quote, error) {
+//				panic("mock out the Simulate method")
+//			},
 //		}
 //
 //		// use mockedService in code that requires shipping.Service
@@ -33,14 +75,88 @@ var _ shipping.Service = &ServiceMock{}
 //
 //	}
 type ServiceMock struct {
+	// AllocateFunc mocks the Allocate method.
+	AllocateFunc func(ctx context.Context, request shipping.AllocationRequest) (*shipping.Allocation, error)
+
+	// AutoContractFunc mocks the AutoContract method.
+	AutoContractFunc func(ctx context.Context, orderID uuid.UUID, requested *bool) (*shipping.Contract, error)
+
+	// CancelContractFunc mocks the CancelContract method.
+	CancelContractFunc func(ctx context.Context, id uuid.UUID, reason string) (*shipping.Contract, error)
+
+	// CancelLoadFunc mocks the CancelLoad method.
+	CancelLoadFunc func(ctx context.Context, id uuid.UUID) (*shipping.Load, error)
+
 	// ContractCarrierFunc mocks the ContractCarrier method.
-	ContractCarrierFunc func(ctx context.Context, orderID uuid.UUID, carrierID uuid.UUID) (*shipping.Contract, error)
+	ContractCarrierFunc func(ctx context.Context, orderID uuid.UUID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error)
+
+	// ContractLoadFunc mocks the ContractLoad method.
+	ContractLoadFunc func(ctx context.Context, id uuid.UUID) (*shipping.Load, error)
+
+	// CreatePricingRuleFunc mocks the CreatePricingRule method.
+	CreatePricingRuleFunc func(ctx context.Context, rule *shipping.PricingRule) (*shipping.PricingRule, error)
+
+	// GetContractFunc mocks the GetContract method.
+	GetContractFunc func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error)
+
+	// GetLoadFunc mocks the GetLoad method.
+	GetLoadFunc func(ctx context.Context, id uuid.UUID) (*shipping.Load, error)
+
+	// ListContractsFunc mocks the ListContracts method.
+	ListContractsFunc func(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error)
+
+	// ListPricingRulesFunc mocks the ListPricingRules method.
+	ListPricingRulesFunc func(ctx context.Context) ([]shipping.PricingRule, error)
+
+	// PlanLoadFunc mocks the PlanLoad method.
+	PlanLoadFunc func(ctx context.Context, request shipping.LoadRequest) (*shipping.Load, error)
 
 	// QuoteAllFunc mocks the QuoteAll method.
-	QuoteAllFunc func(ctx context.Context, orderID uuid.UUID) ([]*shipping.Quote, error)
+	QuoteAllFunc func(ctx context.Context, orderID uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.Quote, error)
+
+	// QuoteBatchFunc mocks the QuoteBatch method.
+	QuoteBatchFunc func(ctx context.Context, orderIDs []uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.OrderQuotes, error)
+
+	// RetryBookingsFunc mocks the RetryBookings method.
+	RetryBookingsFunc func(ctx context.Context, limit int) (int, error)
+
+	// SimulateFunc mocks the Simulate method.
+	SimulateFunc func(ctx context.Context, simulation shipping.Simulation, strategy shipping.RankingStrategy) ([]*shipping.Quote, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// Allocate holds details about calls to the Allocate method.
+		Allocate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request shipping.AllocationRequest
+		}
+		// AutoContract holds details about calls to the AutoContract method.
+		AutoContract []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID uuid.UUID
+			// Requested is the requested argument value.
+			Requested *bool
+		}
+		// CancelContract holds details about calls to the CancelContract method.
+		CancelContract []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Reason is the reason argument value.
+			Reason string
+		}
+		// CancelLoad holds details about calls to the CancelLoad method.
+		CancelLoad []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
 		// ContractCarrier holds details about calls to the ContractCarrier method.
 		ContractCarrier []struct {
 			// Ctx is the ctx argument value.
@@ -49,6 +165,57 @@ type ServiceMock struct {
 			OrderID uuid.UUID
 			// CarrierID is the carrierID argument value.
 			CarrierID uuid.UUID
+			// QuoteID is the quoteID argument value.
+			QuoteID *uuid.UUID
+			// Replace is the replace argument value.
+			Replace bool
+		}
+		// ContractLoad holds details about calls to the ContractLoad method.
+		ContractLoad []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// CreatePricingRule holds details about calls to the CreatePricingRule method.
+		CreatePricingRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rule is the rule argument value.
+			Rule *shipping.PricingRule
+		}
+		// GetContract holds details about calls to the GetContract method.
+		GetContract []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// GetLoad holds details about calls to the GetLoad method.
+		GetLoad []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// ListContracts holds details about calls to the ListContracts method.
+		ListContracts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter shipping.ContractFilter
+		}
+		// ListPricingRules holds details about calls to the ListPricingRules method.
+		ListPricingRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// PlanLoad holds details about calls to the PlanLoad method.
+		PlanLoad []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request shipping.LoadRequest
 		}
 		// QuoteAll holds details about calls to the QuoteAll method.
 		QuoteAll []struct {
@@ -56,14 +223,207 @@ type ServiceMock struct {
 			Ctx context.Context
 			// OrderID is the orderID argument value.
 			OrderID uuid.UUID
+			// Strategy is the strategy argument value.
+			Strategy shipping.RankingStrategy
+		}
+		// QuoteBatch holds details about calls to the QuoteBatch method.
+		QuoteBatch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderIDs is the orderIDs argument value.
+			OrderIDs []uuid.UUID
+			// Strategy is the strategy argument value.
+			Strategy shipping.RankingStrategy
+		}
+		// RetryBookings holds details about calls to the RetryBookings method.
+		RetryBookings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// Simulate holds details about calls to the Simulate method.
+		Simulate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Simulation is the simulation argument value.
+			Simulation shipping.Simulation
+			// Strategy is the strategy argument value.
+			Strategy shipping.RankingStrategy
 		}
 	}
-	lockContractCarrier sync.RWMutex
-	lockQuoteAll        sync.RWMutex
+	lockAllocate          sync.RWMutex
+	lockAutoContract      sync.RWMutex
+	lockCancelContract    sync.RWMutex
+	lockCancelLoad        sync.RWMutex
+	lockContractCarrier   sync.RWMutex
+	lockContractLoad      sync.RWMutex
+	lockCreatePricingRule sync.RWMutex
+	lockGetContract       sync.RWMutex
+	lockGetLoad           sync.RWMutex
+	lockListContracts     sync.RWMutex
+	lockListPricingRules  sync.RWMutex
+	lockPlanLoad          sync.RWMutex
+	lockQuoteAll          sync.RWMutex
+	lockQuoteBatch        sync.RWMutex
+	lockRetryBookings     sync.RWMutex
+	lockSimulate          sync.RWMutex
+}
+
+// Allocate calls AllocateFunc.
+func (mock *ServiceMock) Allocate(ctx context.Context, request shipping.AllocationRequest) (*shipping.Allocation, error) {
+	if mock.AllocateFunc == nil {
+		panic("ServiceMock.AllocateFunc: method is nil but Service.Allocate was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request shipping.AllocationRequest
+	}{
+		Ctx:     ctx,
+		Request: request,
+	}
+	mock.lockAllocate.Lock()
+	mock.calls.Allocate = append(mock.calls.Allocate, callInfo)
+	mock.lockAllocate.Unlock()
+	return mock.AllocateFunc(ctx, request)
+}
+
+// AllocateCalls gets all the calls that were made to Allocate.
+// Check the length with:
+//
+//	len(mockedService.AllocateCalls())
+func (mock *ServiceMock) AllocateCalls() []struct {
+	Ctx     context.Context
+	Request shipping.AllocationRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request shipping.AllocationRequest
+	}
+	mock.lockAllocate.RLock()
+	calls = mock.calls.Allocate
+	mock.lockAllocate.RUnlock()
+	return calls
+}
+
+// AutoContract calls AutoContractFunc.
+func (mock *ServiceMock) AutoContract(ctx context.Context, orderID uuid.UUID, requested *bool) (*shipping.Contract, error) {
+	if mock.AutoContractFunc == nil {
+		panic("ServiceMock.AutoContractFunc: method is nil but Service.AutoContract was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		OrderID   uuid.UUID
+		Requested *bool
+	}{
+		Ctx:       ctx,
+		OrderID:   orderID,
+		Requested: requested,
+	}
+	mock.lockAutoContract.Lock()
+	mock.calls.AutoContract = append(mock.calls.AutoContract, callInfo)
+	mock.lockAutoContract.Unlock()
+	return mock.AutoContractFunc(ctx, orderID, requested)
+}
+
+// AutoContractCalls gets all the calls that were made to AutoContract.
+// Check the length with:
+//
+//	len(mockedService.AutoContractCalls())
+func (mock *ServiceMock) AutoContractCalls() []struct {
+	Ctx       context.Context
+	OrderID   uuid.UUID
+	Requested *bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		OrderID   uuid.UUID
+		Requested *bool
+	}
+	mock.lockAutoContract.RLock()
+	calls = mock.calls.AutoContract
+	mock.lockAutoContract.RUnlock()
+	return calls
+}
+
+// CancelContract calls CancelContractFunc.
+func (mock *ServiceMock) CancelContract(ctx context.Context, id uuid.UUID, reason string) (*shipping.Contract, error) {
+	if mock.CancelContractFunc == nil {
+		panic("ServiceMock.CancelContractFunc: method is nil but Service.CancelContract was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Reason string
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Reason: reason,
+	}
+	mock.lockCancelContract.Lock()
+	mock.calls.CancelContract = append(mock.calls.CancelContract, callInfo)
+	mock.lockCancelContract.Unlock()
+	return mock.CancelContractFunc(ctx, id, reason)
+}
+
+// CancelContractCalls gets all the calls that were made to CancelContract.
+// Check the length with:
+//
+//	len(mockedService.CancelContractCalls())
+func (mock *ServiceMock) CancelContractCalls() []struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Reason string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Reason string
+	}
+	mock.lockCancelContract.RLock()
+	calls = mock.calls.CancelContract
+	mock.lockCancelContract.RUnlock()
+	return calls
+}
+
+// CancelLoad calls CancelLoadFunc.
+func (mock *ServiceMock) CancelLoad(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+	if mock.CancelLoadFunc == nil {
+		panic("ServiceMock.CancelLoadFunc: method is nil but Service.CancelLoad was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockCancelLoad.Lock()
+	mock.calls.CancelLoad = append(mock.calls.CancelLoad, callInfo)
+	mock.lockCancelLoad.Unlock()
+	return mock.CancelLoadFunc(ctx, id)
+}
+
+// CancelLoadCalls gets all the calls that were made to CancelLoad.
+// Check the length with:
+//
+//	len(mockedService.CancelLoadCalls())
+func (mock *ServiceMock) CancelLoadCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockCancelLoad.RLock()
+	calls = mock.calls.CancelLoad
+	mock.lockCancelLoad.RUnlock()
+	return calls
 }
 
 // ContractCarrier calls ContractCarrierFunc.
-func (mock *ServiceMock) ContractCarrier(ctx context.Context, orderID uuid.UUID, carrierID uuid.UUID) (*shipping.Contract, error) {
+func (mock *ServiceMock) ContractCarrier(ctx context.Context, orderID uuid.UUID, carrierID uuid.UUID, quoteID *uuid.UUID, replace bool) (*shipping.Contract, error) {
 	if mock.ContractCarrierFunc == nil {
 		panic("ServiceMock.ContractCarrierFunc: method is nil but Service.ContractCarrier was just called")
 	}
@@ -71,15 +431,19 @@ func (mock *ServiceMock) ContractCarrier(ctx context.Context, orderID uuid.UUID,
 		Ctx       context.Context
 		OrderID   uuid.UUID
 		CarrierID uuid.UUID
+		QuoteID   *uuid.UUID
+		Replace   bool
 	}{
 		Ctx:       ctx,
 		OrderID:   orderID,
 		CarrierID: carrierID,
+		QuoteID:   quoteID,
+		Replace:   replace,
 	}
 	mock.lockContractCarrier.Lock()
 	mock.calls.ContractCarrier = append(mock.calls.ContractCarrier, callInfo)
 	mock.lockContractCarrier.Unlock()
-	return mock.ContractCarrierFunc(ctx, orderID, carrierID)
+	return mock.ContractCarrierFunc(ctx, orderID, carrierID, quoteID, replace)
 }
 
 // ContractCarrierCalls gets all the calls that were made to ContractCarrier.
@@ -90,11 +454,15 @@ func (mock *ServiceMock) ContractCarrierCalls() []struct {
 	Ctx       context.Context
 	OrderID   uuid.UUID
 	CarrierID uuid.UUID
+	QuoteID   *uuid.UUID
+	Replace   bool
 } {
 	var calls []struct {
 		Ctx       context.Context
 		OrderID   uuid.UUID
 		CarrierID uuid.UUID
+		QuoteID   *uuid.UUID
+		Replace   bool
 	}
 	mock.lockContractCarrier.RLock()
 	calls = mock.calls.ContractCarrier
@@ -102,22 +470,272 @@ func (mock *ServiceMock) ContractCarrierCalls() []struct {
 	return calls
 }
 
+// ContractLoad calls ContractLoadFunc.
+func (mock *ServiceMock) ContractLoad(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+	if mock.ContractLoadFunc == nil {
+		panic("ServiceMock.ContractLoadFunc: method is nil but Service.ContractLoad was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockContractLoad.Lock()
+	mock.calls.ContractLoad = append(mock.calls.ContractLoad, callInfo)
+	mock.lockContractLoad.Unlock()
+	return mock.ContractLoadFunc(ctx, id)
+}
+
+// ContractLoadCalls gets all the calls that were made to ContractLoad.
+// Check the length with:
+//
+//	len(mockedService.ContractLoadCalls())
+func (mock *ServiceMock) ContractLoadCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockContractLoad.RLock()
+	calls = mock.calls.ContractLoad
+	mock.lockContractLoad.RUnlock()
+	return calls
+}
+
+// CreatePricingRule calls CreatePricingRuleFunc.
+func (mock *ServiceMock) CreatePricingRule(ctx context.Context, rule *shipping.PricingRule) (*shipping.PricingRule, error) {
+	if mock.CreatePricingRuleFunc == nil {
+		panic("ServiceMock.CreatePricingRuleFunc: method is nil but Service.CreatePricingRule was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Rule *shipping.PricingRule
+	}{
+		Ctx:  ctx,
+		Rule: rule,
+	}
+	mock.lockCreatePricingRule.Lock()
+	mock.calls.CreatePricingRule = append(mock.calls.CreatePricingRule, callInfo)
+	mock.lockCreatePricingRule.Unlock()
+	return mock.CreatePricingRuleFunc(ctx, rule)
+}
+
+// CreatePricingRuleCalls gets all the calls that were made to CreatePricingRule.
+// Check the length with:
+//
+//	len(mockedService.CreatePricingRuleCalls())
+func (mock *ServiceMock) CreatePricingRuleCalls() []struct {
+	Ctx  context.Context
+	Rule *shipping.PricingRule
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Rule *shipping.PricingRule
+	}
+	mock.lockCreatePricingRule.RLock()
+	calls = mock.calls.CreatePricingRule
+	mock.lockCreatePricingRule.RUnlock()
+	return calls
+}
+
+// GetContract calls GetContractFunc.
+func (mock *ServiceMock) GetContract(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+	if mock.GetContractFunc == nil {
+		panic("ServiceMock.GetContractFunc: method is nil but Service.GetContract was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetContract.Lock()
+	mock.calls.GetContract = append(mock.calls.GetContract, callInfo)
+	mock.lockGetContract.Unlock()
+	return mock.GetContractFunc(ctx, id)
+}
+
+// GetContractCalls gets all the calls that were made to GetContract.
+// Check the length with:
+//
+//	len(mockedService.GetContractCalls())
+func (mock *ServiceMock) GetContractCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetContract.RLock()
+	calls = mock.calls.GetContract
+	mock.lockGetContract.RUnlock()
+	return calls
+}
+
+// GetLoad calls GetLoadFunc.
+func (mock *ServiceMock) GetLoad(ctx context.Context, id uuid.UUID) (*shipping.Load, error) {
+	if mock.GetLoadFunc == nil {
+		panic("ServiceMock.GetLoadFunc: method is nil but Service.GetLoad was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetLoad.Lock()
+	mock.calls.GetLoad = append(mock.calls.GetLoad, callInfo)
+	mock.lockGetLoad.Unlock()
+	return mock.GetLoadFunc(ctx, id)
+}
+
+// GetLoadCalls gets all the calls that were made to GetLoad.
+// Check the length with:
+//
+//	len(mockedService.GetLoadCalls())
+func (mock *ServiceMock) GetLoadCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetLoad.RLock()
+	calls = mock.calls.GetLoad
+	mock.lockGetLoad.RUnlock()
+	return calls
+}
+
+// ListContracts calls ListContractsFunc.
+func (mock *ServiceMock) ListContracts(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error) {
+	if mock.ListContractsFunc == nil {
+		panic("ServiceMock.ListContractsFunc: method is nil but Service.ListContracts was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter shipping.ContractFilter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockListContracts.Lock()
+	mock.calls.ListContracts = append(mock.calls.ListContracts, callInfo)
+	mock.lockListContracts.Unlock()
+	return mock.ListContractsFunc(ctx, filter)
+}
+
+// ListContractsCalls gets all the calls that were made to ListContracts.
+// Check the length with:
+//
+//	len(mockedService.ListContractsCalls())
+func (mock *ServiceMock) ListContractsCalls() []struct {
+	Ctx    context.Context
+	Filter shipping.ContractFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter shipping.ContractFilter
+	}
+	mock.lockListContracts.RLock()
+	calls = mock.calls.ListContracts
+	mock.lockListContracts.RUnlock()
+	return calls
+}
+
+// ListPricingRules calls ListPricingRulesFunc.
+func (mock *ServiceMock) ListPricingRules(ctx context.Context) ([]shipping.PricingRule, error) {
+	if mock.ListPricingRulesFunc == nil {
+		panic("ServiceMock.ListPricingRulesFunc: method is nil but Service.ListPricingRules was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListPricingRules.Lock()
+	mock.calls.ListPricingRules = append(mock.calls.ListPricingRules, callInfo)
+	mock.lockListPricingRules.Unlock()
+	return mock.ListPricingRulesFunc(ctx)
+}
+
+// ListPricingRulesCalls gets all the calls that were made to ListPricingRules.
+// Check the length with:
+//
+//	len(mockedService.ListPricingRulesCalls())
+func (mock *ServiceMock) ListPricingRulesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListPricingRules.RLock()
+	calls = mock.calls.ListPricingRules
+	mock.lockListPricingRules.RUnlock()
+	return calls
+}
+
+// PlanLoad calls PlanLoadFunc.
+func (mock *ServiceMock) PlanLoad(ctx context.Context, request shipping.LoadRequest) (*shipping.Load, error) {
+	if mock.PlanLoadFunc == nil {
+		panic("ServiceMock.PlanLoadFunc: method is nil but Service.PlanLoad was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request shipping.LoadRequest
+	}{
+		Ctx:     ctx,
+		Request: request,
+	}
+	mock.lockPlanLoad.Lock()
+	mock.calls.PlanLoad = append(mock.calls.PlanLoad, callInfo)
+	mock.lockPlanLoad.Unlock()
+	return mock.PlanLoadFunc(ctx, request)
+}
+
+// PlanLoadCalls gets all the calls that were made to PlanLoad.
+// Check the length with:
+//
+//	len(mockedService.PlanLoadCalls())
+func (mock *ServiceMock) PlanLoadCalls() []struct {
+	Ctx     context.Context
+	Request shipping.LoadRequest
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request shipping.LoadRequest
+	}
+	mock.lockPlanLoad.RLock()
+	calls = mock.calls.PlanLoad
+	mock.lockPlanLoad.RUnlock()
+	return calls
+}
+
 // QuoteAll calls QuoteAllFunc.
-func (mock *ServiceMock) QuoteAll(ctx context.Context, orderID uuid.UUID) ([]*shipping.Quote, error) {
+func (mock *ServiceMock) QuoteAll(ctx context.Context, orderID uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.Quote, error) {
 	if mock.QuoteAllFunc == nil {
 		panic("ServiceMock.QuoteAllFunc: method is nil but Service.QuoteAll was just called")
 	}
 	callInfo := struct {
-		Ctx     context.Context
-		OrderID uuid.UUID
+		Ctx      context.Context
+		OrderID  uuid.UUID
+		Strategy shipping.RankingStrategy
 	}{
-		Ctx:     ctx,
-		OrderID: orderID,
+		Ctx:      ctx,
+		OrderID:  orderID,
+		Strategy: strategy,
 	}
 	mock.lockQuoteAll.Lock()
 	mock.calls.QuoteAll = append(mock.calls.QuoteAll, callInfo)
 	mock.lockQuoteAll.Unlock()
-	return mock.QuoteAllFunc(ctx, orderID)
+	return mock.QuoteAllFunc(ctx, orderID, strategy)
 }
 
 // QuoteAllCalls gets all the calls that were made to QuoteAll.
@@ -125,15 +743,133 @@ func (mock *ServiceMock) QuoteAll(ctx context.Context, orderID uuid.UUID) ([]*sh
 //
 //	len(mockedService.QuoteAllCalls())
 func (mock *ServiceMock) QuoteAllCalls() []struct {
-	Ctx     context.Context
-	OrderID uuid.UUID
+	Ctx      context.Context
+	OrderID  uuid.UUID
+	Strategy shipping.RankingStrategy
 } {
 	var calls []struct {
-		Ctx     context.Context
-		OrderID uuid.UUID
+		Ctx      context.Context
+		OrderID  uuid.UUID
+		Strategy shipping.RankingStrategy
 	}
 	mock.lockQuoteAll.RLock()
 	calls = mock.calls.QuoteAll
 	mock.lockQuoteAll.RUnlock()
 	return calls
 }
+
+// QuoteBatch calls QuoteBatchFunc.
+func (mock *ServiceMock) QuoteBatch(ctx context.Context, orderIDs []uuid.UUID, strategy shipping.RankingStrategy) ([]*shipping.OrderQuotes, error) {
+	if mock.QuoteBatchFunc == nil {
+		panic("ServiceMock.QuoteBatchFunc: method is nil but Service.QuoteBatch was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		OrderIDs []uuid.UUID
+		Strategy shipping.RankingStrategy
+	}{
+		Ctx:      ctx,
+		OrderIDs: orderIDs,
+		Strategy: strategy,
+	}
+	mock.lockQuoteBatch.Lock()
+	mock.calls.QuoteBatch = append(mock.calls.QuoteBatch, callInfo)
+	mock.lockQuoteBatch.Unlock()
+	return mock.QuoteBatchFunc(ctx, orderIDs, strategy)
+}
+
+// QuoteBatchCalls gets all the calls that were made to QuoteBatch.
+// Check the length with:
+//
+//	len(mockedService.QuoteBatchCalls())
+func (mock *ServiceMock) QuoteBatchCalls() []struct {
+	Ctx      context.Context
+	OrderIDs []uuid.UUID
+	Strategy shipping.RankingStrategy
+} {
+	var calls []struct {
+		Ctx      context.Context
+		OrderIDs []uuid.UUID
+		Strategy shipping.RankingStrategy
+	}
+	mock.lockQuoteBatch.RLock()
+	calls = mock.calls.QuoteBatch
+	mock.lockQuoteBatch.RUnlock()
+	return calls
+}
+
+// RetryBookings calls RetryBookingsFunc.
+func (mock *ServiceMock) RetryBookings(ctx context.Context, limit int) (int, error) {
+	if mock.RetryBookingsFunc == nil {
+		panic("ServiceMock.RetryBookingsFunc: method is nil but Service.RetryBookings was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Limit int
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+	}
+	mock.lockRetryBookings.Lock()
+	mock.calls.RetryBookings = append(mock.calls.RetryBookings, callInfo)
+	mock.lockRetryBookings.Unlock()
+	return mock.RetryBookingsFunc(ctx, limit)
+}
+
+// RetryBookingsCalls gets all the calls that were made to RetryBookings.
+// Check the length with:
+//
+//	len(mockedService.RetryBookingsCalls())
+func (mock *ServiceMock) RetryBookingsCalls() []struct {
+	Ctx   context.Context
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Limit int
+	}
+	mock.lockRetryBookings.RLock()
+	calls = mock.calls.RetryBookings
+	mock.lockRetryBookings.RUnlock()
+	return calls
+}
+
+// Simulate calls SimulateFunc.
+func (mock *ServiceMock) Simulate(ctx context.Context, simulation shipping.Simulation, strategy shipping.RankingStrategy) ([]*shipping.Quote, error) {
+	if mock.SimulateFunc == nil {
+		panic("ServiceMock.SimulateFunc: method is nil but Service.Simulate was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Simulation shipping.Simulation
+		Strategy   shipping.RankingStrategy
+	}{
+		Ctx:        ctx,
+		Simulation: simulation,
+		Strategy:   strategy,
+	}
+	mock.lockSimulate.Lock()
+	mock.calls.Simulate = append(mock.calls.Simulate, callInfo)
+	mock.lockSimulate.Unlock()
+	return mock.SimulateFunc(ctx, simulation, strategy)
+}
+
+// SimulateCalls gets all the calls that were made to Simulate.
+// Check the length with:
+//
+//	len(mockedService.SimulateCalls())
+func (mock *ServiceMock) SimulateCalls() []struct {
+	Ctx        context.Context
+	Simulation shipping.Simulation
+	Strategy   shipping.RankingStrategy
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Simulation shipping.Simulation
+		Strategy   shipping.RankingStrategy
+	}
+	mock.lockSimulate.RLock()
+	calls = mock.calls.Simulate
+	mock.lockSimulate.RUnlock()
+	return calls
+}
@@ -5,22 +5,215 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+)
+
+var (
+	cubingFactorKgPerM3 = decimal.NewFromInt(300)
+	cubicCmPerM3        = decimal.NewFromInt(1_000_000)
+)
+
+type ContractStatus string
+
+const (
+	ContractStatusActive    ContractStatus = "active"
+	ContractStatusCancelled ContractStatus = "cancelled"
+	ContractStatusVoided    ContractStatus = "voided"
+)
+
+var ContractStatusValues = map[string]ContractStatus{
+	"active":    ContractStatusActive,
+	"cancelled": ContractStatusCancelled,
+	"voided":    ContractStatusVoided,
+}
+
+type BookingStatus string
+
+const (
+	BookingStatusNone    BookingStatus = "none"
+	BookingStatusPending BookingStatus = "pending"
+	BookingStatusBooked  BookingStatus = "booked"
+	BookingStatusFailed  BookingStatus = "failed"
 )
 
 type Contract struct {
-	ID            uuid.UUID       `json:"id"`
-	OrderID       uuid.UUID       `json:"order_id"`
-	CarrierID     uuid.UUID       `json:"carrier"`
-	Price         decimal.Decimal `json:"price"`
-	EstimatedDays int             `json:"estimated_days"`
-	ContractedAt  time.Time       `json:"contracted_at"`
-	CreatedAt     time.Time       `json:"created_at"`
-	UpdatedAt     time.Time       `json:"updated_at"`
+	ID                      uuid.UUID        `json:"id"`
+	OrderID                 uuid.UUID        `json:"order_id"`
+	CarrierID               uuid.UUID        `json:"carrier"`
+	Price                   decimal.Decimal  `json:"price"`
+	EstimatedDays           int              `json:"estimated_days"`
+	Status                  ContractStatus   `json:"status"`
+	QuoteID                 *uuid.UUID       `json:"quote_id"`
+	TrackingCode            *string          `json:"tracking_code"`
+	FuelSurchargePercentage decimal.Decimal  `json:"fuel_surcharge_percentage"`
+	FuelSurcharge           decimal.Decimal  `json:"fuel_surcharge"`
+	AdValorem               decimal.Decimal  `json:"ad_valorem"`
+	GRIS                    decimal.Decimal  `json:"gris"`
+	Toll                    decimal.Decimal  `json:"toll"`
+	TDE                     decimal.Decimal  `json:"tde"`
+	DiscountID              *uuid.UUID       `json:"discount_id"`
+	Discount                decimal.Decimal  `json:"discount"`
+	ICMSRate                decimal.Decimal  `json:"icms_rate"`
+	ICMS                    decimal.Decimal  `json:"icms"`
+	Breakdown               []PriceLine      `json:"breakdown"`
+	Explanation             PriceExplanation `json:"explanation"`
+	CancelReason            *string          `json:"cancel_reason"`
+	CancelledAt             *time.Time       `json:"cancelled_at"`
+	ReplacedBy              *uuid.UUID       `json:"replaced_by"`
+	LoadID                  *uuid.UUID       `json:"load_id"`
+	BookingStatus           BookingStatus    `json:"booking_status"`
+	BookingAttempts         int              `json:"booking_attempts"`
+	BookingError            *string          `json:"booking_error"`
+	NextBookingAt           *time.Time       `json:"next_booking_at"`
+	ContractedAt            time.Time        `json:"contracted_at"`
+	CreatedAt               time.Time        `json:"created_at"`
+	UpdatedAt               time.Time        `json:"updated_at"`
+}
+
+type ContractFilter struct {
+	OrderID   *uuid.UUID
+	CarrierID *uuid.UUID
+	Status    *ContractStatus
+	From      *time.Time
+	To        *time.Time
+	Limit     int
+	Offset    int
+}
+
+type QuoteRequest struct {
+	ID          uuid.UUID       `json:"id"`
+	OrderID     uuid.UUID       `json:"order_id"`
+	Strategy    RankingStrategy `json:"strategy"`
+	RequestedAt time.Time       `json:"requested_at"`
 }
 
 type Quote struct {
-	CarrierID     uuid.UUID       `json:"carrier_id"`
-	CarrierName   string          `json:"carrier_name"`
-	Price         decimal.Decimal `json:"price"`
-	EstimatedDays int             `json:"estimated_days"`
+	ID                      uuid.UUID        `json:"id"`
+	RequestID               uuid.UUID        `json:"request_id"`
+	OrderID                 uuid.UUID        `json:"order_id"`
+	CarrierID               uuid.UUID        `json:"carrier_id"`
+	CarrierName             string           `json:"carrier_name"`
+	PolicyID                uuid.UUID        `json:"policy_id"`
+	PolicyVersion           int              `json:"policy_version"`
+	ChargeableWeightKg      decimal.Decimal  `json:"chargeable_weight_kg"`
+	Price                   decimal.Decimal  `json:"price"`
+	EstimatedDays           int              `json:"estimated_days"`
+	BasePrice               decimal.Decimal  `json:"base_price"`
+	FuelSurchargePercentage decimal.Decimal  `json:"fuel_surcharge_percentage"`
+	FuelSurcharge           decimal.Decimal  `json:"fuel_surcharge"`
+	AdValorem               decimal.Decimal  `json:"ad_valorem"`
+	GRIS                    decimal.Decimal  `json:"gris"`
+	Toll                    decimal.Decimal  `json:"toll"`
+	TDE                     decimal.Decimal  `json:"tde"`
+	DiscountID              *uuid.UUID       `json:"discount_id"`
+	Discount                decimal.Decimal  `json:"discount"`
+	ICMSRate                decimal.Decimal  `json:"icms_rate"`
+	ICMS                    decimal.Decimal  `json:"icms"`
+	Breakdown               []PriceLine      `json:"breakdown"`
+	Explanation             PriceExplanation `json:"explanation"`
+	Reliability             decimal.Decimal  `json:"reliability"`
+	Score                   decimal.Decimal  `json:"score"`
+	Recommended             bool             `json:"recommended"`
+	ExpiresAt               time.Time        `json:"expires_at"`
+	CreatedAt               time.Time        `json:"created_at"`
+}
+
+func (c *Contract) applyPrice(calc PriceCalculation) {
+	c.Price = calc.Price
+	c.FuelSurchargePercentage = calc.FuelSurchargePercentage
+	c.FuelSurcharge = calc.FuelSurcharge
+	c.AdValorem = calc.AdValorem
+	c.GRIS = calc.GRIS
+	c.Toll = calc.Toll
+	c.TDE = calc.TDE
+	c.DiscountID = calc.DiscountID
+	c.Discount = calc.Discount
+	c.ICMSRate = calc.ICMSRate
+	c.ICMS = calc.ICMS
+	c.Breakdown = calc.Breakdown
+	c.Explanation = calc.Explanation
+}
+
+func (q *Quote) applyPrice(calc PriceCalculation) {
+	q.BasePrice = calc.BasePrice
+	q.Price = calc.Price
+	q.FuelSurchargePercentage = calc.FuelSurchargePercentage
+	q.FuelSurcharge = calc.FuelSurcharge
+	q.AdValorem = calc.AdValorem
+	q.GRIS = calc.GRIS
+	q.Toll = calc.Toll
+	q.TDE = calc.TDE
+	q.DiscountID = calc.DiscountID
+	q.Discount = calc.Discount
+	q.ICMSRate = calc.ICMSRate
+	q.ICMS = calc.ICMS
+	q.Breakdown = calc.Breakdown
+	q.Explanation = calc.Explanation
+}
+
+func (q Quote) priceCalculation() PriceCalculation {
+	return PriceCalculation{
+		BasePrice:               q.BasePrice,
+		FuelSurchargePercentage: q.FuelSurchargePercentage,
+		FuelSurcharge:           q.FuelSurcharge,
+		AdValorem:               q.AdValorem,
+		GRIS:                    q.GRIS,
+		Toll:                    q.Toll,
+		TDE:                     q.TDE,
+		DiscountID:              q.DiscountID,
+		Discount:                q.Discount,
+		Price:                   q.Price,
+		ICMSRate:                q.ICMSRate,
+		ICMS:                    q.ICMS,
+		Breakdown:               q.Breakdown,
+		Explanation:             q.Explanation,
+	}
+}
+
+func (c Contract) TotalPrice() decimal.Decimal {
+	return c.Price.Add(c.ICMS)
+}
+
+func (q Quote) TotalPrice() decimal.Decimal {
+	return q.Price.Add(q.ICMS)
+}
+
+type OrderQuotes struct {
+	OrderID uuid.UUID `json:"order_id"`
+	Quotes  []*Quote  `json:"quotes"`
+	Err     error     `json:"-"`
+}
+
+type Simulation struct {
+	DestinationUF states.State    `json:"destination_uf"`
+	WeightKg      decimal.Decimal `json:"weight_kg"`
+	LengthCm      decimal.Decimal `json:"length_cm"`
+	WidthCm       decimal.Decimal `json:"width_cm"`
+	HeightCm      decimal.Decimal `json:"height_cm"`
+	DeclaredValue decimal.Decimal `json:"declared_value"`
+}
+
+func (s Simulation) CubicWeightKg() decimal.Decimal {
+	return s.LengthCm.Mul(s.WidthCm).Mul(s.HeightCm).
+		Mul(cubingFactorKgPerM3).
+		Div(cubicCmPerM3).
+		Round(3)
+}
+
+func (s Simulation) ChargeableWeightKg() decimal.Decimal {
+	if cubic := s.CubicWeightKg(); cubic.GreaterThan(s.WeightKg) {
+		return cubic
+	}
+	return s.WeightKg
+}
+
+type DeliveryStats struct {
+	Delivered int `json:"delivered"`
+	Finished  int `json:"finished"`
+}
+
+func (d DeliveryStats) Reliability() decimal.Decimal {
+	return decimal.NewFromInt(int64(d.Delivered + 1)).
+		Div(decimal.NewFromInt(int64(d.Finished + 2))).
+		Round(4)
 }
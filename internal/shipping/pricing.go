@@ -0,0 +1,334 @@
+package shipping
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	"github.com/victorvcruz/shipment-coordinator/pkg/tax"
+)
+
+type PricingRuleKind string
+
+const (
+	PricingRuleSurchargePercentage PricingRuleKind = "surcharge_percentage"
+	PricingRuleSurchargeFixed      PricingRuleKind = "surcharge_fixed"
+	PricingRuleDiscountPercentage  PricingRuleKind = "discount_percentage"
+	PricingRuleDiscountFixed       PricingRuleKind = "discount_fixed"
+	PricingRuleMinimumPrice        PricingRuleKind = "minimum_price"
+	PricingRuleRounding            PricingRuleKind = "rounding"
+)
+
+var PricingRuleKinds = map[string]PricingRuleKind{
+	"surcharge_percentage": PricingRuleSurchargePercentage,
+	"surcharge_fixed":      PricingRuleSurchargeFixed,
+	"discount_percentage":  PricingRuleDiscountPercentage,
+	"discount_fixed":       PricingRuleDiscountFixed,
+	"minimum_price":        PricingRuleMinimumPrice,
+	"rounding":             PricingRuleRounding,
+}
+
+const (
+	PriceLineBaseFreight   = "base_freight"
+	PriceLineFuelSurcharge = "fuel_surcharge"
+	PriceLineAdValorem     = "ad_valorem"
+	PriceLineGRIS          = "gris"
+	PriceLineToll          = "toll"
+	PriceLineTDE           = "tde"
+	PriceLineDiscount      = "negotiated_discount"
+	PriceLineCarrierRate   = "carrier_rate"
+	PriceLineCentRounding  = "cent_rounding"
+)
+
+const (
+	PriceSourcePolicy      = "policy"
+	PriceSourceCarrierRate = "carrier_rate"
+)
+
+var pricingRuleStages = map[PricingRuleKind]int{
+	PricingRuleSurchargePercentage: 1,
+	PricingRuleSurchargeFixed:      1,
+	PricingRuleDiscountPercentage:  2,
+	PricingRuleDiscountFixed:       2,
+	PricingRuleMinimumPrice:        3,
+	PricingRuleRounding:            4,
+}
+
+var (
+	hundred        = decimal.NewFromInt(100)
+	tollFractionKg = decimal.NewFromInt(100)
+)
+
+type PricingRule struct {
+	ID        uuid.UUID       `json:"id"`
+	CarrierID *uuid.UUID      `json:"carrier_id"`
+	Name      string          `json:"name"`
+	Kind      PricingRuleKind `json:"kind"`
+	Value     decimal.Decimal `json:"value"`
+	Position  int             `json:"position"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type PriceLine struct {
+	Rule        string          `json:"rule"`
+	Description string          `json:"description"`
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+type PriceExplanation struct {
+	Source             string          `json:"source"`
+	PolicyID           *uuid.UUID      `json:"policy_id"`
+	PolicyVersion      int             `json:"policy_version"`
+	Region             string          `json:"region"`
+	OriginUF           string          `json:"origin_uf"`
+	DestinationUF      string          `json:"destination_uf"`
+	ChargeableWeightKg decimal.Decimal `json:"chargeable_weight_kg"`
+	RatePerKg          decimal.Decimal `json:"rate_per_kg"`
+	CarrierReference   string          `json:"carrier_reference,omitempty"`
+	Rounding           decimal.Decimal `json:"rounding"`
+}
+
+type PriceInput struct {
+	CarrierID               uuid.UUID
+	Policy                  carrier.Policy
+	Origin                  states.State
+	Destination             states.State
+	WeightKg                decimal.Decimal
+	DeclaredValue           decimal.Decimal
+	FuelSurchargePercentage decimal.Decimal
+	Discount                *carrier.Discount
+}
+
+type PriceCalculation struct {
+	BasePrice               decimal.Decimal
+	FuelSurchargePercentage decimal.Decimal
+	FuelSurcharge           decimal.Decimal
+	AdValorem               decimal.Decimal
+	GRIS                    decimal.Decimal
+	Toll                    decimal.Decimal
+	TDE                     decimal.Decimal
+	DiscountID              *uuid.UUID
+	Discount                decimal.Decimal
+	Price                   decimal.Decimal
+	ICMSRate                decimal.Decimal
+	ICMS                    decimal.Decimal
+	Breakdown               []PriceLine
+	Explanation             PriceExplanation
+}
+
+type PricingEngine struct {
+	rules []PricingRule
+}
+
+func NewPricingEngine(rules []PricingRule) *PricingEngine {
+	sorted := make([]PricingRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		si, sj := pricingRuleStages[sorted[i].Kind], pricingRuleStages[sorted[j].Kind]
+		if si != sj {
+			return si < sj
+		}
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	return &PricingEngine{rules: sorted}
+}
+
+func (e *PricingEngine) RulesFor(carrierID uuid.UUID) []PricingRule {
+	overridden := map[PricingRuleKind]bool{}
+	for _, r := range e.rules {
+		if r.CarrierID != nil && *r.CarrierID == carrierID {
+			overridden[r.Kind] = true
+		}
+	}
+
+	rules := []PricingRule{}
+	for _, r := range e.rules {
+		if r.CarrierID == nil {
+			if !overridden[r.Kind] {
+				rules = append(rules, r)
+			}
+			continue
+		}
+		if *r.CarrierID == carrierID {
+			rules = append(rules, r)
+		}
+	}
+
+	return rules
+}
+
+func (e *PricingEngine) Price(in PriceInput) PriceCalculation {
+	basePrice := in.Policy.PricePerKg.Mul(in.WeightKg)
+	calc := PriceCalculation{
+		BasePrice:               basePrice,
+		FuelSurchargePercentage: in.FuelSurchargePercentage,
+		FuelSurcharge:           percentageOf(basePrice, in.FuelSurchargePercentage),
+		AdValorem:               percentageOf(in.DeclaredValue, in.Policy.AdValoremPercentage),
+		GRIS:                    gris(in.DeclaredValue, in.Policy),
+		Toll:                    toll(in.WeightKg, in.Policy.TollPerFraction),
+		TDE:                     decimal.Zero,
+		Discount:                decimal.Zero,
+		Price:                   basePrice,
+		Breakdown: []PriceLine{
+			{Rule: PriceLineBaseFreight, Description: "Base freight", Amount: basePrice},
+		},
+		Explanation: PriceExplanation{
+			Source:             PriceSourcePolicy,
+			PolicyID:           &in.Policy.ID,
+			PolicyVersion:      in.Policy.Version,
+			Region:             in.Policy.Region.Name,
+			OriginUF:           in.Origin.Sigla,
+			DestinationUF:      in.Destination.Sigla,
+			ChargeableWeightKg: in.WeightKg,
+			RatePerKg:          in.Policy.PricePerKg,
+		},
+	}
+	if in.Policy.AppliesTDE(in.Destination) {
+		calc.TDE = percentageOf(basePrice, in.Policy.TDEPercentage)
+	}
+
+	components := []PriceLine{
+		{
+			Rule:        PriceLineFuelSurcharge,
+			Description: "Fuel surcharge " + in.FuelSurchargePercentage.StringFixed(2) + "%",
+			Amount:      calc.FuelSurcharge,
+		},
+		{Rule: PriceLineAdValorem, Description: "Frete-valor", Amount: calc.AdValorem},
+		{Rule: PriceLineGRIS, Description: "GRIS", Amount: calc.GRIS},
+		{Rule: PriceLineToll, Description: "Pedágio", Amount: calc.Toll},
+		{Rule: PriceLineTDE, Description: "TDE " + in.Destination.Sigla, Amount: calc.TDE},
+	}
+	for _, line := range components {
+		if !line.Amount.IsZero() {
+			calc.add(line)
+		}
+	}
+
+	if in.Discount != nil {
+		calc.DiscountID = &in.Discount.ID
+		calc.Discount = percentageOf(calc.Price, in.Discount.Percentage)
+		if !calc.Discount.IsZero() {
+			calc.add(PriceLine{
+				Rule:        PriceLineDiscount,
+				Description: in.Discount.Name,
+				Amount:      calc.Discount.Neg(),
+			})
+		}
+	}
+
+	if e != nil {
+		for _, r := range e.RulesFor(in.CarrierID) {
+			amount := r.amount(calc.Price)
+			if amount.IsZero() {
+				continue
+			}
+
+			description := r.Name
+			if description == "" {
+				description = string(r.Kind)
+			}
+			calc.add(PriceLine{Rule: string(r.Kind), Description: description, Amount: amount})
+		}
+	}
+
+	if rounded := calc.Price.Round(2); !rounded.Equal(calc.Price) {
+		calc.add(PriceLine{
+			Rule:        PriceLineCentRounding,
+			Description: "Rounding to cents",
+			Amount:      rounded.Sub(calc.Price),
+		})
+	}
+	calc.Explanation.Rounding = calc.rounding()
+
+	icms := tax.Route{Origin: in.Origin, Destination: in.Destination}.ICMS(calc.Price)
+	calc.ICMSRate = icms.Rate
+	calc.ICMS = icms.Amount
+
+	return calc
+}
+
+func gatewayPrice(rate carriergateway.Rate, policy PriceExplanation, origin, destination states.State) PriceCalculation {
+	explanation := policy
+	explanation.Source = PriceSourceCarrierRate
+	explanation.RatePerKg = decimal.Zero
+	explanation.CarrierReference = rate.Reference
+	explanation.Rounding = decimal.Zero
+
+	calc := PriceCalculation{
+		BasePrice: rate.Price,
+		Price:     rate.Price,
+		Breakdown: []PriceLine{
+			{Rule: PriceLineCarrierRate, Description: "Carrier real-time rate", Amount: rate.Price},
+		},
+		Explanation: explanation,
+	}
+
+	icms := tax.Route{Origin: origin, Destination: destination}.ICMS(calc.Price)
+	calc.ICMSRate = icms.Rate
+	calc.ICMS = icms.Amount
+
+	return calc
+}
+
+func (c *PriceCalculation) add(line PriceLine) {
+	c.Price = c.Price.Add(line.Amount)
+	c.Breakdown = append(c.Breakdown, line)
+}
+
+func (c PriceCalculation) rounding() decimal.Decimal {
+	total := decimal.Zero
+	for _, line := range c.Breakdown {
+		if line.Rule == string(PricingRuleRounding) || line.Rule == PriceLineCentRounding {
+			total = total.Add(line.Amount)
+		}
+	}
+	return total
+}
+
+func (r PricingRule) amount(total decimal.Decimal) decimal.Decimal {
+	switch r.Kind {
+	case PricingRuleSurchargePercentage:
+		return total.Mul(r.Value).Div(hundred).Round(2)
+	case PricingRuleSurchargeFixed:
+		return r.Value
+	case PricingRuleDiscountPercentage:
+		return total.Mul(r.Value).Div(hundred).Round(2).Neg()
+	case PricingRuleDiscountFixed:
+		return decimal.Min(r.Value, total).Neg()
+	case PricingRuleMinimumPrice:
+		if total.LessThan(r.Value) {
+			return r.Value.Sub(total)
+		}
+	case PricingRuleRounding:
+		if r.Value.IsPositive() {
+			return total.Div(r.Value).Ceil().Mul(r.Value).Sub(total)
+		}
+	}
+	return decimal.Zero
+}
+
+func percentageOf(amount, percentage decimal.Decimal) decimal.Decimal {
+	if percentage.IsZero() || amount.IsZero() {
+		return decimal.Zero
+	}
+	return amount.Mul(percentage).Div(hundred).Round(2)
+}
+
+func gris(declaredValue decimal.Decimal, policy carrier.Policy) decimal.Decimal {
+	if policy.GRISPercentage.IsZero() && policy.GRISMinimum.IsZero() {
+		return decimal.Zero
+	}
+	return decimal.Max(percentageOf(declaredValue, policy.GRISPercentage), policy.GRISMinimum)
+}
+
+func toll(weightKg, perFraction decimal.Decimal) decimal.Decimal {
+	if perFraction.IsZero() || !weightKg.IsPositive() {
+		return decimal.Zero
+	}
+	return weightKg.Div(tollFractionKg).Ceil().Mul(perFraction)
+}
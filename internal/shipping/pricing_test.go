@@ -0,0 +1,236 @@
+package shipping_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+)
+
+func priceInput(carrierID uuid.UUID, basePrice, fuelPercentage decimal.Decimal) shipping.PriceInput {
+	return shipping.PriceInput{
+		CarrierID:               carrierID,
+		Policy:                  carrier.Policy{PricePerKg: basePrice},
+		Destination:             states.SP,
+		WeightKg:                decimal.NewFromInt(1),
+		FuelSurchargePercentage: fuelPercentage,
+	}
+}
+
+func TestPricingEngine_AppliesRulesInStageOrder(t *testing.T) {
+	engine := shipping.NewPricingEngine([]shipping.PricingRule{
+		{Kind: shipping.PricingRuleRounding, Value: decimal.NewFromFloat(5)},
+		{Kind: shipping.PricingRuleDiscountPercentage, Value: decimal.NewFromFloat(10)},
+		{Name: "Insurance", Kind: shipping.PricingRuleSurchargeFixed, Value: decimal.NewFromFloat(5)},
+	})
+
+	calc := engine.Price(priceInput(uuid.New(), decimal.NewFromFloat(50), decimal.NewFromFloat(10)))
+
+	assert.Equal(t, "55.00", calc.Price.StringFixed(2))
+	assert.Equal(t, "5.00", calc.FuelSurcharge.StringFixed(2))
+	assert.Len(t, calc.Breakdown, 5)
+
+	rules := make([]string, len(calc.Breakdown))
+	for i, l := range calc.Breakdown {
+		rules[i] = l.Rule
+	}
+	assert.Equal(t, []string{
+		shipping.PriceLineBaseFreight,
+		shipping.PriceLineFuelSurcharge,
+		string(shipping.PricingRuleSurchargeFixed),
+		string(shipping.PricingRuleDiscountPercentage),
+		string(shipping.PricingRuleRounding),
+	}, rules)
+	assert.Equal(t, "Insurance", calc.Breakdown[2].Description)
+	assert.Equal(t, "-6.00", calc.Breakdown[3].Amount.StringFixed(2))
+	assert.Equal(t, "1.00", calc.Breakdown[4].Amount.StringFixed(2))
+}
+
+func TestPricingEngine_CarrierRuleOverridesGlobal(t *testing.T) {
+	carrierID := uuid.New()
+	engine := shipping.NewPricingEngine([]shipping.PricingRule{
+		{Kind: shipping.PricingRuleMinimumPrice, Value: decimal.NewFromFloat(30)},
+		{CarrierID: &carrierID, Kind: shipping.PricingRuleMinimumPrice, Value: decimal.NewFromFloat(15)},
+	})
+
+	own := engine.Price(priceInput(carrierID, decimal.NewFromFloat(10), decimal.Zero))
+	assert.Equal(t, "15.00", own.Price.StringFixed(2))
+
+	other := engine.Price(priceInput(uuid.New(), decimal.NewFromFloat(10), decimal.Zero))
+	assert.Equal(t, "30.00", other.Price.StringFixed(2))
+	assert.Len(t, other.Breakdown, 2)
+}
+
+func TestPricingEngine_SkipsRulesWithoutEffect(t *testing.T) {
+	engine := shipping.NewPricingEngine([]shipping.PricingRule{
+		{Kind: shipping.PricingRuleMinimumPrice, Value: decimal.NewFromFloat(5)},
+		{Kind: shipping.PricingRuleRounding, Value: decimal.NewFromFloat(1)},
+	})
+
+	calc := engine.Price(priceInput(uuid.New(), decimal.NewFromFloat(20), decimal.Zero))
+
+	assert.Equal(t, decimal.NewFromFloat(20), calc.Price)
+	assert.Len(t, calc.Breakdown, 1)
+	assert.Equal(t, shipping.PriceLineBaseFreight, calc.Breakdown[0].Rule)
+}
+
+func TestPricingEngine_FixedDiscountNeverGoesNegative(t *testing.T) {
+	engine := shipping.NewPricingEngine([]shipping.PricingRule{
+		{Kind: shipping.PricingRuleDiscountFixed, Value: decimal.NewFromFloat(50)},
+	})
+
+	calc := engine.Price(priceInput(uuid.New(), decimal.NewFromFloat(20), decimal.Zero))
+
+	assert.True(t, calc.Price.IsZero())
+}
+
+func TestPricingEngine_ItemisesFreightComponents(t *testing.T) {
+	policy := carrier.Policy{
+		Region:              states.Norte,
+		PricePerKg:          decimal.NewFromFloat(2),
+		AdValoremPercentage: decimal.NewFromFloat(0.3),
+		GRISPercentage:      decimal.NewFromFloat(0.1),
+		GRISMinimum:         decimal.NewFromFloat(5),
+		TollPerFraction:     decimal.NewFromFloat(4.5),
+		TDEPercentage:       decimal.NewFromFloat(20),
+		TDEUFs:              []string{"AM"},
+	}
+
+	calc := shipping.NewPricingEngine(nil).Price(shipping.PriceInput{
+		CarrierID:     uuid.New(),
+		Policy:        policy,
+		Destination:   states.AM,
+		WeightKg:      decimal.NewFromFloat(150),
+		DeclaredValue: decimal.NewFromFloat(2000),
+	})
+
+	assert.Equal(t, "300.00", calc.BasePrice.StringFixed(2))
+	assert.Equal(t, "6.00", calc.AdValorem.StringFixed(2))
+	assert.Equal(t, "5.00", calc.GRIS.StringFixed(2))
+	assert.Equal(t, "9.00", calc.Toll.StringFixed(2))
+	assert.Equal(t, "60.00", calc.TDE.StringFixed(2))
+	assert.Equal(t, "380.00", calc.Price.StringFixed(2))
+
+	rules := make([]string, len(calc.Breakdown))
+	for i, l := range calc.Breakdown {
+		rules[i] = l.Rule
+	}
+	assert.Equal(t, []string{
+		shipping.PriceLineBaseFreight,
+		shipping.PriceLineAdValorem,
+		shipping.PriceLineGRIS,
+		shipping.PriceLineToll,
+		shipping.PriceLineTDE,
+	}, rules)
+}
+
+func TestPricingEngine_TDEOnlyForListedUFs(t *testing.T) {
+	policy := carrier.Policy{
+		Region:        states.Norte,
+		PricePerKg:    decimal.NewFromFloat(2),
+		TDEPercentage: decimal.NewFromFloat(20),
+		TDEUFs:        []string{"AM"},
+	}
+
+	calc := shipping.NewPricingEngine(nil).Price(shipping.PriceInput{
+		CarrierID:   uuid.New(),
+		Policy:      policy,
+		Destination: states.PA,
+		WeightKg:    decimal.NewFromFloat(10),
+	})
+
+	assert.True(t, calc.TDE.IsZero())
+	assert.Equal(t, "20.00", calc.Price.StringFixed(2))
+	assert.Len(t, calc.Breakdown, 1)
+}
+
+func TestPricingEngine_ComputesICMSOverNetFreight(t *testing.T) {
+	engine := shipping.NewPricingEngine([]shipping.PricingRule{
+		{Kind: shipping.PricingRuleSurchargeFixed, Value: decimal.NewFromFloat(3)},
+	})
+
+	calc := engine.Price(shipping.PriceInput{
+		CarrierID:   uuid.New(),
+		Policy:      carrier.Policy{PricePerKg: decimal.NewFromFloat(9)},
+		Origin:      states.SP,
+		Destination: states.BA,
+		WeightKg:    decimal.NewFromFloat(10),
+	})
+
+	assert.Equal(t, "93.00", calc.Price.StringFixed(2))
+	assert.Equal(t, "7.00", calc.ICMSRate.StringFixed(2))
+	assert.Equal(t, "7.00", calc.ICMS.StringFixed(2))
+	assert.Len(t, calc.Breakdown, 2)
+}
+
+func TestPricingEngine_AppliesNegotiatedDiscountBeforeRules(t *testing.T) {
+	carrierID := uuid.New()
+	engine := shipping.NewPricingEngine([]shipping.PricingRule{
+		{Kind: shipping.PricingRuleMinimumPrice, Value: decimal.NewFromInt(95)},
+	})
+
+	in := priceInput(carrierID, decimal.NewFromInt(100), decimal.Zero)
+	in.Discount = &carrier.Discount{ID: uuid.New(), Name: "Volume agreement", Percentage: decimal.NewFromInt(10)}
+	calc := engine.Price(in)
+
+	assert.Equal(t, "10.00", calc.Discount.StringFixed(2))
+	assert.Equal(t, in.Discount.ID, *calc.DiscountID)
+	assert.Equal(t, "95.00", calc.Price.StringFixed(2))
+	assert.Len(t, calc.Breakdown, 3)
+	assert.Equal(t, shipping.PriceLineDiscount, calc.Breakdown[1].Rule)
+	assert.Equal(t, "Volume agreement", calc.Breakdown[1].Description)
+	assert.Equal(t, "-10.00", calc.Breakdown[1].Amount.StringFixed(2))
+	assert.Equal(t, string(shipping.PricingRuleMinimumPrice), calc.Breakdown[2].Rule)
+}
+
+func TestPricingEngine_ExplainsMatchedPolicy(t *testing.T) {
+	policy := carrier.Policy{
+		ID:         uuid.New(),
+		Region:     states.Sudeste,
+		Version:    3,
+		PricePerKg: decimal.RequireFromString("1.337"),
+	}
+	engine := shipping.NewPricingEngine([]shipping.PricingRule{
+		{Kind: shipping.PricingRuleRounding, Value: decimal.NewFromInt(1)},
+	})
+
+	calc := engine.Price(shipping.PriceInput{
+		CarrierID:   uuid.New(),
+		Policy:      policy,
+		Origin:      states.SP,
+		Destination: states.RJ,
+		WeightKg:    decimal.RequireFromString("7.5"),
+	})
+
+	explanation := calc.Explanation
+	assert.Equal(t, shipping.PriceSourcePolicy, explanation.Source)
+	assert.Equal(t, policy.ID, *explanation.PolicyID)
+	assert.Equal(t, 3, explanation.PolicyVersion)
+	assert.Equal(t, "Sudeste", explanation.Region)
+	assert.Equal(t, "SP", explanation.OriginUF)
+	assert.Equal(t, "RJ", explanation.DestinationUF)
+	assert.Equal(t, "7.500", explanation.ChargeableWeightKg.StringFixed(3))
+	assert.Equal(t, "1.337", explanation.RatePerKg.String())
+
+	assert.Equal(t, "11.00", calc.Price.StringFixed(2))
+	assert.Equal(t, "0.97", explanation.Rounding.StringFixed(2))
+}
+
+func TestPricingEngine_RoundsPriceToCents(t *testing.T) {
+	calc := (*shipping.PricingEngine)(nil).Price(shipping.PriceInput{
+		CarrierID:   uuid.New(),
+		Policy:      carrier.Policy{PricePerKg: decimal.RequireFromString("1.337")},
+		Destination: states.SP,
+		WeightKg:    decimal.NewFromInt(3),
+	})
+
+	last := calc.Breakdown[len(calc.Breakdown)-1]
+	assert.Equal(t, shipping.PriceLineCentRounding, last.Rule)
+	assert.Equal(t, "-0.001", last.Amount.String())
+	assert.Equal(t, "4.01", calc.Price.String())
+	assert.Equal(t, "-0.001", calc.Explanation.Rounding.String())
+}
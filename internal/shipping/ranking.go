@@ -0,0 +1,121 @@
+package shipping
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+type RankingStrategy string
+
+const (
+	RankingCheapest  RankingStrategy = "cheapest"
+	RankingFastest   RankingStrategy = "fastest"
+	RankingBestValue RankingStrategy = "best_value"
+)
+
+var RankingStrategies = map[string]RankingStrategy{
+	"cheapest":   RankingCheapest,
+	"fastest":    RankingFastest,
+	"best_value": RankingBestValue,
+}
+
+type RankingWeights struct {
+	Price       float64
+	Days        float64
+	Reliability float64
+}
+
+var DefaultRankingWeights = RankingWeights{Price: 0.5, Days: 0.3, Reliability: 0.2}
+
+func (w RankingWeights) normalized() RankingWeights {
+	w.Price = max(w.Price, 0)
+	w.Days = max(w.Days, 0)
+	w.Reliability = max(w.Reliability, 0)
+
+	total := w.Price + w.Days + w.Reliability
+	if total <= 0 {
+		return DefaultRankingWeights
+	}
+
+	return RankingWeights{
+		Price:       w.Price / total,
+		Days:        w.Days / total,
+		Reliability: w.Reliability / total,
+	}
+}
+
+func RankQuotes(quotes []*Quote, strategy RankingStrategy, weights RankingWeights) {
+	if len(quotes) == 0 {
+		return
+	}
+
+	scoreQuotes(quotes, weights.normalized())
+
+	var less func(a, b *Quote) bool
+	switch strategy {
+	case RankingFastest:
+		less = func(a, b *Quote) bool {
+			if a.EstimatedDays != b.EstimatedDays {
+				return a.EstimatedDays < b.EstimatedDays
+			}
+			return a.Price.LessThan(b.Price)
+		}
+	case RankingBestValue:
+		less = func(a, b *Quote) bool {
+			if !a.Score.Equal(b.Score) {
+				return a.Score.GreaterThan(b.Score)
+			}
+			return a.Price.LessThan(b.Price)
+		}
+	default:
+		less = func(a, b *Quote) bool {
+			if !a.Price.Equal(b.Price) {
+				return a.Price.LessThan(b.Price)
+			}
+			return a.EstimatedDays < b.EstimatedDays
+		}
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		if less(quotes[i], quotes[j]) != less(quotes[j], quotes[i]) {
+			return less(quotes[i], quotes[j])
+		}
+		return quotes[i].CarrierName < quotes[j].CarrierName
+	})
+
+	for i, q := range quotes {
+		q.Recommended = i == 0
+	}
+}
+
+func scoreQuotes(quotes []*Quote, weights RankingWeights) {
+	minPrice := quotes[0].Price
+	minDays := quotes[0].EstimatedDays
+	for _, q := range quotes[1:] {
+		if q.Price.LessThan(minPrice) {
+			minPrice = q.Price
+		}
+		if q.EstimatedDays < minDays {
+			minDays = q.EstimatedDays
+		}
+	}
+
+	one := decimal.NewFromInt(1)
+	for _, q := range quotes {
+		priceScore := one
+		if q.Price.IsPositive() {
+			priceScore = minPrice.Div(q.Price)
+		}
+
+		daysScore := one
+		if q.EstimatedDays > 0 {
+			daysScore = decimal.NewFromInt(int64(minDays)).Div(decimal.NewFromInt(int64(q.EstimatedDays)))
+		}
+
+		q.Score = priceScore.Mul(decimal.NewFromFloat(weights.Price)).
+			Add(daysScore.Mul(decimal.NewFromFloat(weights.Days))).
+			Add(q.Reliability.Mul(decimal.NewFromFloat(weights.Reliability))).
+			Round(4)
+	}
+}
@@ -0,0 +1,89 @@
+package shipping_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+)
+
+func rankingQuotes() []*shipping.Quote {
+	return []*shipping.Quote{
+		{
+			CarrierID:     uuid.New(),
+			CarrierName:   "Slow",
+			Price:         decimal.NewFromFloat(20),
+			EstimatedDays: 10,
+			Reliability:   decimal.NewFromFloat(0.9),
+		},
+		{
+			CarrierID:     uuid.New(),
+			CarrierName:   "Fast",
+			Price:         decimal.NewFromFloat(40),
+			EstimatedDays: 2,
+			Reliability:   decimal.NewFromFloat(0.9),
+		},
+		{
+			CarrierID:     uuid.New(),
+			CarrierName:   "Balanced",
+			Price:         decimal.NewFromFloat(24),
+			EstimatedDays: 4,
+			Reliability:   decimal.NewFromFloat(0.95),
+		},
+	}
+}
+
+func TestRankQuotes_Cheapest(t *testing.T) {
+	quotes := rankingQuotes()
+	shipping.RankQuotes(quotes, shipping.RankingCheapest, shipping.RankingWeights{})
+
+	assert.Equal(t, "Slow", quotes[0].CarrierName)
+	assert.True(t, quotes[0].Recommended)
+	assert.False(t, quotes[1].Recommended)
+	assert.False(t, quotes[2].Recommended)
+}
+
+func TestRankQuotes_Fastest(t *testing.T) {
+	quotes := rankingQuotes()
+	shipping.RankQuotes(quotes, shipping.RankingFastest, shipping.RankingWeights{})
+
+	assert.Equal(t, "Fast", quotes[0].CarrierName)
+	assert.Equal(t, "Balanced", quotes[1].CarrierName)
+	assert.True(t, quotes[0].Recommended)
+}
+
+func TestRankQuotes_BestValue(t *testing.T) {
+	quotes := rankingQuotes()
+	shipping.RankQuotes(quotes, shipping.RankingBestValue, shipping.DefaultRankingWeights)
+
+	assert.Equal(t, "Balanced", quotes[0].CarrierName)
+	assert.True(t, quotes[0].Recommended)
+	assert.True(t, quotes[0].Score.GreaterThan(quotes[1].Score))
+}
+
+func TestRankQuotes_Empty(t *testing.T) {
+	assert.NotPanics(t, func() {
+		shipping.RankQuotes(nil, shipping.RankingBestValue, shipping.RankingWeights{})
+	})
+}
+
+func TestDeliveryStats_Reliability(t *testing.T) {
+	assert.Equal(t, "0.5000", shipping.DeliveryStats{}.Reliability().StringFixed(4))
+	assert.Equal(t, "0.9167", shipping.DeliveryStats{Delivered: 10, Finished: 10}.Reliability().StringFixed(4))
+}
+
+func TestRankQuotes_NormalisesWeights(t *testing.T) {
+	scaled := rankingQuotes()
+	shipping.RankQuotes(scaled, shipping.RankingBestValue, shipping.RankingWeights{Price: 500, Days: 300, Reliability: 200})
+
+	quotes := rankingQuotes()
+	shipping.RankQuotes(quotes, shipping.RankingBestValue, shipping.DefaultRankingWeights)
+
+	for i := range quotes {
+		assert.Equal(t, quotes[i].CarrierName, scaled[i].CarrierName)
+		assert.True(t, quotes[i].Score.Equal(scaled[i].Score))
+		assert.True(t, scaled[i].Score.LessThanOrEqual(decimal.NewFromInt(1)))
+	}
+}
@@ -1,47 +1,1074 @@
 package shipping
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
 )
 
+var (
+	ErrContractAlreadyExists = errors.New("order already has an active contract")
+	ErrContractNotFound      = errors.New("contract not found")
+	ErrContractNotActive     = errors.New("contract is not active")
+	ErrTrackingCodeTaken     = errors.New("tracking code already used by another contract of the carrier")
+	ErrQuoteNotFound         = errors.New("quote not found")
+	ErrLoadNotFound          = errors.New("load not found")
+	ErrLoadNotPlanned        = errors.New("load is not planned")
+	ErrLoadOutdated          = errors.New("load orders changed since it was planned")
+	ErrOrderAlreadyLoaded    = errors.New("order already belongs to a planned load")
+)
+
+const (
+	uniqueViolationCode          = "23505"
+	uniqueActiveContractPerOrder = "unique_active_contract_per_order"
+	uniqueTrackingCodePerCarrier = "unique_tracking_code_per_carrier"
+	uniqueActiveLoadOrder        = "unique_active_load_order"
+)
+
+const contractReplacedReason = "replaced"
+
 const queryInsertContract = `
-	INSERT INTO contracts (order_id, carrier_id, price, estimated_days, contracted_at, created_at, updated_at)
-	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	INSERT INTO contracts (order_id, carrier_id, price, estimated_days, status, quote_id,
+	                       fuel_surcharge_percentage, fuel_surcharge, ad_valorem, gris, toll, tde,
+	                       discount_id, discount, icms_rate, icms, price_breakdown, price_explanation,
+	                       load_id, booking_status, next_booking_at, contracted_at, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+	        $21, $22, $23, $24)
 	RETURNING id
 `
 
+const (
+	contractColumns = `
+	id, order_id, carrier_id, price, estimated_days, status, quote_id, tracking_code,
+	fuel_surcharge_percentage, fuel_surcharge, ad_valorem, gris, toll, tde,
+	discount_id, discount, icms_rate, icms, price_breakdown, price_explanation,
+	cancel_reason, cancelled_at, replaced_by, load_id, booking_status, booking_attempts, booking_error,
+	next_booking_at, contracted_at, created_at, updated_at`
+
+	querySelectContractByID = `
+	SELECT` + contractColumns + `
+	FROM contracts
+	WHERE id = $1
+`
+
+	querySelectActiveContractByOrder = `
+	SELECT` + contractColumns + `
+	FROM contracts
+	WHERE order_id = $1 AND status = 'active'
+`
+
+	querySelectContractByTrackingCode = `
+	SELECT` + contractColumns + `
+	FROM contracts
+	WHERE carrier_id = $1 AND tracking_code = $2
+`
+
+	queryListContracts = `
+	SELECT` + contractColumns + `
+	FROM contracts
+	WHERE ($1::uuid IS NULL OR order_id = $1)
+	  AND ($2::uuid IS NULL OR carrier_id = $2)
+	  AND ($3::text IS NULL OR status = $3)
+	  AND ($4::timestamptz IS NULL OR contracted_at >= $4)
+	  AND ($5::timestamptz IS NULL OR contracted_at < $5)
+	ORDER BY contracted_at DESC
+	LIMIT $6 OFFSET $7
+`
+
+	queryCancelContract = `
+	UPDATE contracts
+	SET status = 'cancelled', cancel_reason = $2, cancelled_at = $3, updated_at = $3
+	WHERE id = $1 AND status = 'active'
+	RETURNING order_id, carrier_id
+`
+
+	queryVoidContract = `
+	UPDATE contracts
+	SET status = 'voided', updated_at = $2
+	WHERE id = $1 AND status = 'active'
+	RETURNING order_id, carrier_id
+`
+
+	querySetTrackingCode = `
+	UPDATE contracts
+	SET tracking_code = $2, updated_at = $3,
+	    booking_status = CASE WHEN booking_status = 'none' THEN 'none' ELSE 'booked' END,
+	    booking_error = NULL, next_booking_at = NULL
+	WHERE id = $1
+	RETURNING id
+`
+
+	queryRecordBookingFailure = `
+	UPDATE contracts
+	SET booking_attempts = booking_attempts + 1, booking_error = $2,
+	    booking_status = CASE WHEN $3::timestamptz IS NULL THEN 'failed' ELSE 'pending' END,
+	    next_booking_at = $3, updated_at = $4
+	WHERE id = $1 AND booking_status = 'pending'
+	RETURNING booking_attempts
+`
+
+	queryClaimPendingBookings = `
+	WITH due AS (
+		SELECT id AS due_id
+		FROM contracts
+		WHERE status = 'active' AND booking_status = 'pending' AND next_booking_at <= $1
+		ORDER BY next_booking_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE contracts
+	SET next_booking_at = $3
+	FROM due
+	WHERE id = due.due_id
+	RETURNING` + contractColumns + `
+`
+
+	querySetContractReplacement = `
+	UPDATE contracts
+	SET replaced_by = $2
+	WHERE id = $1
+`
+)
+
+const (
+	queryInsertQuoteRequest = `
+	INSERT INTO quote_requests (order_id, strategy, requested_at)
+	VALUES ($1, $2, $3)
+	RETURNING id
+`
+
+	queryInsertQuote = `
+	INSERT INTO quotes (request_id, order_id, carrier_id, policy_id, policy_version,
+	                    base_price, fuel_surcharge_percentage, fuel_surcharge, ad_valorem, gris, toll, tde,
+	                    discount_id, discount, price, icms_rate, icms, price_breakdown, price_explanation,
+	                    estimated_days, reliability, score, recommended, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+	        $21, $22, $23, $24, $25)
+	RETURNING id
+`
+
+	querySelectQuoteByID = `
+	SELECT q.id, q.request_id, q.order_id, q.carrier_id, c.name, q.policy_id, q.policy_version,
+	       q.base_price, q.fuel_surcharge_percentage, q.fuel_surcharge, q.ad_valorem, q.gris, q.toll, q.tde,
+	       q.discount_id, q.discount, q.price, q.icms_rate, q.icms, q.price_breakdown, q.price_explanation,
+	       q.estimated_days, q.reliability, q.score, q.recommended, q.expires_at, q.created_at
+	FROM quotes q
+	INNER JOIN carriers c ON c.id = q.carrier_id
+	WHERE q.id = $1
+`
+)
+
+const (
+	queryInsertPricingRule = `
+	INSERT INTO pricing_rules (carrier_id, name, kind, value, position, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id
+`
+
+	queryListPricingRules = `
+	SELECT id, carrier_id, name, kind, value, position, created_at
+	FROM pricing_rules
+	ORDER BY position, created_at
+`
+)
+
+const queryListDeliveryStats = `
+	SELECT c.carrier_id,
+	       COUNT(*) FILTER (WHERE o.status = 'delivered'),
+	       COUNT(*) FILTER (WHERE o.status IN ('delivered', 'lost'))
+	FROM contracts c
+	INNER JOIN orders o ON o.id = c.order_id
+	WHERE c.carrier_id = ANY($1) AND c.status = 'active'
+	GROUP BY c.carrier_id
+`
+
+const queryCountContractsSince = `
+	SELECT carrier_id, COUNT(*)
+	FROM contracts
+	WHERE carrier_id = ANY($1) AND status = 'active' AND contracted_at >= $2
+	GROUP BY carrier_id
+`
+
+const (
+	queryInsertLoad = `
+	INSERT INTO loads (carrier_id, region, destination_uf, status, weight_kg, declared_value, estimated_days,
+	                   base_price, fuel_surcharge_percentage, fuel_surcharge, ad_valorem, gris, toll, tde,
+	                   discount_id, discount, price, icms, price_breakdown, price_explanation, individual_price,
+	                   expires_at, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+	        $21, $22, $23, $24)
+	RETURNING id
+`
+
+	queryInsertLoadOrder = `
+	INSERT INTO load_orders (load_id, order_id, position, destination_uf, weight_kg, declared_value, tde)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+	querySelectLoad = `
+	SELECT l.id, l.carrier_id, c.name, l.region, l.destination_uf, l.status, l.weight_kg, l.declared_value,
+	       l.estimated_days, l.base_price, l.fuel_surcharge_percentage, l.fuel_surcharge, l.ad_valorem,
+	       l.gris, l.toll, l.tde, l.discount_id, l.discount, l.price, l.icms, l.price_breakdown,
+	       l.price_explanation, l.individual_price, l.expires_at, l.contracted_at, l.cancelled_at, l.created_at, l.updated_at
+	FROM loads l
+	INNER JOIN carriers c ON c.id = l.carrier_id
+	WHERE l.id = $1
+`
+
+	querySelectLoadOrders = `
+	SELECT order_id, destination_uf, weight_kg, declared_value, tde, contract_id
+	FROM load_orders
+	WHERE load_id = $1
+	ORDER BY position
+`
+
+	queryListLoadedOrderIDs = `
+	SELECT order_id
+	FROM load_orders
+	WHERE order_id = ANY($1) AND NOT released
+`
+
+	queryLockLoad = `
+	SELECT status
+	FROM loads
+	WHERE id = $1
+	FOR UPDATE
+`
+
+	querySetLoadOrderContract = `
+	UPDATE load_orders
+	SET contract_id = $3
+	WHERE load_id = $1 AND order_id = $2
+`
+
+	queryContractLoad = `
+	UPDATE loads
+	SET status = 'contracted', contracted_at = $2, updated_at = $2
+	WHERE id = $1
+`
+
+	queryCancelLoad = `
+	UPDATE loads
+	SET status = 'cancelled', cancelled_at = $2, updated_at = $2
+	WHERE id = $1
+`
+
+	queryReleaseLoadOrders = `
+	UPDATE load_orders
+	SET released = TRUE
+	WHERE load_id = $1
+`
+)
+
 //go:generate moq -pkg mocks -out mocks/repository.go . Repository
 type Repository interface {
 	Insert(ctx context.Context, c *Contract) (uuid.UUID, error)
+	Replace(ctx context.Context, previousID uuid.UUID, c *Contract) (uuid.UUID, error)
+	GetContractByID(ctx context.Context, id uuid.UUID) (*Contract, error)
+	GetActiveContractByOrder(ctx context.Context, orderID uuid.UUID) (*Contract, error)
+	GetContractByTrackingCode(ctx context.Context, carrierID uuid.UUID, code string) (*Contract, error)
+	ListContracts(ctx context.Context, filter ContractFilter) ([]Contract, error)
+	CancelContract(ctx context.Context, id uuid.UUID, reason string, at time.Time) error
+	SetTrackingCode(ctx context.Context, id uuid.UUID, code string, at time.Time) error
+	RecordBookingFailure(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time, at time.Time) (int, error)
+	ClaimPendingBookings(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]Contract, error)
+	ListDeliveryStats(ctx context.Context, carrierIDs []uuid.UUID) (map[uuid.UUID]DeliveryStats, error)
+	CountContractsSince(ctx context.Context, carrierIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int, error)
+	InsertQuotes(ctx context.Context, request *QuoteRequest, quotes []*Quote) error
+	GetQuoteByID(ctx context.Context, id uuid.UUID) (*Quote, error)
+	InsertPricingRule(ctx context.Context, rule *PricingRule) (uuid.UUID, error)
+	ListPricingRules(ctx context.Context) ([]PricingRule, error)
+	InsertLoad(ctx context.Context, l *Load) (uuid.UUID, error)
+	GetLoadByID(ctx context.Context, id uuid.UUID) (*Load, error)
+	ListLoadedOrderIDs(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+	ContractLoad(ctx context.Context, id uuid.UUID, contracts []*Contract, at time.Time) error
+	CancelLoad(ctx context.Context, id uuid.UUID, at time.Time) error
 }
 
 type repository struct {
-	pool *pgxpool.Pool
+	pool   *pgxpool.Pool
+	orders order.Repository
 }
 
-func NewRepository(pool *pgxpool.Pool) Repository {
-	return &repository{pool: pool}
+func NewRepository(pool *pgxpool.Pool, orders order.Repository) Repository {
+	return &repository{pool: pool, orders: orders}
 }
 
 func (r *repository) Insert(ctx context.Context, c *Contract) (uuid.UUID, error) {
 	var id uuid.UUID
 
-	err := r.pool.QueryRow(ctx, queryInsertContract,
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+		id, err = insertContract(ctx, tx, c)
+		if err != nil {
+			return err
+		}
+
+		return r.orders.TransitionStatusTx(ctx, tx, c.OrderID, order.StatusCreated, order.StatusAwaitingPickup, c.UpdatedAt)
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+
+func (r *repository) Replace(ctx context.Context, previousID uuid.UUID, c *Contract) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var orderID, carrierID uuid.UUID
+		err := tx.QueryRow(ctx, queryVoidContract, previousID, c.CreatedAt).Scan(&orderID, &carrierID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrContractNotActive
+			}
+			return fmt.Errorf("failed to void contract: %w", err)
+		}
+
+		err = outbox.Write(ctx, tx, c.CreatedAt, outbox.ContractCancelled{
+			ContractID:  previousID,
+			OrderID:     orderID,
+			CarrierID:   carrierID,
+			Reason:      contractReplacedReason,
+			CancelledAt: c.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		id, err = insertContract(ctx, tx, c)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, querySetContractReplacement, previousID, id); err != nil {
+			return fmt.Errorf("failed to link replacement contract: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+
+func insertContract(ctx context.Context, tx pgx.Tx, c *Contract) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	err := tx.QueryRow(ctx, queryInsertContract,
 		c.OrderID,
 		c.CarrierID,
 		c.Price,
 		c.EstimatedDays,
+		c.Status,
+		c.QuoteID,
+		c.FuelSurchargePercentage,
+		c.FuelSurcharge,
+		c.AdValorem,
+		c.GRIS,
+		c.Toll,
+		c.TDE,
+		c.DiscountID,
+		c.Discount,
+		c.ICMSRate,
+		c.ICMS,
+		c.Breakdown,
+		c.Explanation,
+		c.LoadID,
+		c.BookingStatus,
+		c.NextBookingAt,
 		c.ContractedAt,
 		c.CreatedAt,
 		c.UpdatedAt,
 	).Scan(&id)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode &&
+			pgErr.ConstraintName == uniqueActiveContractPerOrder {
+			return uuid.Nil, ErrContractAlreadyExists
+		}
 		return uuid.Nil, fmt.Errorf("failed to insert contract: %w", err)
 	}
 
+	err = outbox.Write(ctx, tx, c.ContractedAt, outbox.ContractCreated{
+		ContractID:    id,
+		OrderID:       c.OrderID,
+		CarrierID:     c.CarrierID,
+		Price:         c.Price,
+		ICMS:          c.ICMS,
+		EstimatedDays: c.EstimatedDays,
+		QuoteID:       c.QuoteID,
+		LoadID:        c.LoadID,
+		ContractedAt:  c.ContractedAt,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	return id, nil
 }
+
+func (r *repository) GetContractByID(ctx context.Context, id uuid.UUID) (*Contract, error) {
+	c, err := scanContract(r.pool.QueryRow(ctx, querySelectContractByID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrContractNotFound
+		}
+		return nil, fmt.Errorf("failed to get contract: %w", err)
+	}
+
+	return c, nil
+}
+
+func (r *repository) GetActiveContractByOrder(ctx context.Context, orderID uuid.UUID) (*Contract, error) {
+	c, err := scanContract(r.pool.QueryRow(ctx, querySelectActiveContractByOrder, orderID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrContractNotFound
+		}
+		return nil, fmt.Errorf("failed to get active contract: %w", err)
+	}
+
+	return c, nil
+}
+
+func (r *repository) GetContractByTrackingCode(
+	ctx context.Context,
+	carrierID uuid.UUID,
+	code string,
+) (*Contract, error) {
+	c, err := scanContract(r.pool.QueryRow(ctx, querySelectContractByTrackingCode, carrierID, code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrContractNotFound
+		}
+		return nil, fmt.Errorf("failed to get contract by tracking code: %w", err)
+	}
+
+	return c, nil
+}
+
+func (r *repository) ListContracts(ctx context.Context, filter ContractFilter) ([]Contract, error) {
+	var status *string
+	if filter.Status != nil {
+		s := string(*filter.Status)
+		status = &s
+	}
+
+	rows, err := r.pool.Query(ctx, queryListContracts,
+		filter.OrderID,
+		filter.CarrierID,
+		status,
+		filter.From,
+		filter.To,
+		filter.Limit,
+		filter.Offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contracts: %w", err)
+	}
+	defer rows.Close()
+
+	contracts := []Contract{}
+	for rows.Next() {
+		c, err := scanContract(rows)
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, *c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contracts, nil
+}
+
+func (r *repository) CancelContract(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var orderID, carrierID uuid.UUID
+		if err := tx.QueryRow(ctx, queryCancelContract, id, reason, at).Scan(&orderID, &carrierID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrContractNotActive
+			}
+			return err
+		}
+
+		err := outbox.Write(ctx, tx, at, outbox.ContractCancelled{
+			ContractID:  id,
+			OrderID:     orderID,
+			CarrierID:   carrierID,
+			Reason:      reason,
+			CancelledAt: at,
+		})
+		if err != nil {
+			return err
+		}
+
+		return r.orders.TransitionStatusTx(ctx, tx, orderID, order.StatusAwaitingPickup, order.StatusCreated, at)
+	})
+	if err != nil {
+		if errors.Is(err, ErrContractNotActive) || errors.Is(err, order.ErrInvalidStatusTransition) {
+			return err
+		}
+		return fmt.Errorf("failed to cancel contract: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) SetTrackingCode(ctx context.Context, id uuid.UUID, code string, at time.Time) error {
+	var updatedID uuid.UUID
+	err := r.pool.QueryRow(ctx, querySetTrackingCode, id, code, at).Scan(&updatedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrContractNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode &&
+			pgErr.ConstraintName == uniqueTrackingCodePerCarrier {
+			return ErrTrackingCodeTaken
+		}
+		return fmt.Errorf("failed to set tracking code: %w", err)
+	}
+
+	return nil
+}
+
+func scanContract(row pgx.Row) (*Contract, error) {
+	var c Contract
+	err := row.Scan(
+		&c.ID,
+		&c.OrderID,
+		&c.CarrierID,
+		&c.Price,
+		&c.EstimatedDays,
+		&c.Status,
+		&c.QuoteID,
+		&c.TrackingCode,
+		&c.FuelSurchargePercentage,
+		&c.FuelSurcharge,
+		&c.AdValorem,
+		&c.GRIS,
+		&c.Toll,
+		&c.TDE,
+		&c.DiscountID,
+		&c.Discount,
+		&c.ICMSRate,
+		&c.ICMS,
+		&c.Breakdown,
+		&c.Explanation,
+		&c.CancelReason,
+		&c.CancelledAt,
+		&c.ReplacedBy,
+		&c.LoadID,
+		&c.BookingStatus,
+		&c.BookingAttempts,
+		&c.BookingError,
+		&c.NextBookingAt,
+		&c.ContractedAt,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *repository) RecordBookingFailure(
+	ctx context.Context,
+	id uuid.UUID,
+	reason string,
+	retryAt *time.Time,
+	at time.Time,
+) (int, error) {
+	var attempts int
+	err := r.pool.QueryRow(ctx, queryRecordBookingFailure, id, reason, retryAt, at).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrContractNotFound
+		}
+		return 0, fmt.Errorf("failed to record booking failure: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *repository) ClaimPendingBookings(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	lease time.Duration,
+) ([]Contract, error) {
+	rows, err := r.pool.Query(ctx, queryClaimPendingBookings, now, limit, now.Add(lease))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending bookings: %w", err)
+	}
+	defer rows.Close()
+
+	contracts := []Contract{}
+	for rows.Next() {
+		c, err := scanContract(rows)
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, *c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contracts, nil
+}
+
+func (r *repository) ListDeliveryStats(
+	ctx context.Context,
+	carrierIDs []uuid.UUID,
+) (map[uuid.UUID]DeliveryStats, error) {
+	rows, err := r.pool.Query(ctx, queryListDeliveryStats, carrierIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make(map[uuid.UUID]DeliveryStats, len(carrierIDs))
+	for rows.Next() {
+		var (
+			carrierID uuid.UUID
+			s         DeliveryStats
+		)
+		if err := rows.Scan(&carrierID, &s.Delivered, &s.Finished); err != nil {
+			return nil, err
+		}
+		stats[carrierID] = s
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r *repository) CountContractsSince(
+	ctx context.Context,
+	carrierIDs []uuid.UUID,
+	since time.Time,
+) (map[uuid.UUID]int, error) {
+	rows, err := r.pool.Query(ctx, queryCountContractsSince, carrierIDs, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count contracts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID]int, len(carrierIDs))
+	for rows.Next() {
+		var (
+			carrierID uuid.UUID
+			count     int
+		)
+		if err := rows.Scan(&carrierID, &count); err != nil {
+			return nil, err
+		}
+		counts[carrierID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *repository) InsertQuotes(ctx context.Context, request *QuoteRequest, quotes []*Quote) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, queryInsertQuoteRequest,
+			request.OrderID,
+			request.Strategy,
+			request.RequestedAt,
+		).Scan(&request.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, q := range quotes {
+			q.RequestID = request.ID
+			err := tx.QueryRow(ctx, queryInsertQuote,
+				q.RequestID,
+				q.OrderID,
+				q.CarrierID,
+				q.PolicyID,
+				q.PolicyVersion,
+				q.BasePrice,
+				q.FuelSurchargePercentage,
+				q.FuelSurcharge,
+				q.AdValorem,
+				q.GRIS,
+				q.Toll,
+				q.TDE,
+				q.DiscountID,
+				q.Discount,
+				q.Price,
+				q.ICMSRate,
+				q.ICMS,
+				q.Breakdown,
+				q.Explanation,
+				q.EstimatedDays,
+				q.Reliability,
+				q.Score,
+				q.Recommended,
+				q.ExpiresAt,
+				q.CreatedAt,
+			).Scan(&q.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert quotes: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) GetQuoteByID(ctx context.Context, id uuid.UUID) (*Quote, error) {
+	var q Quote
+	err := r.pool.QueryRow(ctx, querySelectQuoteByID, id).Scan(
+		&q.ID,
+		&q.RequestID,
+		&q.OrderID,
+		&q.CarrierID,
+		&q.CarrierName,
+		&q.PolicyID,
+		&q.PolicyVersion,
+		&q.BasePrice,
+		&q.FuelSurchargePercentage,
+		&q.FuelSurcharge,
+		&q.AdValorem,
+		&q.GRIS,
+		&q.Toll,
+		&q.TDE,
+		&q.DiscountID,
+		&q.Discount,
+		&q.Price,
+		&q.ICMSRate,
+		&q.ICMS,
+		&q.Breakdown,
+		&q.Explanation,
+		&q.EstimatedDays,
+		&q.Reliability,
+		&q.Score,
+		&q.Recommended,
+		&q.ExpiresAt,
+		&q.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuoteNotFound
+		}
+		return nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	return &q, nil
+}
+
+func (r *repository) InsertPricingRule(ctx context.Context, rule *PricingRule) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, queryInsertPricingRule,
+		rule.CarrierID,
+		rule.Name,
+		rule.Kind,
+		rule.Value,
+		rule.Position,
+		rule.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to insert pricing rule: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repository) ListPricingRules(ctx context.Context) ([]PricingRule, error) {
+	rows, err := r.pool.Query(ctx, queryListPricingRules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pricing rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := []PricingRule{}
+	for rows.Next() {
+		var rule PricingRule
+		if err := rows.Scan(
+			&rule.ID, &rule.CarrierID, &rule.Name, &rule.Kind, &rule.Value, &rule.Position, &rule.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *repository) InsertLoad(ctx context.Context, l *Load) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, queryInsertLoad,
+			l.CarrierID,
+			l.Region,
+			l.DestinationUF.Sigla,
+			l.Status,
+			l.WeightKg,
+			l.DeclaredValue,
+			l.EstimatedDays,
+			l.BasePrice,
+			l.FuelSurchargePercentage,
+			l.FuelSurcharge,
+			l.AdValorem,
+			l.GRIS,
+			l.Toll,
+			l.TDE,
+			l.DiscountID,
+			l.Discount,
+			l.Price,
+			l.ICMS,
+			l.Breakdown,
+			l.Explanation,
+			l.IndividualPrice,
+			l.ExpiresAt,
+			l.CreatedAt,
+			l.UpdatedAt,
+		).Scan(&id)
+		if err != nil {
+			return err
+		}
+
+		for i, o := range l.Orders {
+			_, err := tx.Exec(ctx, queryInsertLoadOrder,
+				id,
+				o.OrderID,
+				i,
+				o.DestinationUF.Sigla,
+				o.WeightKg,
+				o.DeclaredValue,
+				o.TDE,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode &&
+			pgErr.ConstraintName == uniqueActiveLoadOrder {
+			return uuid.Nil, ErrOrderAlreadyLoaded
+		}
+		return uuid.Nil, fmt.Errorf("failed to insert load: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *repository) GetLoadByID(ctx context.Context, id uuid.UUID) (*Load, error) {
+	var (
+		l     Load
+		state string
+	)
+	err := r.pool.QueryRow(ctx, querySelectLoad, id).Scan(
+		&l.ID,
+		&l.CarrierID,
+		&l.CarrierName,
+		&l.Region,
+		&state,
+		&l.Status,
+		&l.WeightKg,
+		&l.DeclaredValue,
+		&l.EstimatedDays,
+		&l.BasePrice,
+		&l.FuelSurchargePercentage,
+		&l.FuelSurcharge,
+		&l.AdValorem,
+		&l.GRIS,
+		&l.Toll,
+		&l.TDE,
+		&l.DiscountID,
+		&l.Discount,
+		&l.Price,
+		&l.ICMS,
+		&l.Breakdown,
+		&l.Explanation,
+		&l.IndividualPrice,
+		&l.ExpiresAt,
+		&l.ContractedAt,
+		&l.CancelledAt,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrLoadNotFound
+		}
+		return nil, fmt.Errorf("failed to get load: %w", err)
+	}
+	l.DestinationUF = states.States[state]
+
+	rows, err := r.pool.Query(ctx, querySelectLoadOrders, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load orders: %w", err)
+	}
+	defer rows.Close()
+
+	l.Orders = []LoadOrder{}
+	for rows.Next() {
+		var o LoadOrder
+		if err := rows.Scan(&o.OrderID, &state, &o.WeightKg, &o.DeclaredValue, &o.TDE, &o.ContractID); err != nil {
+			return nil, err
+		}
+		o.DestinationUF = states.States[state]
+		l.Orders = append(l.Orders, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+func (r *repository) ListLoadedOrderIDs(
+	ctx context.Context,
+	orderIDs []uuid.UUID,
+) (map[uuid.UUID]bool, error) {
+	rows, err := r.pool.Query(ctx, queryListLoadedOrderIDs, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loaded orders: %w", err)
+	}
+	defer rows.Close()
+
+	loaded := map[uuid.UUID]bool{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		loaded[id] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return loaded, nil
+}
+
+func (r *repository) ContractLoad(
+	ctx context.Context,
+	id uuid.UUID,
+	contracts []*Contract,
+	at time.Time,
+) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockPlanned(ctx, tx, id); err != nil {
+			return err
+		}
+
+		orderIDs := make([]uuid.UUID, len(contracts))
+		for i, c := range contracts {
+			orderIDs[i] = c.OrderID
+		}
+		slices.SortFunc(orderIDs, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+		for _, orderID := range orderIDs {
+			err := r.orders.TransitionStatusTx(ctx, tx, orderID, order.StatusCreated, order.StatusAwaitingPickup, at)
+			if errors.Is(err, order.ErrInvalidStatusTransition) || errors.Is(err, order.ErrOrderNotFound) {
+				return ErrLoadOutdated
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, c := range contracts {
+			var err error
+			if c.ID, err = insertContract(ctx, tx, c); err != nil {
+				return err
+			}
+			if _, err := tx.Exec(ctx, querySetLoadOrderContract, id, c.OrderID, c.ID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.Exec(ctx, queryContractLoad, id, at); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, queryReleaseLoadOrders, id)
+		return err
+	})
+	if err != nil && !isLoadError(err) && !errors.Is(err, ErrContractAlreadyExists) {
+		return fmt.Errorf("failed to contract load: %w", err)
+	}
+	return err
+}
+
+func (r *repository) CancelLoad(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockPlanned(ctx, tx, id); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, queryCancelLoad, id, at); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, queryReleaseLoadOrders, id)
+		return err
+	})
+	if err != nil && !isLoadError(err) {
+		return fmt.Errorf("failed to cancel load: %w", err)
+	}
+	return err
+}
+
+func lockPlanned(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	var status string
+	if err := tx.QueryRow(ctx, queryLockLoad, id).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrLoadNotFound
+		}
+		return err
+	}
+	if LoadStatus(status) != LoadStatusPlanned {
+		return ErrLoadNotPlanned
+	}
+	return nil
+}
+
+func isLoadError(err error) bool {
+	return errors.Is(err, ErrLoadNotFound) ||
+		errors.Is(err, ErrLoadNotPlanned) ||
+		errors.Is(err, ErrLoadOutdated)
+}
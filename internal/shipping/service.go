@@ -6,41 +6,137 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway"
 	"github.com/victorvcruz/shipment-coordinator/internal/order"
 	"github.com/victorvcruz/shipment-coordinator/internal/platform/telemetry"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
 	log "go.uber.org/zap"
 )
 
-var ErrNoValidPolicy = errors.New(
-	"no valid policy found for the carrier in the order's destination region",
+var (
+	ErrNoValidPolicy = errors.New(
+		"no valid policy found for the carrier in the order's destination region",
+	)
+	ErrQuoteExpired  = errors.New("quote expired")
+	ErrQuoteMismatch = errors.New("quote does not belong to the order and carrier")
+
+	ErrInvalidRegion      = errors.New("invalid region")
+	ErrLoadTooSmall       = errors.New("a load needs at least two eligible orders")
+	ErrLoadExpired        = errors.New("load price expired")
+	ErrLoadRegionMismatch = errors.New("order destination is outside the load region")
+	ErrOrderNotLoadable   = errors.New("only created orders can be loaded")
+
+	ErrOrderNotAllocatable = errors.New("only created orders can be allocated")
+)
+
+const (
+	defaultQuoteTTL    = 30 * time.Minute
+	defaultSnapshotTTL = time.Minute
+
+	defaultContractsLimit = 50
+
+	defaultGatewayDeadline = 3 * time.Second
+
+	defaultBookingMaxAttempts = 5
+	defaultBookingBackoff     = time.Minute
+	defaultBookingLease       = 5 * time.Minute
 )
 
+type BookingPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	Lease       time.Duration
+}
+
+type Config struct {
+	Strategy        RankingStrategy
+	Weights         RankingWeights
+	AutoContract    bool
+	QuoteTTL        time.Duration
+	SnapshotTTL     time.Duration
+	Origin          states.State
+	Gateways        carriergateway.Registry
+	GatewayDeadline time.Duration
+	Booking         BookingPolicy
+}
+
 //go:generate moq -pkg mocks -out mocks/service.go . Service
 type Service interface {
-	QuoteAll(ctx context.Context, orderID uuid.UUID) ([]*Quote, error)
-	ContractCarrier(ctx context.Context, orderID, carrierID uuid.UUID) (*Contract, error)
+	QuoteAll(ctx context.Context, orderID uuid.UUID, strategy RankingStrategy) ([]*Quote, error)
+	QuoteBatch(ctx context.Context, orderIDs []uuid.UUID, strategy RankingStrategy) ([]*OrderQuotes, error)
+	Simulate(ctx context.Context, simulation Simulation, strategy RankingStrategy) ([]*Quote, error)
+	Allocate(ctx context.Context, request AllocationRequest) (*Allocation, error)
+	ContractCarrier(
+		ctx context.Context,
+		orderID, carrierID uuid.UUID,
+		quoteID *uuid.UUID,
+		replace bool,
+	) (*Contract, error)
+	AutoContract(ctx context.Context, orderID uuid.UUID, requested *bool) (*Contract, error)
+	GetContract(ctx context.Context, id uuid.UUID) (*Contract, error)
+	ListContracts(ctx context.Context, filter ContractFilter) ([]Contract, error)
+	CancelContract(ctx context.Context, id uuid.UUID, reason string) (*Contract, error)
+	CreatePricingRule(ctx context.Context, rule *PricingRule) (*PricingRule, error)
+	ListPricingRules(ctx context.Context) ([]PricingRule, error)
+	PlanLoad(ctx context.Context, request LoadRequest) (*Load, error)
+	GetLoad(ctx context.Context, id uuid.UUID) (*Load, error)
+	ContractLoad(ctx context.Context, id uuid.UUID) (*Load, error)
+	CancelLoad(ctx context.Context, id uuid.UUID) (*Load, error)
+	RetryBookings(ctx context.Context, limit int) (int, error)
 }
 
 type service struct {
 	orderRepository    order.Repository
 	carrierRepository  carrier.Repository
 	shippingRepository Repository
+	config             Config
+	snapshots          *ttlCache[*pricingSnapshot]
 }
 
 func NewService(
 	orderRepository order.Repository,
 	carrierRepository carrier.Repository,
 	shippingRepository Repository,
+	config Config,
 ) Service {
+	if config.Strategy == "" {
+		config.Strategy = RankingCheapest
+	}
+	if config.QuoteTTL <= 0 {
+		config.QuoteTTL = defaultQuoteTTL
+	}
+	if config.SnapshotTTL <= 0 {
+		config.SnapshotTTL = defaultSnapshotTTL
+	}
+	if config.GatewayDeadline <= 0 {
+		config.GatewayDeadline = defaultGatewayDeadline
+	}
+	if config.Booking.MaxAttempts <= 0 {
+		config.Booking.MaxAttempts = defaultBookingMaxAttempts
+	}
+	if config.Booking.Backoff <= 0 {
+		config.Booking.Backoff = defaultBookingBackoff
+	}
+	if config.Booking.Lease <= 0 {
+		config.Booking.Lease = defaultBookingLease
+	}
+
 	return &service{
 		orderRepository:    orderRepository,
 		carrierRepository:  carrierRepository,
 		shippingRepository: shippingRepository,
+		config:             config,
+		snapshots:          newTTLCache[*pricingSnapshot](config.SnapshotTTL),
 	}
 }
 
-func (s *service) QuoteAll(ctx context.Context, orderID uuid.UUID) ([]*Quote, error) {
+func (s *service) QuoteAll(
+	ctx context.Context,
+	orderID uuid.UUID,
+	strategy RankingStrategy,
+) ([]*Quote, error) {
 	order, err := s.orderRepository.GetByID(ctx, orderID)
 	if err != nil {
 		log.L().
@@ -48,39 +144,277 @@ func (s *service) QuoteAll(ctx context.Context, orderID uuid.UUID) ([]*Quote, er
 		return nil, err
 	}
 
-	carriers, err := s.carrierRepository.ListAllByRegion(ctx, order.DestinationUF.Region)
+	now := time.Now().UTC()
+	snapshot, err := s.loadSnapshot(ctx, order.DestinationUF.Region, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.quoteOrder(ctx, order, snapshot, strategy, now)
+}
+
+func (s *service) QuoteBatch(
+	ctx context.Context,
+	orderIDs []uuid.UUID,
+	strategy RankingStrategy,
+) ([]*OrderQuotes, error) {
+	orders, err := s.orderRepository.ListByIDs(ctx, orderIDs)
 	if err != nil {
 		log.L().
-			Error("failed to list carriers by region", log.String("region", order.DestinationUF.Region), log.Error(err))
+			Error("failed to list orders by IDs", log.Int("orders", len(orderIDs)), log.Error(err))
 		return nil, err
 	}
 
-	var quotes []*Quote
-	for _, c := range carriers {
+	return s.quoteOrders(ctx, orderIDs, orders, strategy)
+}
+
+func (s *service) quoteOrders(
+	ctx context.Context,
+	orderIDs []uuid.UUID,
+	orders []order.Order,
+	strategy RankingStrategy,
+) ([]*OrderQuotes, error) {
+	byID := make(map[uuid.UUID]*order.Order, len(orders))
+	for i := range orders {
+		byID[orders[i].ID] = &orders[i]
+	}
+
+	var err error
+	now := time.Now().UTC()
+	snapshots := map[string]*pricingSnapshot{}
+	results := make([]*OrderQuotes, 0, len(orderIDs))
+	for _, id := range orderIDs {
+		result := &OrderQuotes{OrderID: id}
+		results = append(results, result)
+
+		o, ok := byID[id]
+		if !ok {
+			result.Err = order.ErrOrderNotFound
+			continue
+		}
 
-		var validPolicy carrier.Policy
-		for _, policy := range c.Policies {
-			if policy.Region.Name == order.DestinationUF.Region {
-				validPolicy = policy
-				break
+		region := o.DestinationUF.Region
+		snapshot, ok := snapshots[region]
+		if !ok {
+			snapshot, err = s.loadSnapshot(ctx, region, now)
+			if err != nil {
+				return nil, err
 			}
+			snapshots[region] = snapshot
 		}
 
-		quote := &Quote{
-			CarrierID:     c.ID,
-			CarrierName:   c.Name,
-			Price:         validPolicy.PricePerKg.Mul(order.WeightKg),
-			EstimatedDays: validPolicy.EstimatedDays,
+		result.Quotes, result.Err = s.quoteOrder(ctx, o, snapshot, strategy, now)
+	}
+
+	return results, nil
+}
+
+func (s *service) Allocate(ctx context.Context, request AllocationRequest) (*Allocation, error) {
+	orders, err := s.orderRepository.ListByIDs(ctx, request.OrderIDs)
+	if err != nil {
+		log.L().
+			Error("failed to list orders by IDs", log.Int("orders", len(request.OrderIDs)), log.Error(err))
+		return nil, err
+	}
+
+	for _, o := range orders {
+		if o.Status != order.StatusCreated {
+			log.L().
+				Info("order is not allocatable", log.String("order_id", o.ID.String()), log.String("status", string(o.Status)))
+			return nil, ErrOrderNotAllocatable
 		}
-		quotes = append(quotes, quote)
+	}
+
+	results, err := s.quoteOrders(ctx, request.OrderIDs, orders, RankingCheapest)
+	if err != nil {
+		return nil, err
+	}
+
+	allocation := &Allocation{
+		Assignments:   make([]Assignment, len(results)),
+		Carriers:      []CarrierLoad{},
+		TotalPrice:    decimal.Zero,
+		CheapestPrice: decimal.Zero,
+	}
+
+	options := make([][]*Quote, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		var cheapest *Quote
+		for _, q := range result.Quotes {
+			if request.MaxDays > 0 && q.EstimatedDays > request.MaxDays {
+				continue
+			}
+			options[i] = append(options[i], q)
+			if cheapest == nil || q.TotalPrice().LessThan(cheapest.TotalPrice()) {
+				cheapest = q
+			}
+		}
+		if cheapest == nil {
+			log.L().
+				Info("no carrier meets the allocation constraints", log.String("order_id", result.OrderID.String()))
+			return nil, ErrAllocationInfeasible
+		}
+		allocation.CheapestPrice = allocation.CheapestPrice.Add(cheapest.TotalPrice())
+	}
+
+	assigned, err := allocate(options, request.Capacity, request.RequiredCarriers)
+	if err != nil {
+		log.L().
+			Info("no allocation satisfies the constraints", log.Int("orders", len(results)))
+		return nil, err
+	}
+
+	carriers := map[uuid.UUID]int{}
+	for i, q := range assigned {
+		allocation.Assignments[i] = Assignment{
+			OrderID:       q.OrderID,
+			CarrierID:     q.CarrierID,
+			CarrierName:   q.CarrierName,
+			QuoteID:       q.ID,
+			TotalPrice:    q.TotalPrice(),
+			EstimatedDays: q.EstimatedDays,
+		}
+		allocation.TotalPrice = allocation.TotalPrice.Add(q.TotalPrice())
+
+		idx, ok := carriers[q.CarrierID]
+		if !ok {
+			idx = len(allocation.Carriers)
+			carriers[q.CarrierID] = idx
+			allocation.Carriers = append(allocation.Carriers, CarrierLoad{
+				CarrierID:   q.CarrierID,
+				CarrierName: q.CarrierName,
+				TotalPrice:  decimal.Zero,
+			})
+		}
+		allocation.Carriers[idx].Orders++
+		allocation.Carriers[idx].TotalPrice = allocation.Carriers[idx].TotalPrice.Add(q.TotalPrice())
+	}
+
+	if !request.Contract {
+		return allocation, nil
+	}
+
+	for i := range allocation.Assignments {
+		a := &allocation.Assignments[i]
+		contract, err := s.ContractCarrier(ctx, a.OrderID, a.CarrierID, &a.QuoteID, false)
+		if err != nil {
+			a.Err = err
+			continue
+		}
+		a.ContractID = &contract.ID
+	}
+
+	return allocation, nil
+}
+
+func (s *service) quoteOrder(
+	ctx context.Context,
+	o *order.Order,
+	snapshot *pricingSnapshot,
+	strategy RankingStrategy,
+	now time.Time,
+) ([]*Quote, error) {
+	quotes := priceQuotes(snapshot, s.config.Origin, o.DestinationUF, o.WeightKg, o.DeclaredValue)
+	s.applyGatewayRates(ctx, o, quotes)
+	for _, q := range quotes {
+		q.OrderID = o.ID
+		q.ExpiresAt = now.Add(s.config.QuoteTTL)
+		q.CreatedAt = now
+	}
+
+	if strategy == "" {
+		strategy = s.config.Strategy
+	}
+	RankQuotes(quotes, strategy, s.config.Weights)
+
+	request := &QuoteRequest{
+		OrderID:     o.ID,
+		Strategy:    strategy,
+		RequestedAt: now,
+	}
+
+	if err := s.shippingRepository.InsertQuotes(ctx, request, quotes); err != nil {
+		log.L().
+			Error("failed to store quotes", log.String("order_id", o.ID.String()), log.Error(err))
+		return nil, err
 	}
 
 	return quotes, nil
 }
 
+func (s *service) Simulate(
+	ctx context.Context,
+	simulation Simulation,
+	strategy RankingStrategy,
+) ([]*Quote, error) {
+	now := time.Now().UTC()
+	region := simulation.DestinationUF.Region
+
+	snapshot, ok := s.snapshots.get(region, now)
+	if !ok {
+		var err error
+		snapshot, err = s.loadSnapshot(ctx, region, now)
+		if err != nil {
+			return nil, err
+		}
+		s.snapshots.set(region, snapshot, now)
+	}
+
+	quotes := priceQuotes(
+		snapshot,
+		s.config.Origin,
+		simulation.DestinationUF,
+		simulation.ChargeableWeightKg(),
+		simulation.DeclaredValue,
+	)
+	for _, q := range quotes {
+		q.CreatedAt = now
+	}
+
+	if strategy == "" {
+		strategy = s.config.Strategy
+	}
+	RankQuotes(quotes, strategy, s.config.Weights)
+
+	return quotes, nil
+}
+
+func (s *service) AutoContract(
+	ctx context.Context,
+	orderID uuid.UUID,
+	requested *bool,
+) (*Contract, error) {
+	enabled := s.config.AutoContract
+	if requested != nil {
+		enabled = *requested
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	quotes, err := s.QuoteAll(ctx, orderID, s.config.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quotes) == 0 {
+		log.L().
+			Warn("no carrier available for auto contract", log.String("order_id", orderID.String()))
+		return nil, ErrNoValidPolicy
+	}
+
+	return s.ContractCarrier(ctx, orderID, quotes[0].CarrierID, &quotes[0].ID, false)
+}
+
 func (s *service) ContractCarrier(
 	ctx context.Context,
 	orderID, carrierID uuid.UUID,
+	quoteID *uuid.UUID,
+	replace bool,
 ) (*Contract, error) {
 	o, err := s.orderRepository.GetByID(ctx, orderID)
 	if err != nil {
@@ -89,12 +423,29 @@ func (s *service) ContractCarrier(
 		return nil, err
 	}
 
-	if o.Status != order.StatusCreated {
+	if o.Status != order.StatusCreated && o.Status != order.StatusAwaitingPickup {
 		log.L().
 			Error("invalid order status for contracting", log.String("order_id", o.ID.String()), log.String("current_status", string(o.Status)))
 		return nil, order.ErrInvalidStatusTransition
 	}
 
+	var previous *Contract
+	if o.Status == order.StatusAwaitingPickup {
+		if !replace {
+			return nil, ErrContractAlreadyExists
+		}
+
+		previous, err = s.shippingRepository.GetActiveContractByOrder(ctx, o.ID)
+		if err != nil && !errors.Is(err, ErrContractNotFound) {
+			log.L().
+				Error("failed to get active contract", log.String("order_id", o.ID.String()), log.Error(err))
+			return nil, err
+		}
+		if previous != nil && previous.CarrierID == carrierID {
+			return nil, ErrContractAlreadyExists
+		}
+	}
+
 	c, err := s.carrierRepository.GetByID(ctx, carrierID)
 	if err != nil {
 		log.L().
@@ -102,28 +453,94 @@ func (s *service) ContractCarrier(
 		return nil, err
 	}
 
-	var validPolicy carrier.Policy
-	for _, policy := range c.Policies {
-		if policy.Region.Name == o.DestinationUF.Region {
-			validPolicy = policy
-			break
-		}
-	}
-
-	if validPolicy.ID == uuid.Nil {
-		return nil, ErrNoValidPolicy
-	}
-
 	now := time.Now().UTC()
 	contract := &Contract{
 		OrderID:       o.ID,
 		CarrierID:     c.ID,
-		Price:         validPolicy.PricePerKg.Mul(o.WeightKg),
-		EstimatedDays: validPolicy.EstimatedDays,
+		Status:        ContractStatusActive,
+		BookingStatus: BookingStatusNone,
 		ContractedAt:  now,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
+	if _, ok := s.config.Gateways.For(c.ID); ok {
+		nextBookingAt := now.Add(s.config.Booking.Lease)
+		contract.BookingStatus = BookingStatusPending
+		contract.NextBookingAt = &nextBookingAt
+	}
+
+	if quoteID != nil {
+		q, err := s.lockedQuote(ctx, *quoteID, o.ID, c.ID, now)
+		if err != nil {
+			return nil, err
+		}
+
+		contract.QuoteID = &q.ID
+		contract.EstimatedDays = q.EstimatedDays
+		contract.applyPrice(q.priceCalculation())
+	} else {
+		validPolicy, ok := policyForRegion(*c, o.DestinationUF.Region)
+		if !ok || validPolicy.ID == uuid.Nil {
+			return nil, ErrNoValidPolicy
+		}
+
+		surcharges, err := s.carrierRepository.ListFuelSurchargesInEffect(ctx, now)
+		if err != nil {
+			log.L().
+				Error("failed to list fuel surcharges", log.String("carrier_id", c.ID.String()), log.Error(err))
+			return nil, err
+		}
+
+		rules, err := s.shippingRepository.ListPricingRules(ctx)
+		if err != nil {
+			log.L().
+				Error("failed to list pricing rules", log.String("carrier_id", c.ID.String()), log.Error(err))
+			return nil, err
+		}
+
+		discounts, err := s.carrierRepository.ListDiscountsInEffect(ctx, now)
+		if err != nil {
+			log.L().
+				Error("failed to list discounts", log.String("carrier_id", c.ID.String()), log.Error(err))
+			return nil, err
+		}
+
+		volumes, err := s.shippingRepository.CountContractsSince(ctx, []uuid.UUID{c.ID}, monthStart(now))
+		if err != nil {
+			log.L().
+				Error("failed to count monthly contracts", log.String("carrier_id", c.ID.String()), log.Error(err))
+			return nil, err
+		}
+
+		calc := NewPricingEngine(rules).Price(PriceInput{
+			CarrierID:               c.ID,
+			Policy:                  validPolicy,
+			Origin:                  s.config.Origin,
+			Destination:             o.DestinationUF,
+			WeightKg:                o.WeightKg,
+			DeclaredValue:           o.DeclaredValue,
+			FuelSurchargePercentage: carrier.FuelSurchargeFor(surcharges, c.ID),
+			Discount:                eligibleDiscount(discounts, c.ID, o.DestinationUF.Region, o.WeightKg, volumes[c.ID], now),
+		})
+
+		contract.EstimatedDays = validPolicy.EstimatedDays
+		contract.applyPrice(calc)
+	}
+
+	if previous != nil {
+		id, err := s.shippingRepository.Replace(ctx, previous.ID, contract)
+		if err != nil {
+			log.L().
+				Error("failed to replace contract", log.String("order_id", o.ID.String()), log.String("previous_contract_id", previous.ID.String()), log.Error(err))
+			return nil, err
+		}
+		contract.ID = id
+		s.cancelBooking(ctx, previous)
+		s.book(ctx, contract, o)
+
+		telemetry.ContractCreatedCounter.Add(ctx, 1)
+		return contract, nil
+	}
 
 	id, err := s.shippingRepository.Insert(ctx, contract)
 	if err != nil {
@@ -132,15 +549,693 @@ func (s *service) ContractCarrier(
 		return nil, err
 	}
 	contract.ID = id
+	s.book(ctx, contract, o)
+
+	telemetry.ContractCreatedCounter.Add(ctx, 1)
+
+	return contract, nil
+}
+
+func (s *service) applyGatewayRates(ctx context.Context, o *order.Order, quotes []*Quote) {
+	if len(s.config.Gateways) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.GatewayDeadline)
+	defer cancel()
+
+	type gatewayRate struct {
+		quote *Quote
+		rate  *carriergateway.Rate
+	}
+
+	results := make(chan gatewayRate, len(quotes))
+	pending := 0
+	for _, q := range quotes {
+		gateway, ok := s.config.Gateways.For(q.CarrierID)
+		if !ok {
+			continue
+		}
+
+		pending++
+		go func(q *Quote) {
+			rate, err := gateway.Rate(ctx, carriergateway.RateRequest{
+				OrderID:       o.ID,
+				OriginUF:      s.config.Origin.Sigla,
+				DestinationUF: o.DestinationUF.Sigla,
+				WeightKg:      o.WeightKg,
+				DeclaredValue: o.DeclaredValue,
+			})
+			if err != nil {
+				log.L().
+					Warn("carrier gateway rate failed, using stored policy", log.String("carrier_id", q.CarrierID.String()), log.String("order_id", o.ID.String()), log.Error(err))
+			}
+			results <- gatewayRate{quote: q, rate: rate}
+		}(q)
+	}
 
-	err = s.orderRepository.UpdateStatus(ctx, o.ID, order.StatusAwaitingPickup)
+	for pending > 0 {
+		select {
+		case <-ctx.Done():
+			log.L().
+				Warn("carrier gateway deadline exceeded, using stored policies", log.String("order_id", o.ID.String()), log.Int("pending", pending))
+			return
+		case r := <-results:
+			pending--
+			if r.rate != nil {
+				r.quote.EstimatedDays = r.rate.EstimatedDays
+				r.quote.applyPrice(gatewayPrice(*r.rate, r.quote.Explanation, s.config.Origin, o.DestinationUF))
+			}
+		}
+	}
+}
+
+func (s *service) RetryBookings(ctx context.Context, limit int) (int, error) {
+	contracts, err := s.shippingRepository.ClaimPendingBookings(
+		ctx,
+		time.Now().UTC(),
+		limit,
+		s.config.Booking.Lease,
+	)
 	if err != nil {
 		log.L().
-			Error("failed to get carrier by ID", log.String("carrier_id", carrierID.String()), log.Error(err))
+			Error("failed to claim pending bookings", log.Error(err))
+		return 0, err
+	}
+
+	booked := 0
+	for i := range contracts {
+		contract := &contracts[i]
+
+		o, err := s.orderRepository.GetByID(ctx, contract.OrderID)
+		if err != nil {
+			log.L().
+				Error("failed to get order by ID", log.String("order_id", contract.OrderID.String()), log.Error(err))
+			return booked, err
+		}
+
+		s.book(ctx, contract, o)
+		if contract.BookingStatus == BookingStatusBooked {
+			booked++
+		}
+	}
+
+	return booked, nil
+}
+
+func (s *service) book(ctx context.Context, contract *Contract, o *order.Order) {
+	gateway, ok := s.config.Gateways.For(contract.CarrierID)
+	if !ok {
+		return
+	}
+
+	booking, err := gateway.Book(ctx, carriergateway.BookingRequest{
+		ContractID:    contract.ID,
+		OrderID:       o.ID,
+		Reference:     contract.Explanation.CarrierReference,
+		OriginUF:      s.config.Origin.Sigla,
+		DestinationUF: o.DestinationUF.Sigla,
+		WeightKg:      o.WeightKg,
+	})
+	if err != nil {
+		log.L().
+			Error("failed to book contract with carrier gateway", log.String("contract_id", contract.ID.String()), log.Error(err))
+		s.recordBookingFailure(ctx, contract, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	err = s.shippingRepository.SetTrackingCode(ctx, contract.ID, booking.TrackingCode, now)
+	if err != nil {
+		log.L().
+			Error("failed to store booked tracking code", log.String("contract_id", contract.ID.String()), log.Error(err))
+		return
+	}
+	contract.TrackingCode = &booking.TrackingCode
+	contract.BookingStatus = BookingStatusBooked
+	contract.BookingError = nil
+	contract.NextBookingAt = nil
+	contract.UpdatedAt = now
+}
+
+func (s *service) cancelBooking(ctx context.Context, contract *Contract) {
+	if contract.BookingStatus == BookingStatusNone {
+		return
+	}
+
+	gateway, ok := s.config.Gateways.For(contract.CarrierID)
+	if !ok {
+		return
+	}
+
+	if err := gateway.Cancel(ctx, contract.ID); err != nil {
+		log.L().
+			Error("failed to cancel booking with carrier gateway", log.String("contract_id", contract.ID.String()), log.Error(err))
+	}
+}
+
+func (s *service) recordBookingFailure(ctx context.Context, contract *Contract, cause error) {
+	now := time.Now().UTC()
+	reason := cause.Error()
+
+	var retryAt *time.Time
+	if contract.BookingAttempts+1 < s.config.Booking.MaxAttempts {
+		next := now.Add(s.config.Booking.Backoff * time.Duration(contract.BookingAttempts+1))
+		retryAt = &next
+	}
+
+	attempts, err := s.shippingRepository.RecordBookingFailure(ctx, contract.ID, reason, retryAt, now)
+	if err != nil {
+		log.L().
+			Error("failed to record booking failure", log.String("contract_id", contract.ID.String()), log.Error(err))
+		return
+	}
+
+	contract.BookingAttempts = attempts
+	contract.BookingError = &reason
+	contract.NextBookingAt = retryAt
+	contract.BookingStatus = BookingStatusPending
+	if retryAt == nil {
+		contract.BookingStatus = BookingStatusFailed
+	}
+	contract.UpdatedAt = now
+}
+
+func (s *service) GetContract(ctx context.Context, id uuid.UUID) (*Contract, error) {
+	contract, err := s.shippingRepository.GetContractByID(ctx, id)
+	if err != nil {
+		log.L().
+			Error("failed to get contract by ID", log.String("contract_id", id.String()), log.Error(err))
 		return nil, err
 	}
+	return contract, nil
+}
 
-	telemetry.ContractCreatedCounter.Add(ctx, 1)
+func (s *service) ListContracts(ctx context.Context, filter ContractFilter) ([]Contract, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultContractsLimit
+	}
+
+	contracts, err := s.shippingRepository.ListContracts(ctx, filter)
+	if err != nil {
+		log.L().Error("failed to list contracts", log.Error(err))
+		return nil, err
+	}
+	return contracts, nil
+}
+
+func (s *service) CancelContract(ctx context.Context, id uuid.UUID, reason string) (*Contract, error) {
+	contract, err := s.shippingRepository.GetContractByID(ctx, id)
+	if err != nil {
+		log.L().
+			Error("failed to get contract by ID", log.String("contract_id", id.String()), log.Error(err))
+		return nil, err
+	}
+
+	if contract.Status != ContractStatusActive {
+		return nil, ErrContractNotActive
+	}
+
+	o, err := s.orderRepository.GetByID(ctx, contract.OrderID)
+	if err != nil {
+		log.L().
+			Error("failed to get order by ID", log.String("order_id", contract.OrderID.String()), log.Error(err))
+		return nil, err
+	}
+
+	if o.Status != order.StatusAwaitingPickup {
+		log.L().
+			Error("invalid order status for cancelling", log.String("order_id", o.ID.String()), log.String("current_status", string(o.Status)))
+		return nil, order.ErrInvalidStatusTransition
+	}
+
+	now := time.Now().UTC()
+	if err := s.shippingRepository.CancelContract(ctx, contract.ID, reason, now); err != nil {
+		log.L().
+			Error("failed to cancel contract", log.String("contract_id", contract.ID.String()), log.Error(err))
+		return nil, err
+	}
+	s.cancelBooking(ctx, contract)
+
+	contract.Status = ContractStatusCancelled
+	contract.CancelReason = &reason
+	contract.CancelledAt = &now
+	contract.UpdatedAt = now
 
 	return contract, nil
 }
+
+func (s *service) CreatePricingRule(ctx context.Context, rule *PricingRule) (*PricingRule, error) {
+	if rule.CarrierID != nil {
+		if _, err := s.carrierRepository.GetByID(ctx, *rule.CarrierID); err != nil {
+			log.L().
+				Error("failed to get carrier for pricing rule", log.String("carrier_id", rule.CarrierID.String()), log.Error(err))
+			return nil, err
+		}
+	}
+
+	id, err := s.shippingRepository.InsertPricingRule(ctx, rule)
+	if err != nil {
+		log.L().
+			Error("failed to create pricing rule", log.String("kind", string(rule.Kind)), log.Error(err))
+		return nil, err
+	}
+
+	rule.ID = id
+	return rule, nil
+}
+
+func (s *service) ListPricingRules(ctx context.Context) ([]PricingRule, error) {
+	rules, err := s.shippingRepository.ListPricingRules(ctx)
+	if err != nil {
+		log.L().Error("failed to list pricing rules", log.Error(err))
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *service) PlanLoad(ctx context.Context, request LoadRequest) (*Load, error) {
+	region, ok := states.Regions[request.Region]
+	if !ok {
+		return nil, ErrInvalidRegion
+	}
+
+	orders, err := s.loadableOrders(ctx, region, request.OrderIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) < minLoadOrders {
+		return nil, ErrLoadTooSmall
+	}
+
+	now := time.Now().UTC()
+	snapshot, err := s.loadSnapshot(ctx, region.Name, now)
+	if err != nil {
+		return nil, err
+	}
+
+	load := &Load{
+		Region:          region.Name,
+		Status:          LoadStatusPlanned,
+		WeightKg:        decimal.Zero,
+		DeclaredValue:   decimal.Zero,
+		IndividualPrice: decimal.Zero,
+		Orders:          make([]LoadOrder, len(orders)),
+		ExpiresAt:       now.Add(s.config.QuoteTTL),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	weightByUF := map[string]decimal.Decimal{}
+	for i, o := range orders {
+		load.WeightKg = load.WeightKg.Add(o.WeightKg)
+		load.DeclaredValue = load.DeclaredValue.Add(o.DeclaredValue)
+		load.Orders[i] = LoadOrder{
+			OrderID:       o.ID,
+			DestinationUF: o.DestinationUF,
+			WeightKg:      o.WeightKg,
+			DeclaredValue: o.DeclaredValue,
+		}
+
+		uf := o.DestinationUF.Sigla
+		weightByUF[uf] = weightByUF[uf].Add(o.WeightKg)
+		if load.DestinationUF.Sigla == "" || weightByUF[uf].GreaterThan(weightByUF[load.DestinationUF.Sigla]) {
+			load.DestinationUF = o.DestinationUF
+		}
+	}
+
+	quotes := priceQuotes(snapshot, s.config.Origin, load.DestinationUF, load.WeightKg, load.DeclaredValue)
+	RankQuotes(quotes, s.config.Strategy, s.config.Weights)
+
+	var chosen *Quote
+	for _, q := range quotes {
+		if request.CarrierID == nil || q.CarrierID == *request.CarrierID {
+			chosen = q
+			break
+		}
+	}
+	if chosen == nil {
+		return nil, ErrNoValidPolicy
+	}
+
+	load.CarrierID = chosen.CarrierID
+	load.CarrierName = chosen.CarrierName
+	load.EstimatedDays = chosen.EstimatedDays
+	load.applyPrice(chosen.priceCalculation())
+	for _, c := range snapshot.carriers {
+		if policy, ok := policyForRegion(c, region.Name); ok && c.ID == chosen.CarrierID {
+			load.applyOrderTDE(policy)
+		}
+	}
+	load.split(s.config.Origin)
+
+	for _, o := range orders {
+		for _, q := range priceQuotes(snapshot, s.config.Origin, o.DestinationUF, o.WeightKg, o.DeclaredValue) {
+			if q.CarrierID == load.CarrierID {
+				load.IndividualPrice = load.IndividualPrice.Add(q.TotalPrice())
+			}
+		}
+	}
+
+	load.ID, err = s.shippingRepository.InsertLoad(ctx, load)
+	if err != nil {
+		log.L().
+			Error("failed to insert load", log.String("region", region.Name), log.String("carrier_id", load.CarrierID.String()), log.Error(err))
+		return nil, err
+	}
+
+	return load, nil
+}
+
+func (s *service) loadableOrders(
+	ctx context.Context,
+	region states.Region,
+	orderIDs []uuid.UUID,
+) ([]order.Order, error) {
+	if len(orderIDs) == 0 {
+		ufs := make([]string, len(region.States))
+		for i, uf := range region.States {
+			ufs[i] = uf.Sigla
+		}
+
+		orders, err := s.orderRepository.ListByStatus(ctx, order.StatusCreated, ufs)
+		if err != nil {
+			log.L().
+				Error("failed to list created orders", log.String("region", region.Name), log.Error(err))
+			return nil, err
+		}
+
+		ids := make([]uuid.UUID, len(orders))
+		for i, o := range orders {
+			ids[i] = o.ID
+		}
+		loaded, err := s.shippingRepository.ListLoadedOrderIDs(ctx, ids)
+		if err != nil {
+			log.L().
+				Error("failed to list loaded orders", log.String("region", region.Name), log.Error(err))
+			return nil, err
+		}
+
+		eligible := []order.Order{}
+		for _, o := range orders {
+			if !loaded[o.ID] {
+				eligible = append(eligible, o)
+			}
+		}
+		return eligible, nil
+	}
+
+	orders, err := s.orderRepository.ListByIDs(ctx, orderIDs)
+	if err != nil {
+		log.L().
+			Error("failed to list orders by IDs", log.Int("orders", len(orderIDs)), log.Error(err))
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]order.Order, len(orders))
+	for _, o := range orders {
+		byID[o.ID] = o
+	}
+
+	eligible := make([]order.Order, 0, len(orderIDs))
+	seen := map[uuid.UUID]bool{}
+	for _, id := range orderIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		o, ok := byID[id]
+		switch {
+		case !ok:
+			return nil, order.ErrOrderNotFound
+		case o.Status != order.StatusCreated:
+			return nil, ErrOrderNotLoadable
+		case o.DestinationUF.Region != region.Name:
+			return nil, ErrLoadRegionMismatch
+		}
+		eligible = append(eligible, o)
+	}
+
+	loaded, err := s.shippingRepository.ListLoadedOrderIDs(ctx, orderIDs)
+	if err != nil {
+		log.L().
+			Error("failed to list loaded orders", log.String("region", region.Name), log.Error(err))
+		return nil, err
+	}
+	if len(loaded) > 0 {
+		return nil, ErrOrderAlreadyLoaded
+	}
+
+	return eligible, nil
+}
+
+func (s *service) GetLoad(ctx context.Context, id uuid.UUID) (*Load, error) {
+	load, err := s.shippingRepository.GetLoadByID(ctx, id)
+	if err != nil {
+		log.L().
+			Error("failed to get load by ID", log.String("load_id", id.String()), log.Error(err))
+		return nil, err
+	}
+
+	load.split(s.config.Origin)
+	return load, nil
+}
+
+func (s *service) ContractLoad(ctx context.Context, id uuid.UUID) (*Load, error) {
+	load, err := s.GetLoad(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if load.Status != LoadStatusPlanned {
+		return nil, ErrLoadNotPlanned
+	}
+
+	now := time.Now().UTC()
+	if !now.Before(load.ExpiresAt) {
+		log.L().
+			Info("load price expired", log.String("load_id", id.String()), log.Time("expires_at", load.ExpiresAt))
+		return nil, ErrLoadExpired
+	}
+
+	contracts := make([]*Contract, len(load.Orders))
+	for i, o := range load.Orders {
+		contracts[i] = &Contract{
+			OrderID:       o.OrderID,
+			CarrierID:     load.CarrierID,
+			EstimatedDays: load.EstimatedDays,
+			Status:        ContractStatusActive,
+			LoadID:        &load.ID,
+			BookingStatus: BookingStatusNone,
+			ContractedAt:  now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		contracts[i].applyPrice(o.Share)
+	}
+
+	if err := s.shippingRepository.ContractLoad(ctx, load.ID, contracts, now); err != nil {
+		log.L().
+			Error("failed to contract load", log.String("load_id", id.String()), log.Error(err))
+		return nil, err
+	}
+
+	for i := range load.Orders {
+		load.Orders[i].ContractID = &contracts[i].ID
+	}
+	load.Status = LoadStatusContracted
+	load.ContractedAt = &now
+	load.UpdatedAt = now
+
+	telemetry.ContractCreatedCounter.Add(ctx, int64(len(contracts)))
+
+	return load, nil
+}
+
+func (s *service) CancelLoad(ctx context.Context, id uuid.UUID) (*Load, error) {
+	load, err := s.GetLoad(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err := s.shippingRepository.CancelLoad(ctx, load.ID, now); err != nil {
+		log.L().
+			Error("failed to cancel load", log.String("load_id", id.String()), log.Error(err))
+		return nil, err
+	}
+
+	load.Status = LoadStatusCancelled
+	load.CancelledAt = &now
+	load.UpdatedAt = now
+
+	return load, nil
+}
+
+func (s *service) loadSnapshot(
+	ctx context.Context,
+	region string,
+	now time.Time,
+) (*pricingSnapshot, error) {
+	carriers, err := s.carrierRepository.ListAllByRegion(ctx, region)
+	if err != nil {
+		log.L().
+			Error("failed to list carriers by region", log.String("region", region), log.Error(err))
+		return nil, err
+	}
+
+	surcharges, err := s.carrierRepository.ListFuelSurchargesInEffect(ctx, now)
+	if err != nil {
+		log.L().
+			Error("failed to list fuel surcharges", log.Error(err))
+		return nil, err
+	}
+
+	rules, err := s.shippingRepository.ListPricingRules(ctx)
+	if err != nil {
+		log.L().
+			Error("failed to list pricing rules", log.Error(err))
+		return nil, err
+	}
+
+	discounts, err := s.carrierRepository.ListDiscountsInEffect(ctx, now)
+	if err != nil {
+		log.L().
+			Error("failed to list discounts", log.Error(err))
+		return nil, err
+	}
+
+	stats := map[uuid.UUID]DeliveryStats{}
+	volumes := map[uuid.UUID]int{}
+	if len(carriers) > 0 {
+		carrierIDs := make([]uuid.UUID, len(carriers))
+		for i, c := range carriers {
+			carrierIDs[i] = c.ID
+		}
+
+		stats, err = s.shippingRepository.ListDeliveryStats(ctx, carrierIDs)
+		if err != nil {
+			log.L().
+				Error("failed to list carrier delivery stats", log.String("region", region), log.Error(err))
+			return nil, err
+		}
+
+		volumes, err = s.shippingRepository.CountContractsSince(ctx, carrierIDs, monthStart(now))
+		if err != nil {
+			log.L().
+				Error("failed to count monthly contracts", log.String("region", region), log.Error(err))
+			return nil, err
+		}
+	}
+
+	return &pricingSnapshot{
+		carriers:   carriers,
+		surcharges: surcharges,
+		discounts:  discounts,
+		volumes:    volumes,
+		pricing:    NewPricingEngine(rules),
+		stats:      stats,
+		loadedAt:   now,
+	}, nil
+}
+
+func priceQuotes(
+	snapshot *pricingSnapshot,
+	origin, destination states.State,
+	weightKg, declaredValue decimal.Decimal,
+) []*Quote {
+	quotes := []*Quote{}
+	for _, c := range snapshot.carriers {
+		validPolicy, ok := policyForRegion(c, destination.Region)
+		if !ok {
+			continue
+		}
+
+		calc := snapshot.pricing.Price(PriceInput{
+			CarrierID:               c.ID,
+			Policy:                  validPolicy,
+			Origin:                  origin,
+			Destination:             destination,
+			WeightKg:                weightKg,
+			DeclaredValue:           declaredValue,
+			FuelSurchargePercentage: carrier.FuelSurchargeFor(snapshot.surcharges, c.ID),
+			Discount: eligibleDiscount(
+				snapshot.discounts,
+				c.ID,
+				destination.Region,
+				weightKg,
+				snapshot.volumes[c.ID],
+				snapshot.loadedAt,
+			),
+		})
+
+		q := &Quote{
+			CarrierID:          c.ID,
+			CarrierName:        c.Name,
+			PolicyID:           validPolicy.ID,
+			PolicyVersion:      validPolicy.Version,
+			ChargeableWeightKg: weightKg,
+			EstimatedDays:      validPolicy.EstimatedDays,
+			Reliability:        snapshot.stats[c.ID].Reliability(),
+		}
+		q.applyPrice(calc)
+		quotes = append(quotes, q)
+	}
+
+	return quotes
+}
+
+func eligibleDiscount(
+	discounts []carrier.Discount,
+	carrierID uuid.UUID,
+	region string,
+	weightKg decimal.Decimal,
+	monthlyVolume int,
+	at time.Time,
+) *carrier.Discount {
+	d, ok := carrier.BestDiscount(discounts, carrierID, region, weightKg, monthlyVolume, at)
+	if !ok {
+		return nil
+	}
+	return &d
+}
+
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func policyForRegion(c carrier.Carrier, region string) (carrier.Policy, bool) {
+	for _, policy := range c.Policies {
+		if policy.Region.Name == region {
+			return policy, true
+		}
+	}
+	return carrier.Policy{}, false
+}
+
+func (s *service) lockedQuote(
+	ctx context.Context,
+	quoteID, orderID, carrierID uuid.UUID,
+	now time.Time,
+) (*Quote, error) {
+	q, err := s.shippingRepository.GetQuoteByID(ctx, quoteID)
+	if err != nil {
+		log.L().
+			Error("failed to get quote by ID", log.String("quote_id", quoteID.String()), log.Error(err))
+		return nil, err
+	}
+
+	if q.OrderID != orderID || q.CarrierID != carrierID {
+		log.L().
+			Error("quote does not match order and carrier", log.String("quote_id", quoteID.String()), log.String("order_id", orderID.String()), log.String("carrier_id", carrierID.String()))
+		return nil, ErrQuoteMismatch
+	}
+
+	if !now.Before(q.ExpiresAt) {
+		log.L().
+			Info("quote expired", log.String("quote_id", quoteID.String()), log.Time("expires_at", q.ExpiresAt))
+		return nil, ErrQuoteExpired
+	}
+
+	return q, nil
+}
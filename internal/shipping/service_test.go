@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/victorvcruz/shipment-coordinator/internal/carrier"
 	carriermock "github.com/victorvcruz/shipment-coordinator/internal/carrier/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/carriergateway"
+	gatewaymock "github.com/victorvcruz/shipment-coordinator/internal/carriergateway/mocks"
 	"github.com/victorvcruz/shipment-coordinator/internal/order"
 	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
 	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
@@ -53,17 +55,244 @@ func TestService_QuoteAll_Success(t *testing.T) {
 		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
 			return []carrier.Carrier{carrierObj}, nil
 		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		ListDeliveryStatsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+			return map[uuid.UUID]shipping.DeliveryStats{}, nil
+		},
+		InsertQuotesFunc: func(ctx context.Context, r *shipping.QuoteRequest, q []*shipping.Quote) error {
+			return nil
+		},
 	}
-	shippingRepo := &mocks.RepositoryMock{}
 
-	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo)
-	quotes, err := svc.QuoteAll(ctx, orderID)
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	quotes, err := svc.QuoteAll(ctx, orderID, "")
 	assert.NoError(t, err)
 	assert.Len(t, quotes, 1)
 	assert.Equal(t, carrierID, quotes[0].CarrierID)
 	assert.Equal(t, "CarrierX", quotes[0].CarrierName)
 	assert.Equal(t, decimal.NewFromFloat(50), quotes[0].Price)
 	assert.Equal(t, 3, quotes[0].EstimatedDays)
+	assert.True(t, quotes[0].Recommended)
+	assert.Equal(t, policy.ID, quotes[0].PolicyID)
+	assert.True(t, quotes[0].ExpiresAt.After(time.Now().UTC()))
+	assert.Len(t, shippingRepo.InsertQuotesCalls(), 1)
+	assert.Equal(t, shipping.RankingCheapest, shippingRepo.InsertQuotesCalls()[0].Request.Strategy)
+}
+
+func TestService_QuoteAll_FuelSurcharge(t *testing.T) {
+	ctx := context.Background()
+	carrierID := uuid.New()
+	otherCarrierID := uuid.New()
+
+	orderObj := &order.Order{
+		ID:            uuid.New(),
+		WeightKg:      decimal.NewFromFloat(10),
+		DestinationUF: states.SP,
+	}
+	policy := carrier.Policy{
+		ID:            uuid.New(),
+		Region:        states.Sudeste,
+		EstimatedDays: 3,
+		PricePerKg:    decimal.NewFromFloat(5),
+	}
+	carriers := []carrier.Carrier{
+		{ID: carrierID, Name: "CarrierX", Policies: []carrier.Policy{policy}},
+		{ID: otherCarrierID, Name: "CarrierY", Policies: []carrier.Policy{policy}},
+	}
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return orderObj, nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
+			return carriers, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return []carrier.FuelSurcharge{
+				{Percentage: decimal.NewFromFloat(10)},
+				{CarrierID: &carrierID, Percentage: decimal.NewFromFloat(20)},
+			}, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		ListDeliveryStatsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+			return map[uuid.UUID]shipping.DeliveryStats{}, nil
+		},
+		InsertQuotesFunc: func(ctx context.Context, r *shipping.QuoteRequest, q []*shipping.Quote) error {
+			return nil
+		},
+	}, shipping.Config{})
+	quotes, err := svc.QuoteAll(ctx, orderObj.ID, "")
+	assert.NoError(t, err)
+	assert.Len(t, quotes, 2)
+
+	byCarrier := map[uuid.UUID]*shipping.Quote{}
+	for _, q := range quotes {
+		byCarrier[q.CarrierID] = q
+	}
+	assert.Equal(t, "50.00", byCarrier[carrierID].BasePrice.StringFixed(2))
+	assert.Equal(t, "10.00", byCarrier[carrierID].FuelSurcharge.StringFixed(2))
+	assert.Equal(t, "60.00", byCarrier[carrierID].Price.StringFixed(2))
+	assert.Equal(t, "5.00", byCarrier[otherCarrierID].FuelSurcharge.StringFixed(2))
+	assert.Equal(t, "55.00", byCarrier[otherCarrierID].Price.StringFixed(2))
+}
+
+func TestService_QuoteAll_AppliesVolumeDiscount(t *testing.T) {
+	ctx := context.Background()
+	carrierID := uuid.New()
+	discountID := uuid.New()
+
+	orderObj := &order.Order{
+		ID:            uuid.New(),
+		WeightKg:      decimal.NewFromFloat(10),
+		DestinationUF: states.SP,
+	}
+	policy := carrier.Policy{
+		ID:            uuid.New(),
+		Region:        states.Sudeste,
+		EstimatedDays: 3,
+		PricePerKg:    decimal.NewFromFloat(5),
+	}
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return orderObj, nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
+			return []carrier.Carrier{{ID: carrierID, Name: "CarrierX", Policies: []carrier.Policy{policy}}}, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return []carrier.Discount{{
+				ID:               discountID,
+				CarrierID:        carrierID,
+				Name:             "Volume agreement",
+				Percentage:       decimal.NewFromFloat(10),
+				MinMonthlyVolume: 30,
+				ValidFrom:        at.AddDate(0, -1, 0),
+			}}, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{carrierID: 30}, nil
+		},
+		ListDeliveryStatsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+			return map[uuid.UUID]shipping.DeliveryStats{}, nil
+		},
+		InsertQuotesFunc: func(ctx context.Context, r *shipping.QuoteRequest, q []*shipping.Quote) error {
+			return nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	quotes, err := svc.QuoteAll(ctx, orderObj.ID, "")
+	assert.NoError(t, err)
+	assert.Len(t, quotes, 1)
+	assert.Equal(t, &discountID, quotes[0].DiscountID)
+	assert.Equal(t, "5.00", quotes[0].Discount.StringFixed(2))
+	assert.Equal(t, "45.00", quotes[0].Price.StringFixed(2))
+
+	since := shippingRepo.CountContractsSinceCalls()[0].Since
+	assert.Equal(t, 1, since.Day())
+	assert.Equal(t, 0, since.Hour())
+}
+
+func TestService_ContractCarrier_StoresPriceBreakdown(t *testing.T) {
+	ctx := context.Background()
+	carrierID := uuid.New()
+	orderObj := &order.Order{
+		ID:            uuid.New(),
+		WeightKg:      decimal.NewFromFloat(2),
+		DestinationUF: states.SP,
+		Status:        order.StatusCreated,
+	}
+	carrierObj := &carrier.Carrier{
+		ID:   carrierID,
+		Name: "CarrierY",
+		Policies: []carrier.Policy{
+			{ID: uuid.New(), Region: states.Sudeste, EstimatedDays: 5, PricePerKg: decimal.NewFromFloat(7)},
+		},
+	}
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return orderObj, nil
+		},
+		UpdateStatusFunc: func(ctx context.Context, id uuid.UUID, status order.Status) error {
+			return nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return carrierObj, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return []shipping.PricingRule{
+				{Kind: shipping.PricingRuleMinimumPrice, Value: decimal.NewFromFloat(25)},
+			}, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		InsertFunc: func(ctx context.Context, c *shipping.Contract) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{Origin: states.SP})
+	contract, err := svc.ContractCarrier(ctx, orderObj.ID, carrierID, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "25.00", contract.Price.StringFixed(2))
+	assert.Equal(t, "18.00", contract.ICMSRate.StringFixed(2))
+	assert.Equal(t, "5.49", contract.ICMS.StringFixed(2))
+	assert.Equal(t, "30.49", contract.TotalPrice().StringFixed(2))
+
+	stored := shippingRepo.InsertCalls()[0].C
+	assert.Len(t, stored.Breakdown, 2)
+	assert.Equal(t, "14.00", stored.Breakdown[0].Amount.StringFixed(2))
+	assert.Equal(t, string(shipping.PricingRuleMinimumPrice), stored.Breakdown[1].Rule)
+	assert.Equal(t, "11.00", stored.Breakdown[1].Amount.StringFixed(2))
 }
 
 func TestService_QuoteAll_OrderNotFound(t *testing.T) {
@@ -75,12 +304,96 @@ func TestService_QuoteAll_OrderNotFound(t *testing.T) {
 	}
 	carrierRepo := &carriermock.RepositoryMock{}
 	shippingRepo := &mocks.RepositoryMock{}
-	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo)
-	quotes, err := svc.QuoteAll(ctx, uuid.New())
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	quotes, err := svc.QuoteAll(ctx, uuid.New(), "")
 	assert.Error(t, err)
 	assert.Nil(t, quotes)
 }
 
+func TestService_QuoteBatch_LoadsCarriersOncePerRegion(t *testing.T) {
+	ctx := context.Background()
+	policy := carrier.Policy{
+		ID:            uuid.New(),
+		Region:        states.Sudeste,
+		EstimatedDays: 3,
+		PricePerKg:    decimal.NewFromFloat(5),
+	}
+	northeastPolicy := carrier.Policy{
+		ID:            uuid.New(),
+		Region:        states.Nordeste,
+		EstimatedDays: 6,
+		PricePerKg:    decimal.NewFromFloat(8),
+	}
+	carriers := []carrier.Carrier{
+		{
+			ID:       uuid.New(),
+			Name:     "CarrierX",
+			Policies: []carrier.Policy{policy, northeastPolicy},
+		},
+	}
+
+	orders := []order.Order{
+		{ID: uuid.New(), WeightKg: decimal.NewFromFloat(2), DestinationUF: states.SP},
+		{ID: uuid.New(), WeightKg: decimal.NewFromFloat(4), DestinationUF: states.RJ},
+		{ID: uuid.New(), WeightKg: decimal.NewFromFloat(1), DestinationUF: states.BA},
+	}
+	missingID := uuid.New()
+
+	orderRepo := &ordermock.RepositoryMock{
+		ListByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]order.Order, error) {
+			return orders, nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
+			return carriers, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		ListDeliveryStatsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+			return map[uuid.UUID]shipping.DeliveryStats{}, nil
+		},
+		InsertQuotesFunc: func(ctx context.Context, r *shipping.QuoteRequest, q []*shipping.Quote) error {
+			return nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	results, err := svc.QuoteBatch(
+		ctx,
+		[]uuid.UUID{orders[0].ID, missingID, orders[1].ID, orders[2].ID},
+		"",
+	)
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	assert.Len(t, carrierRepo.ListAllByRegionCalls(), 2)
+	assert.Len(t, shippingRepo.InsertQuotesCalls(), 3)
+
+	assert.Equal(t, orders[0].ID, results[0].OrderID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "10.00", results[0].Quotes[0].Price.StringFixed(2))
+
+	assert.Equal(t, missingID, results[1].OrderID)
+	assert.ErrorIs(t, results[1].Err, order.ErrOrderNotFound)
+	assert.Empty(t, results[1].Quotes)
+
+	assert.Equal(t, "20.00", results[2].Quotes[0].Price.StringFixed(2))
+	assert.Equal(t, "8.00", results[3].Quotes[0].Price.StringFixed(2))
+	assert.Equal(t, 6, results[3].Quotes[0].EstimatedDays)
+}
+
 func TestService_ContractCarrier_Success(t *testing.T) {
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -93,6 +406,7 @@ func TestService_ContractCarrier_Success(t *testing.T) {
 		ID:            orderID,
 		WeightKg:      decimal.NewFromFloat(2),
 		DestinationUF: state,
+		Status:        order.StatusCreated,
 	}
 	policy := carrier.Policy{
 		ID:            uuid.New(),
@@ -115,23 +429,239 @@ func TestService_ContractCarrier_Success(t *testing.T) {
 		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
 			return carrierObj, nil
 		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
 	}
 	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
 		InsertFunc: func(ctx context.Context, c *shipping.Contract) (uuid.UUID, error) {
 			return contractID, nil
 		},
 	}
-	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo)
-	contract, err := svc.ContractCarrier(ctx, orderID, carrierID)
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	contract, err := svc.ContractCarrier(ctx, orderID, carrierID, nil, false)
 	assert.NoError(t, err)
 	assert.Equal(t, contractID, contract.ID)
 	assert.Equal(t, orderID, contract.OrderID)
 	assert.Equal(t, carrierID, contract.CarrierID)
 	assert.Equal(t, decimal.NewFromFloat(14), contract.Price)
 	assert.Equal(t, 5, contract.EstimatedDays)
+	assert.Equal(t, shipping.ContractStatusActive, contract.Status)
+	assert.Len(t, shippingRepo.InsertCalls(), 1)
+	assert.Empty(t, orderRepo.UpdateStatusCalls())
 	assert.WithinDuration(t, time.Now().UTC(), contract.ContractedAt, time.Second)
 }
 
+func TestService_ContractCarrier_VoidsPreviousContract(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	carrierID := uuid.New()
+	previousID := uuid.New()
+	contractID := uuid.New()
+
+	orderObj := &order.Order{
+		ID:            orderID,
+		WeightKg:      decimal.NewFromFloat(2),
+		DestinationUF: states.SP,
+		Status:        order.StatusAwaitingPickup,
+	}
+	policy := carrier.Policy{
+		ID:            uuid.New(),
+		Region:        states.Sudeste,
+		EstimatedDays: 5,
+		PricePerKg:    decimal.NewFromFloat(7),
+	}
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return orderObj, nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return &carrier.Carrier{ID: carrierID, Name: "CarrierY", Policies: []carrier.Policy{policy}}, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		GetActiveContractByOrderFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: previousID, OrderID: id, CarrierID: uuid.New()}, nil
+		},
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		ReplaceFunc: func(ctx context.Context, id uuid.UUID, c *shipping.Contract) (uuid.UUID, error) {
+			return contractID, nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	contract, err := svc.ContractCarrier(ctx, orderID, carrierID, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, contractID, contract.ID)
+	assert.Equal(t, carrierID, contract.CarrierID)
+	assert.Len(t, shippingRepo.ReplaceCalls(), 1)
+	assert.Equal(t, previousID, shippingRepo.ReplaceCalls()[0].PreviousID)
+	assert.Empty(t, shippingRepo.InsertCalls())
+	assert.Empty(t, orderRepo.UpdateStatusCalls())
+}
+
+func TestService_ContractCarrier_SameCarrierAlreadyContracted(t *testing.T) {
+	ctx := context.Background()
+	carrierID := uuid.New()
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &order.Order{ID: id, DestinationUF: states.SP, Status: order.StatusAwaitingPickup}, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		GetActiveContractByOrderFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: uuid.New(), OrderID: id, CarrierID: carrierID}, nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, &carriermock.RepositoryMock{}, shippingRepo, shipping.Config{})
+	contract, err := svc.ContractCarrier(ctx, uuid.New(), carrierID, nil, true)
+	assert.ErrorIs(t, err, shipping.ErrContractAlreadyExists)
+	assert.Nil(t, contract)
+}
+
+func TestService_ContractCarrier_RequiresReplaceOptIn(t *testing.T) {
+	ctx := context.Background()
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &order.Order{ID: id, DestinationUF: states.SP, Status: order.StatusAwaitingPickup}, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{}
+
+	svc := shipping.NewService(orderRepo, &carriermock.RepositoryMock{}, shippingRepo, shipping.Config{})
+	contract, err := svc.ContractCarrier(ctx, uuid.New(), uuid.New(), nil, false)
+	assert.ErrorIs(t, err, shipping.ErrContractAlreadyExists)
+	assert.Nil(t, contract)
+	assert.Empty(t, shippingRepo.GetActiveContractByOrderCalls())
+	assert.Empty(t, shippingRepo.ReplaceCalls())
+}
+
+func TestService_CancelContract_Success(t *testing.T) {
+	ctx := context.Background()
+	contractID := uuid.New()
+	orderID := uuid.New()
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &order.Order{ID: id, Status: order.StatusAwaitingPickup}, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		GetContractByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: id, OrderID: orderID, Status: shipping.ContractStatusActive}, nil
+		},
+		CancelContractFunc: func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+			return nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, &carriermock.RepositoryMock{}, shippingRepo, shipping.Config{})
+	contract, err := svc.CancelContract(ctx, contractID, "customer gave up")
+	assert.NoError(t, err)
+	assert.Equal(t, shipping.ContractStatusCancelled, contract.Status)
+	assert.Equal(t, "customer gave up", *contract.CancelReason)
+	assert.NotNil(t, contract.CancelledAt)
+	assert.Len(t, shippingRepo.CancelContractCalls(), 1)
+	assert.Empty(t, orderRepo.UpdateStatusCalls())
+}
+
+func TestService_CancelContract_CancelsCarrierBooking(t *testing.T) {
+	ctx := context.Background()
+	carrierID := uuid.New()
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &order.Order{ID: id, Status: order.StatusAwaitingPickup}, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		GetContractByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return &shipping.Contract{
+				ID:            id,
+				OrderID:       uuid.New(),
+				CarrierID:     carrierID,
+				Status:        shipping.ContractStatusActive,
+				BookingStatus: shipping.BookingStatusBooked,
+			}, nil
+		},
+		CancelContractFunc: func(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+			return nil
+		},
+	}
+	gateway := &gatewaymock.CarrierGatewayMock{
+		CancelFunc: func(ctx context.Context, contractID uuid.UUID) error {
+			return nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, &carriermock.RepositoryMock{}, shippingRepo, shipping.Config{
+		Gateways: carriergateway.Registry{carrierID: gateway},
+	})
+	contract, err := svc.CancelContract(ctx, uuid.New(), "customer gave up")
+	assert.NoError(t, err)
+	assert.Len(t, gateway.CancelCalls(), 1)
+	assert.Equal(t, contract.ID, gateway.CancelCalls()[0].ContractID)
+}
+
+func TestService_CancelContract_AfterPickup(t *testing.T) {
+	ctx := context.Background()
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &order.Order{ID: id, Status: order.StatusPickedUp}, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		GetContractByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: id, OrderID: uuid.New(), Status: shipping.ContractStatusActive}, nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, &carriermock.RepositoryMock{}, shippingRepo, shipping.Config{})
+	contract, err := svc.CancelContract(ctx, uuid.New(), "too late")
+	assert.ErrorIs(t, err, order.ErrInvalidStatusTransition)
+	assert.Nil(t, contract)
+	assert.Empty(t, shippingRepo.CancelContractCalls())
+}
+
+func TestService_ListContracts_DefaultLimit(t *testing.T) {
+	shippingRepo := &mocks.RepositoryMock{
+		ListContractsFunc: func(ctx context.Context, filter shipping.ContractFilter) ([]shipping.Contract, error) {
+			return []shipping.Contract{}, nil
+		},
+	}
+
+	svc := shipping.NewService(&ordermock.RepositoryMock{}, &carriermock.RepositoryMock{}, shippingRepo, shipping.Config{})
+	_, err := svc.ListContracts(context.Background(), shipping.ContractFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, 50, shippingRepo.ListContractsCalls()[0].Filter.Limit)
+}
+
 func TestService_ContractCarrier_NoValidPolicy(t *testing.T) {
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -143,6 +673,7 @@ func TestService_ContractCarrier_NoValidPolicy(t *testing.T) {
 		ID:            orderID,
 		WeightKg:      decimal.NewFromFloat(1),
 		DestinationUF: state,
+		Status:        order.StatusCreated,
 	}
 	carrierObj := &carrier.Carrier{
 		ID:       carrierID,
@@ -160,8 +691,489 @@ func TestService_ContractCarrier_NoValidPolicy(t *testing.T) {
 		},
 	}
 	shippingRepo := &mocks.RepositoryMock{}
-	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo)
-	contract, err := svc.ContractCarrier(ctx, orderID, carrierID)
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	contract, err := svc.ContractCarrier(ctx, orderID, carrierID, nil, false)
 	assert.ErrorIs(t, err, shipping.ErrNoValidPolicy)
 	assert.Nil(t, contract)
 }
+
+func TestService_ContractCarrier_AlreadyContracted(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	carrierID := uuid.New()
+
+	orderObj := &order.Order{
+		ID:            orderID,
+		WeightKg:      decimal.NewFromFloat(1),
+		DestinationUF: states.SP,
+		Status:        order.StatusCreated,
+	}
+	carrierObj := &carrier.Carrier{
+		ID:   carrierID,
+		Name: "CarrierW",
+		Policies: []carrier.Policy{
+			{ID: uuid.New(), Region: states.Sudeste, EstimatedDays: 3, PricePerKg: decimal.NewFromFloat(4)},
+		},
+	}
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return orderObj, nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			return carrierObj, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		InsertFunc: func(ctx context.Context, c *shipping.Contract) (uuid.UUID, error) {
+			return uuid.Nil, shipping.ErrContractAlreadyExists
+		},
+	}
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	contract, err := svc.ContractCarrier(ctx, orderID, carrierID, nil, false)
+	assert.ErrorIs(t, err, shipping.ErrContractAlreadyExists)
+	assert.Nil(t, contract)
+	assert.Empty(t, orderRepo.UpdateStatusCalls())
+}
+
+func TestService_AutoContract_Disabled(t *testing.T) {
+	svc := shipping.NewService(
+		&ordermock.RepositoryMock{},
+		&carriermock.RepositoryMock{},
+		&mocks.RepositoryMock{},
+		shipping.Config{AutoContract: false},
+	)
+
+	contract, err := svc.AutoContract(context.Background(), uuid.New(), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, contract)
+}
+
+func TestService_AutoContract_ContractsRecommended(t *testing.T) {
+	ctx := context.Background()
+	cheapID := uuid.New()
+	expensiveID := uuid.New()
+
+	orderObj := &order.Order{
+		ID:            uuid.New(),
+		WeightKg:      decimal.NewFromFloat(2),
+		DestinationUF: states.SP,
+		Status:        order.StatusCreated,
+	}
+	cheap := carrier.Carrier{
+		ID:   cheapID,
+		Name: "Cheap",
+		Policies: []carrier.Policy{
+			{ID: uuid.New(), Region: states.Sudeste, EstimatedDays: 6, PricePerKg: decimal.NewFromFloat(3)},
+		},
+	}
+	expensive := carrier.Carrier{
+		ID:   expensiveID,
+		Name: "Expensive",
+		Policies: []carrier.Policy{
+			{ID: uuid.New(), Region: states.Sudeste, EstimatedDays: 2, PricePerKg: decimal.NewFromFloat(9)},
+		},
+	}
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return orderObj, nil
+		},
+		UpdateStatusFunc: func(ctx context.Context, id uuid.UUID, status order.Status) error {
+			return nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
+			return []carrier.Carrier{expensive, cheap}, nil
+		},
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			if id == cheapID {
+				return &cheap, nil
+			}
+			return &expensive, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	stored := map[uuid.UUID]*shipping.Quote{}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		ListDeliveryStatsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+			return map[uuid.UUID]shipping.DeliveryStats{}, nil
+		},
+		InsertQuotesFunc: func(ctx context.Context, r *shipping.QuoteRequest, quotes []*shipping.Quote) error {
+			for _, q := range quotes {
+				q.ID = uuid.New()
+				stored[q.ID] = q
+			}
+			return nil
+		},
+		GetQuoteByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Quote, error) {
+			return stored[id], nil
+		},
+		InsertFunc: func(ctx context.Context, c *shipping.Contract) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+	}
+
+	requested := true
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{
+		Strategy: shipping.RankingCheapest,
+	})
+	contract, err := svc.AutoContract(ctx, orderObj.ID, &requested)
+	assert.NoError(t, err)
+	assert.Equal(t, cheapID, contract.CarrierID)
+	assert.NotNil(t, contract.QuoteID)
+	assert.Equal(t, "6.00", contract.Price.StringFixed(2))
+	assert.Len(t, shippingRepo.InsertCalls(), 1)
+}
+
+func lockedQuoteFixture(
+	t *testing.T,
+	expiresAt time.Time,
+) (shipping.Service, *order.Order, uuid.UUID, *shipping.Quote) {
+	t.Helper()
+
+	carrierID := uuid.New()
+	orderObj := &order.Order{
+		ID:            uuid.New(),
+		WeightKg:      decimal.NewFromFloat(2),
+		DestinationUF: states.SP,
+		Status:        order.StatusCreated,
+	}
+	carrierObj := &carrier.Carrier{
+		ID:   carrierID,
+		Name: "CarrierL",
+		Policies: []carrier.Policy{
+			{ID: uuid.New(), Region: states.Sudeste, EstimatedDays: 3, PricePerKg: decimal.NewFromFloat(20)},
+		},
+	}
+	quote := &shipping.Quote{
+		ID:                      uuid.New(),
+		OrderID:                 orderObj.ID,
+		CarrierID:               carrierID,
+		Price:                   decimal.NewFromFloat(11),
+		BasePrice:               decimal.NewFromFloat(10),
+		FuelSurchargePercentage: decimal.NewFromFloat(10),
+		FuelSurcharge:           decimal.NewFromFloat(1),
+		EstimatedDays:           4,
+		ExpiresAt:               expiresAt,
+	}
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return orderObj, nil
+		},
+		UpdateStatusFunc: func(ctx context.Context, id uuid.UUID, status order.Status) error {
+			return nil
+		},
+	}
+	carrierRepo := &carriermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*carrier.Carrier, error) {
+			found := *carrierObj
+			found.ID = id
+			return &found, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		GetQuoteByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Quote, error) {
+			if id != quote.ID {
+				return nil, shipping.ErrQuoteNotFound
+			}
+			return quote, nil
+		},
+		InsertFunc: func(ctx context.Context, c *shipping.Contract) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	return svc, orderObj, carrierID, quote
+}
+
+func TestService_ContractCarrier_HonoursLockedQuote(t *testing.T) {
+	svc, orderObj, carrierID, quote := lockedQuoteFixture(t, time.Now().UTC().Add(time.Minute))
+
+	contract, err := svc.ContractCarrier(context.Background(), orderObj.ID, carrierID, &quote.ID, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &quote.ID, contract.QuoteID)
+	assert.Equal(t, "11.00", contract.Price.StringFixed(2))
+	assert.Equal(t, 4, contract.EstimatedDays)
+}
+
+func TestService_ContractCarrier_ExpiredQuote(t *testing.T) {
+	svc, orderObj, carrierID, quote := lockedQuoteFixture(t, time.Now().UTC().Add(-time.Minute))
+
+	contract, err := svc.ContractCarrier(context.Background(), orderObj.ID, carrierID, &quote.ID, false)
+	assert.ErrorIs(t, err, shipping.ErrQuoteExpired)
+	assert.Nil(t, contract)
+}
+
+func TestService_ContractCarrier_QuoteMismatch(t *testing.T) {
+	svc, orderObj, _, quote := lockedQuoteFixture(t, time.Now().UTC().Add(time.Minute))
+
+	contract, err := svc.ContractCarrier(context.Background(), orderObj.ID, uuid.New(), &quote.ID, false)
+	assert.ErrorIs(t, err, shipping.ErrQuoteMismatch)
+	assert.Nil(t, contract)
+}
+
+func TestService_Simulate_UsesCachedSnapshot(t *testing.T) {
+	ctx := context.Background()
+	carrierObj := carrier.Carrier{
+		ID:   uuid.New(),
+		Name: "CarrierS",
+		Policies: []carrier.Policy{
+			{ID: uuid.New(), Region: states.Sudeste, EstimatedDays: 3, PricePerKg: decimal.NewFromFloat(2)},
+		},
+	}
+
+	carrierRepo := &carriermock.RepositoryMock{
+		ListAllByRegionFunc: func(ctx context.Context, region string) ([]carrier.Carrier, error) {
+			return []carrier.Carrier{carrierObj}, nil
+		},
+		ListFuelSurchargesInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.FuelSurcharge, error) {
+			return nil, nil
+		},
+		ListDiscountsInEffectFunc: func(ctx context.Context, at time.Time) ([]carrier.Discount, error) {
+			return nil, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ListPricingRulesFunc: func(ctx context.Context) ([]shipping.PricingRule, error) {
+			return nil, nil
+		},
+		CountContractsSinceFunc: func(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+			return map[uuid.UUID]int{}, nil
+		},
+		ListDeliveryStatsFunc: func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]shipping.DeliveryStats, error) {
+			return map[uuid.UUID]shipping.DeliveryStats{}, nil
+		},
+	}
+	orderRepo := &ordermock.RepositoryMock{}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{})
+	simulation := shipping.Simulation{
+		DestinationUF: states.RJ,
+		WeightKg:      decimal.NewFromFloat(1),
+		LengthCm:      decimal.NewFromFloat(40),
+		WidthCm:       decimal.NewFromFloat(30),
+		HeightCm:      decimal.NewFromFloat(20),
+	}
+
+	for range 3 {
+		quotes, err := svc.Simulate(ctx, simulation, "")
+		assert.NoError(t, err)
+		assert.Len(t, quotes, 1)
+		assert.Equal(t, "7.20", quotes[0].ChargeableWeightKg.StringFixed(2))
+		assert.Equal(t, "14.40", quotes[0].Price.StringFixed(2))
+		assert.True(t, quotes[0].Recommended)
+	}
+
+	assert.Len(t, carrierRepo.ListAllByRegionCalls(), 1)
+	assert.Empty(t, orderRepo.GetByIDCalls())
+	assert.Empty(t, shippingRepo.InsertQuotesCalls())
+}
+
+func TestSimulation_ChargeableWeightKg(t *testing.T) {
+	light := shipping.Simulation{
+		WeightKg: decimal.NewFromFloat(10),
+		LengthCm: decimal.NewFromFloat(10),
+		WidthCm:  decimal.NewFromFloat(10),
+		HeightCm: decimal.NewFromFloat(10),
+	}
+	assert.Equal(t, "0.300", light.CubicWeightKg().StringFixed(3))
+	assert.Equal(t, "10.00", light.ChargeableWeightKg().StringFixed(2))
+
+	noDimensions := shipping.Simulation{WeightKg: decimal.NewFromFloat(2.5)}
+	assert.Equal(t, "2.50", noDimensions.ChargeableWeightKg().StringFixed(2))
+}
+
+func gatewayQuoteFixture(gateway carriergateway.CarrierGateway) (shipping.Service, carrier.Carrier, carrier.Carrier) {
+	orders := []order.Order{{
+		ID:            uuid.New(),
+		WeightKg:      decimal.NewFromInt(10),
+		DestinationUF: states.RJ,
+		Status:        order.StatusCreated,
+	}}
+	live := sudesteCarrier("Live", "5")
+	static := sudesteCarrier("Static", "4")
+
+	orderRepo, carrierRepo, shippingRepo := loadFixture(orders, live, static)
+	orderRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+		return &orders[0], nil
+	}
+	shippingRepo.ListPricingRulesFunc = func(ctx context.Context) ([]shipping.PricingRule, error) {
+		return nil, nil
+	}
+	shippingRepo.InsertQuotesFunc = func(ctx context.Context, r *shipping.QuoteRequest, q []*shipping.Quote) error {
+		return nil
+	}
+
+	svc := shipping.NewService(orderRepo, carrierRepo, shippingRepo, shipping.Config{
+		Origin:          states.SP,
+		Gateways:        carriergateway.Registry{live.ID: gateway},
+		GatewayDeadline: 50 * time.Millisecond,
+	})
+	return svc, live, static
+}
+
+func TestService_QuoteAll_UsesGatewayRate(t *testing.T) {
+	gateway := &gatewaymock.CarrierGatewayMock{
+		RateFunc: func(ctx context.Context, r carriergateway.RateRequest) (*carriergateway.Rate, error) {
+			return &carriergateway.Rate{Reference: "RT-1", Price: decimal.NewFromInt(30), EstimatedDays: 1}, nil
+		},
+	}
+	svc, live, _ := gatewayQuoteFixture(gateway)
+
+	quotes, err := svc.QuoteAll(context.Background(), uuid.New(), shipping.RankingCheapest)
+	assert.NoError(t, err)
+	assert.Len(t, quotes, 2)
+	assert.Equal(t, live.ID, quotes[0].CarrierID)
+	assert.Equal(t, "30.00", quotes[0].Price.StringFixed(2))
+	assert.Equal(t, 1, quotes[0].EstimatedDays)
+	assert.Equal(t, shipping.PriceLineCarrierRate, quotes[0].Breakdown[0].Rule)
+	assert.Equal(t, shipping.PriceSourceCarrierRate, quotes[0].Explanation.Source)
+	assert.Equal(t, "RT-1", quotes[0].Explanation.CarrierReference)
+	assert.Equal(t, quotes[0].PolicyID, *quotes[0].Explanation.PolicyID)
+	assert.True(t, quotes[0].ICMS.IsPositive())
+	assert.Equal(t, "RJ", gateway.RateCalls()[0].Request.DestinationUF)
+}
+
+func TestService_QuoteAll_FallsBackWhenGatewayFails(t *testing.T) {
+	gateway := &gatewaymock.CarrierGatewayMock{
+		RateFunc: func(ctx context.Context, r carriergateway.RateRequest) (*carriergateway.Rate, error) {
+			return nil, carriergateway.ErrCircuitOpen
+		},
+	}
+	svc, live, static := gatewayQuoteFixture(gateway)
+
+	quotes, err := svc.QuoteAll(context.Background(), uuid.New(), shipping.RankingCheapest)
+	assert.NoError(t, err)
+	assert.Equal(t, static.ID, quotes[0].CarrierID)
+	assert.Equal(t, live.ID, quotes[1].CarrierID)
+	assert.Equal(t, "50.00", quotes[1].Price.StringFixed(2))
+}
+
+func TestService_QuoteAll_FallsBackWhenGatewayMissesDeadline(t *testing.T) {
+	gateway := &gatewaymock.CarrierGatewayMock{
+		RateFunc: func(ctx context.Context, r carriergateway.RateRequest) (*carriergateway.Rate, error) {
+			time.Sleep(200 * time.Millisecond)
+			return &carriergateway.Rate{Price: decimal.NewFromInt(1), EstimatedDays: 1}, nil
+		},
+	}
+	svc, live, _ := gatewayQuoteFixture(gateway)
+
+	start := time.Now()
+	quotes, err := svc.QuoteAll(context.Background(), uuid.New(), shipping.RankingCheapest)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), 150*time.Millisecond)
+	assert.Equal(t, live.ID, quotes[1].CarrierID)
+	assert.Equal(t, "50.00", quotes[1].Price.StringFixed(2))
+}
+
+func bookingFixture(gateway carriergateway.CarrierGateway, attempts int) (shipping.Service, *mocks.RepositoryMock, *shipping.Contract) {
+	o := order.Order{ID: uuid.New(), WeightKg: decimal.NewFromInt(10), DestinationUF: states.RJ}
+	contract := shipping.Contract{
+		ID:              uuid.New(),
+		OrderID:         o.ID,
+		CarrierID:       uuid.New(),
+		Status:          shipping.ContractStatusActive,
+		BookingStatus:   shipping.BookingStatusPending,
+		BookingAttempts: attempts,
+	}
+
+	orderRepo := &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &o, nil
+		},
+	}
+	shippingRepo := &mocks.RepositoryMock{
+		ClaimPendingBookingsFunc: func(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]shipping.Contract, error) {
+			return []shipping.Contract{contract}, nil
+		},
+		RecordBookingFailureFunc: func(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time, at time.Time) (int, error) {
+			return attempts + 1, nil
+		},
+		SetTrackingCodeFunc: func(ctx context.Context, id uuid.UUID, code string, at time.Time) error {
+			return nil
+		},
+	}
+
+	svc := shipping.NewService(orderRepo, &carriermock.RepositoryMock{}, shippingRepo, shipping.Config{
+		Origin:   states.SP,
+		Gateways: carriergateway.Registry{contract.CarrierID: gateway},
+		Booking:  shipping.BookingPolicy{MaxAttempts: 3, Backoff: time.Minute},
+	})
+	return svc, shippingRepo, &contract
+}
+
+func TestService_RetryBookings_BooksPendingContract(t *testing.T) {
+	gateway := &gatewaymock.CarrierGatewayMock{
+		BookFunc: func(ctx context.Context, r carriergateway.BookingRequest) (*carriergateway.Booking, error) {
+			return &carriergateway.Booking{TrackingCode: "BR1"}, nil
+		},
+	}
+	svc, shippingRepo, contract := bookingFixture(gateway, 1)
+
+	booked, err := svc.RetryBookings(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, booked)
+	assert.Equal(t, contract.ID, gateway.BookCalls()[0].Request.ContractID)
+	assert.Equal(t, "BR1", shippingRepo.SetTrackingCodeCalls()[0].Code)
+	assert.Empty(t, shippingRepo.RecordBookingFailureCalls())
+}
+
+func TestService_RetryBookings_RecordsFailureForRetry(t *testing.T) {
+	gateway := &gatewaymock.CarrierGatewayMock{
+		BookFunc: func(ctx context.Context, r carriergateway.BookingRequest) (*carriergateway.Booking, error) {
+			return nil, carriergateway.ErrCircuitOpen
+		},
+	}
+	svc, shippingRepo, contract := bookingFixture(gateway, 0)
+
+	booked, err := svc.RetryBookings(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Zero(t, booked)
+	assert.Len(t, shippingRepo.RecordBookingFailureCalls(), 1)
+	call := shippingRepo.RecordBookingFailureCalls()[0]
+	assert.Equal(t, contract.ID, call.ID)
+	assert.Equal(t, carriergateway.ErrCircuitOpen.Error(), call.Reason)
+	assert.NotNil(t, call.RetryAt)
+	assert.Empty(t, shippingRepo.SetTrackingCodeCalls())
+}
+
+func TestService_RetryBookings_GivesUpAfterMaxAttempts(t *testing.T) {
+	gateway := &gatewaymock.CarrierGatewayMock{
+		BookFunc: func(ctx context.Context, r carriergateway.BookingRequest) (*carriergateway.Booking, error) {
+			return nil, carriergateway.ErrCircuitOpen
+		},
+	}
+	svc, shippingRepo, _ := bookingFixture(gateway, 2)
+
+	_, err := svc.RetryBookings(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Nil(t, shippingRepo.RecordBookingFailureCalls()[0].RetryAt)
+}
@@ -0,0 +1,162 @@
+package stream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	log "go.uber.org/zap"
+)
+
+const subscriberBuffer = 64
+
+type Update struct {
+	EventID        uuid.UUID        `json:"event_id"`
+	Type           outbox.EventType `json:"type"`
+	OrderID        uuid.UUID        `json:"order_id"`
+	Status         order.Status     `json:"status"`
+	PreviousStatus *order.Status    `json:"previous_status,omitempty"`
+	DestinationUF  string           `json:"destination_uf"`
+	Region         string           `json:"region"`
+	ContractID     *uuid.UUID       `json:"contract_id,omitempty"`
+	CarrierID      *uuid.UUID       `json:"carrier_id,omitempty"`
+	OccurredAt     time.Time        `json:"occurred_at"`
+}
+
+type Filter struct {
+	OrderID uuid.UUID
+	Status  *order.Status
+	Region  string
+}
+
+func (f Filter) Matches(u Update) bool {
+	if f.OrderID != uuid.Nil && f.OrderID != u.OrderID {
+		return false
+	}
+	if f.Status != nil && *f.Status != u.Status {
+		return false
+	}
+	if f.Region != "" && f.Region != u.Region {
+		return false
+	}
+	return true
+}
+
+type Subscriber struct {
+	C       <-chan Update
+	updates chan Update
+	filter  Filter
+	dropped int
+}
+
+type Hub struct {
+	orders      order.Repository
+	mu          sync.Mutex
+	subscribers map[*Subscriber]struct{}
+}
+
+func NewHub(orders order.Repository) *Hub {
+	return &Hub{orders: orders, subscribers: map[*Subscriber]struct{}{}}
+}
+
+func (h *Hub) Subscribe(filter Filter) *Subscriber {
+	updates := make(chan Update, subscriberBuffer)
+	s := &Subscriber{C: updates, updates: updates, filter: filter}
+
+	h.mu.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mu.Unlock()
+
+	return s
+}
+
+func (h *Hub) Unsubscribe(s *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[s]; ok {
+		delete(h.subscribers, s)
+		close(s.updates)
+	}
+}
+
+func (h *Hub) Publish(ctx context.Context, event outbox.Event) error {
+	h.mu.Lock()
+	empty := len(h.subscribers) == 0
+	h.mu.Unlock()
+	if empty {
+		return nil
+	}
+
+	update, ok, err := h.resolve(ctx, event)
+	if err != nil || !ok {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscribers {
+		if !s.filter.Matches(update) {
+			continue
+		}
+		select {
+		case s.updates <- update:
+		default:
+			s.dropped++
+			log.L().
+				Warn("stream subscriber is falling behind, dropping update", log.String("event_id", update.EventID.String()), log.Int("dropped", s.dropped))
+		}
+	}
+
+	return nil
+}
+
+func (h *Hub) resolve(ctx context.Context, event outbox.Event) (Update, bool, error) {
+	update := Update{EventID: event.ID, Type: event.Type, OccurredAt: event.OccurredAt}
+
+	switch event.Type {
+	case outbox.EventOrderStatusChanged:
+		var changed outbox.OrderStatusChanged
+		if err := event.Decode(&changed); err != nil {
+			return Update{}, false, err
+		}
+		previous := order.Status(changed.From)
+		update.OrderID = changed.OrderID
+		update.Status = order.Status(changed.To)
+		update.PreviousStatus = &previous
+	case outbox.EventContractCreated:
+		var created outbox.ContractCreated
+		if err := event.Decode(&created); err != nil {
+			return Update{}, false, err
+		}
+		update.OrderID = created.OrderID
+		update.ContractID = &created.ContractID
+		update.CarrierID = &created.CarrierID
+	case outbox.EventContractCancelled:
+		var cancelled outbox.ContractCancelled
+		if err := event.Decode(&cancelled); err != nil {
+			return Update{}, false, err
+		}
+		update.OrderID = cancelled.OrderID
+		update.ContractID = &cancelled.ContractID
+		update.CarrierID = &cancelled.CarrierID
+	default:
+		return Update{}, false, nil
+	}
+
+	o, err := h.orders.GetByID(ctx, update.OrderID)
+	if err != nil {
+		return Update{}, false, err
+	}
+	if update.Status == "" {
+		update.Status = o.Status
+	}
+	update.DestinationUF = o.DestinationUF.Sigla
+	update.Region = o.DestinationUF.Region
+
+	return update, true, nil
+}
@@ -0,0 +1,103 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"github.com/victorvcruz/shipment-coordinator/internal/stream"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+)
+
+func orderRepository(orders ...*order.Order) *ordermock.RepositoryMock {
+	byID := map[uuid.UUID]*order.Order{}
+	for _, o := range orders {
+		byID[o.ID] = o
+	}
+	return &ordermock.RepositoryMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			o, ok := byID[id]
+			if !ok {
+				return nil, order.ErrOrderNotFound
+			}
+			return o, nil
+		},
+	}
+}
+
+func statusChanged(t *testing.T, o *order.Order, from order.Status) outbox.Event {
+	event, err := outbox.NewEvent(outbox.OrderStatusChanged{
+		OrderID:   o.ID,
+		From:      string(from),
+		To:        string(o.Status),
+		ChangedAt: time.Now(),
+	}, time.Now())
+	assert.NoError(t, err)
+	event.ID = uuid.New()
+	return event
+}
+
+func TestHub_Publish_FiltersByStatusAndRegion(t *testing.T) {
+	north := &order.Order{ID: uuid.New(), DestinationUF: states.AM, Status: order.StatusPickedUp}
+	south := &order.Order{ID: uuid.New(), DestinationUF: states.RS, Status: order.StatusPickedUp}
+	hub := stream.NewHub(orderRepository(north, south))
+
+	status := order.StatusPickedUp
+	sul := hub.Subscribe(stream.Filter{Status: &status, Region: states.Sul.Name})
+	single := hub.Subscribe(stream.Filter{OrderID: north.ID})
+	defer hub.Unsubscribe(sul)
+	defer hub.Unsubscribe(single)
+
+	assert.NoError(t, hub.Publish(context.Background(), statusChanged(t, north, order.StatusAwaitingPickup)))
+	assert.NoError(t, hub.Publish(context.Background(), statusChanged(t, south, order.StatusAwaitingPickup)))
+
+	update := <-sul.C
+	assert.Equal(t, south.ID, update.OrderID)
+	assert.Equal(t, "RS", update.DestinationUF)
+	assert.Equal(t, order.StatusAwaitingPickup, *update.PreviousStatus)
+	assert.Empty(t, sul.C)
+
+	update = <-single.C
+	assert.Equal(t, north.ID, update.OrderID)
+	assert.Equal(t, order.StatusPickedUp, update.Status)
+	assert.Empty(t, single.C)
+}
+
+func TestHub_Publish_ContractEventUsesCurrentStatus(t *testing.T) {
+	o := &order.Order{ID: uuid.New(), DestinationUF: states.SP, Status: order.StatusAwaitingPickup}
+	hub := stream.NewHub(orderRepository(o))
+	subscriber := hub.Subscribe(stream.Filter{})
+	defer hub.Unsubscribe(subscriber)
+
+	contractID, carrierID := uuid.New(), uuid.New()
+	event, err := outbox.NewEvent(outbox.ContractCreated{ContractID: contractID, OrderID: o.ID, CarrierID: carrierID}, time.Now())
+	assert.NoError(t, err)
+
+	assert.NoError(t, hub.Publish(context.Background(), event))
+
+	update := <-subscriber.C
+	assert.Equal(t, outbox.EventContractCreated, update.Type)
+	assert.Equal(t, order.StatusAwaitingPickup, update.Status)
+	assert.Equal(t, contractID, *update.ContractID)
+	assert.Equal(t, carrierID, *update.CarrierID)
+	assert.Equal(t, "Sudeste", update.Region)
+}
+
+func TestHub_Publish_IgnoresUnrelatedEvents(t *testing.T) {
+	repository := orderRepository()
+	hub := stream.NewHub(repository)
+	subscriber := hub.Subscribe(stream.Filter{})
+	defer hub.Unsubscribe(subscriber)
+
+	event, err := outbox.NewEvent(outbox.OrderCreated{OrderID: uuid.New(), Status: "created"}, time.Now())
+	assert.NoError(t, err)
+
+	assert.NoError(t, hub.Publish(context.Background(), event))
+	assert.Empty(t, subscriber.C)
+	assert.Empty(t, repository.GetByIDCalls())
+}
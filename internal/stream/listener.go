@@ -0,0 +1,74 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	log "go.uber.org/zap"
+)
+
+const (
+	Channel          = "outbox_events"
+	reconnectBackoff = time.Second
+)
+
+type Listener struct {
+	pool *pgxpool.Pool
+	hub  *Hub
+}
+
+func NewListener(pool *pgxpool.Pool, hub *Hub) *Listener {
+	return &Listener{pool: pool, hub: hub}
+}
+
+func (l *Listener) Run(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.L().
+			Warn("stream listener disconnected, reconnecting", log.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectBackoff):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var event outbox.Event
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			log.L().
+				Error("failed to decode stream notification", log.Error(err))
+			continue
+		}
+
+		if err := l.hub.Publish(ctx, event); err != nil {
+			log.L().
+				Warn("failed to publish stream update", log.String("event_id", event.ID.String()), log.Error(err))
+		}
+	}
+}
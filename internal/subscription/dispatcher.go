@@ -0,0 +1,192 @@
+package subscription
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	log "go.uber.org/zap"
+)
+
+const (
+	defaultInterval    = 5 * time.Second
+	defaultBatchSize   = 50
+	defaultTimeout     = 10 * time.Second
+	defaultMaxAttempts = 8
+	defaultBackoff     = 30 * time.Second
+	defaultMaxBackoff  = 6 * time.Hour
+	maxBackoffShift    = 30
+)
+
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+type DispatcherConfig struct {
+	Interval  time.Duration
+	BatchSize int
+	Timeout   time.Duration
+	Lease     time.Duration
+	Retry     RetryPolicy
+}
+
+type Dispatcher struct {
+	repo   Repository
+	client *http.Client
+	config DispatcherConfig
+	now    func() time.Time
+}
+
+func NewDispatcher(repo Repository, client *http.Client, config DispatcherConfig) *Dispatcher {
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.Lease <= 0 {
+		config.Lease = config.Timeout * time.Duration(config.BatchSize)
+	}
+	if config.Retry.MaxAttempts <= 0 {
+		config.Retry.MaxAttempts = defaultMaxAttempts
+	}
+	if config.Retry.Backoff <= 0 {
+		config.Retry.Backoff = defaultBackoff
+	}
+	if config.Retry.MaxBackoff <= 0 {
+		config.Retry.MaxBackoff = defaultMaxBackoff
+	}
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	return &Dispatcher{repo: repo, client: client, config: config, now: time.Now}
+}
+
+func (p RetryPolicy) backoff(attempts int) time.Duration {
+	shift := min(attempts-1, maxBackoffShift)
+	delay := p.Backoff << shift
+	if delay <= 0 || delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return delay
+}
+
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.Flush(ctx); err != nil && ctx.Err() == nil {
+			log.L().
+				Warn("failed to dispatch webhook deliveries", log.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) Flush(ctx context.Context) (int, error) {
+	deliveries, err := d.repo.ClaimDueDeliveries(ctx, d.now().UTC(), d.config.BatchSize, d.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	subscriptions := map[uuid.UUID]*Subscription{}
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		s, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			if s, err = d.repo.GetSubscription(ctx, delivery.SubscriptionID); err != nil {
+				return sent, err
+			}
+			subscriptions[delivery.SubscriptionID] = s
+		}
+		if !s.Active {
+			continue
+		}
+
+		if _, err := d.Deliver(ctx, s, delivery); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (d *Dispatcher) Deliver(ctx context.Context, s *Subscription, delivery *Delivery) (*Attempt, error) {
+	attempt := d.send(ctx, s, delivery)
+	delivery.record(attempt, d.config.Retry)
+
+	if err := d.repo.RecordAttempt(ctx, delivery, attempt); err != nil {
+		log.L().
+			Error("failed to record webhook delivery attempt", log.String("delivery_id", delivery.ID.String()), log.Error(err))
+		return nil, err
+	}
+
+	if attempt.Error != nil {
+		log.L().
+			Warn("webhook delivery failed", log.String("delivery_id", delivery.ID.String()), log.String("status", string(delivery.Status)), log.String("error", *attempt.Error))
+	}
+
+	return attempt, nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, s *Subscription, delivery *Delivery) *Attempt {
+	ctx, cancel := context.WithTimeout(ctx, d.config.Timeout)
+	defer cancel()
+
+	start := d.now()
+	attempt := &Attempt{DeliveryID: delivery.ID, AttemptedAt: start.UTC()}
+	fail := func(err error) *Attempt {
+		message := err.Error()
+		attempt.Error = &message
+		attempt.Duration = d.now().Sub(start)
+		return attempt
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fail(err)
+	}
+
+	timestamp := start.Unix()
+	nonce := delivery.ID.String()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Event", string(delivery.EventType))
+	req.Header.Set("X-Webhook-Timestamp", fmt.Sprint(timestamp))
+	req.Header.Set("X-Webhook-Nonce", nonce)
+	req.Header.Set("X-Webhook-Signature", carrierwebhook.Sign(s.Secret, timestamp, nonce, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fail(err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	attempt.StatusCode = &resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fail(fmt.Errorf("subscriber responded %d", resp.StatusCode))
+	}
+
+	attempt.Duration = d.now().Sub(start)
+	return attempt
+}
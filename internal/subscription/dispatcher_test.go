@@ -0,0 +1,196 @@
+package subscription_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/carrierwebhook"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription/mocks"
+)
+
+const testSecret = "0123456789abcdef"
+
+func subscriber(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		status := statuses[len(statuses)-1]
+		if int(n) <= len(statuses) {
+			status = statuses[n-1]
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get("X-Webhook-Timestamp"), 10, 64)
+		signed := carrierwebhook.Signed{
+			Timestamp: timestamp,
+			Nonce:     r.Header.Get("X-Webhook-Nonce"),
+			Signature: r.Header.Get("X-Webhook-Signature"),
+			Body:      body,
+		}
+		if !signed.Verify(testSecret) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func deliveryRepository(s subscription.Subscription, deliveries ...*subscription.Delivery) *mocks.RepositoryMock {
+	return &mocks.RepositoryMock{
+		GetSubscriptionFunc: func(ctx context.Context, id uuid.UUID) (*subscription.Subscription, error) {
+			return &s, nil
+		},
+		ClaimDueDeliveriesFunc: func(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]subscription.Delivery, error) {
+			due := []subscription.Delivery{}
+			for _, d := range deliveries {
+				if d.Status == subscription.DeliveryStatusPending && !d.NextAttemptAt.After(now) {
+					d.NextAttemptAt = now.Add(lease)
+					due = append(due, *d)
+				}
+			}
+			return due, nil
+		},
+		RecordAttemptFunc: func(ctx context.Context, d *subscription.Delivery, a *subscription.Attempt) error {
+			for _, stored := range deliveries {
+				if stored.ID == d.ID {
+					*stored = *d
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func pendingDelivery(s subscription.Subscription) *subscription.Delivery {
+	return &subscription.Delivery{
+		ID:             uuid.New(),
+		SubscriptionID: s.ID,
+		EventID:        uuid.New(),
+		EventType:      outbox.EventOrderStatusChanged,
+		Payload:        []byte(`{"type":"order.status_changed"}`),
+		Status:         subscription.DeliveryStatusPending,
+	}
+}
+
+func TestDispatcher_DeliversSignedPayload(t *testing.T) {
+	srv, calls := subscriber(t, http.StatusNoContent)
+	s := subscription.Subscription{ID: uuid.New(), URL: srv.URL, Secret: testSecret, Active: true}
+	delivery := pendingDelivery(s)
+	repo := deliveryRepository(s, delivery)
+
+	dispatcher := subscription.NewDispatcher(repo, srv.Client(), subscription.DispatcherConfig{})
+	sent, err := dispatcher.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+
+	assert.Equal(t, subscription.DeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.NotNil(t, delivery.DeliveredAt)
+	assert.Equal(t, http.StatusNoContent, *delivery.LastStatusCode)
+
+	attempt := repo.RecordAttemptCalls()[0].A
+	assert.Equal(t, 1, attempt.Number)
+	assert.Nil(t, attempt.Error)
+}
+
+func TestDispatcher_RetriesWithBackoffThenDeadLetters(t *testing.T) {
+	srv, calls := subscriber(t, http.StatusServiceUnavailable)
+	s := subscription.Subscription{ID: uuid.New(), URL: srv.URL, Secret: testSecret, Active: true}
+	delivery := pendingDelivery(s)
+	repo := deliveryRepository(s, delivery)
+
+	dispatcher := subscription.NewDispatcher(repo, srv.Client(), subscription.DispatcherConfig{
+		Retry: subscription.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond},
+	})
+
+	_, err := dispatcher.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, subscription.DeliveryStatusPending, delivery.Status)
+	assert.Equal(t, "subscriber responded 503", *delivery.LastError)
+	first := delivery.NextAttemptAt
+
+	time.Sleep(2 * time.Millisecond)
+	_, err = dispatcher.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, subscription.DeliveryStatusPending, delivery.Status)
+	assert.Equal(t, 2*time.Millisecond, delivery.NextAttemptAt.Sub(repo.RecordAttemptCalls()[1].A.AttemptedAt))
+	assert.True(t, delivery.NextAttemptAt.After(first))
+
+	time.Sleep(3 * time.Millisecond)
+	_, err = dispatcher.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, subscription.DeliveryStatusDead, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+
+	sent, err := dispatcher.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestDispatcher_SkipsInactiveSubscription(t *testing.T) {
+	srv, calls := subscriber(t, http.StatusOK)
+	s := subscription.Subscription{ID: uuid.New(), URL: srv.URL, Secret: testSecret}
+	delivery := pendingDelivery(s)
+	repo := deliveryRepository(s, delivery)
+
+	dispatcher := subscription.NewDispatcher(repo, srv.Client(), subscription.DispatcherConfig{})
+	sent, err := dispatcher.Flush(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Zero(t, atomic.LoadInt32(calls))
+	assert.Empty(t, repo.RecordAttemptCalls())
+}
+
+func TestPublisher_CreatesDeliveryPerMatchingSubscription(t *testing.T) {
+	subscriptions := []subscription.Subscription{
+		{ID: uuid.New(), EventTypes: []outbox.EventType{outbox.EventContractCreated}},
+		{ID: uuid.New(), EventTypes: []outbox.EventType{outbox.EventContractCreated, outbox.EventOrderCreated}},
+	}
+	repo := &mocks.RepositoryMock{
+		ListSubscriptionsForEventFunc: func(ctx context.Context, eventType outbox.EventType) ([]subscription.Subscription, error) {
+			return subscriptions, nil
+		},
+		InsertDeliveriesFunc: func(ctx context.Context, deliveries []*subscription.Delivery) error {
+			return nil
+		},
+	}
+
+	contractID := uuid.New()
+	event, err := outbox.NewEvent(outbox.ContractCreated{ContractID: contractID}, time.Now())
+	assert.NoError(t, err)
+	event.ID = uuid.New()
+
+	assert.NoError(t, subscription.NewPublisher(repo).Publish(context.Background(), event))
+	assert.Equal(t, outbox.EventContractCreated, repo.ListSubscriptionsForEventCalls()[0].EventType)
+
+	deliveries := repo.InsertDeliveriesCalls()[0].Deliveries
+	assert.Len(t, deliveries, 2)
+	assert.Equal(t, subscriptions[1].ID, deliveries[1].SubscriptionID)
+	assert.Equal(t, event.ID, deliveries[0].EventID)
+	assert.Equal(t, subscription.DeliveryStatusPending, deliveries[0].Status)
+
+	var body struct {
+		ID   uuid.UUID              `json:"id"`
+		Type string                 `json:"type"`
+		Data outbox.ContractCreated `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(deliveries[0].Payload, &body))
+	assert.Equal(t, event.ID, body.ID)
+	assert.Equal(t, "contract.created", body.Type)
+	assert.Equal(t, contractID, body.Data.ContractID)
+}
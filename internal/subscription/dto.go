@@ -0,0 +1,98 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateInput struct {
+	Body CreateInputBody
+}
+
+type CreateInputBody struct {
+	URL        string   `json:"url"              required:"true"  doc:"Endpoint receiving the events"                        example:"https://shop.example.com/webhooks/shipping" maxLength:"2048"`
+	EventTypes []string `json:"event_types"      required:"true"  doc:"Event types to deliver"                               example:"[\"order.status_changed\"]"                 minItems:"1"     uniqueItems:"true"`
+	Secret     string   `json:"secret,omitempty" required:"false" doc:"Secret used to sign payloads, generated when omitted" example:"whsec_0123456789abcdef"                     minLength:"16"   maxLength:"256"`
+}
+
+type CreateOutput struct {
+	Status int
+	Body   CreateOutputBody
+}
+
+type CreateOutputBody struct {
+	SubscriptionResponse
+	Secret string `json:"secret" doc:"Signing secret, shown only once" example:"whsec_0123456789abcdef"`
+}
+
+type SubscriptionInput struct {
+	ID uuid.UUID `path:"id" doc:"Subscription ID"`
+}
+
+type SubscriptionOutput struct {
+	Status int
+	Body   SubscriptionResponse
+}
+
+type ListOutput struct {
+	Status int
+	Body   []SubscriptionResponse
+}
+
+type DeleteOutput struct {
+	Status int
+}
+
+type SubscriptionResponse struct {
+	ID         string    `json:"id"          doc:"Subscription ID"                    example:"123e4567-e89b-12d3-a456-426614174000"`
+	URL        string    `json:"url"         doc:"Endpoint receiving the events"      example:"https://shop.example.com/webhooks/shipping"`
+	EventTypes []string  `json:"event_types" doc:"Event types delivered"              example:"[\"order.status_changed\"]"`
+	Active     bool      `json:"active"      doc:"Whether events are still delivered" example:"true"`
+	CreatedAt  time.Time `json:"created_at"  doc:"Creation date"                      example:"2025-06-28T15:04:05Z"`
+	UpdatedAt  time.Time `json:"updated_at"  doc:"Last update date"                   example:"2025-06-28T15:04:05Z"`
+}
+
+type ListDeliveriesInput struct {
+	ID     uuid.UUID `path:"id"      doc:"Subscription ID"`
+	Status string    `query:"status" required:"false"      doc:"Filter by delivery status"    enum:"pending,delivered,dead"`
+	Limit  int       `query:"limit"  required:"false"      doc:"Maximum number of deliveries" default:"50"                  minimum:"1" maximum:"200"`
+	Offset int       `query:"offset" required:"false"      doc:"Number of deliveries to skip" minimum:"0"`
+}
+
+type ListDeliveriesOutput struct {
+	Status int
+	Body   []DeliveryResponse
+}
+
+type DeliveryInput struct {
+	ID uuid.UUID `path:"id" doc:"Delivery ID"`
+}
+
+type DeliveryOutput struct {
+	Status int
+	Body   DeliveryResponse
+}
+
+type DeliveryResponse struct {
+	ID             string            `json:"id"                         doc:"Delivery ID"                          example:"123e4567-e89b-12d3-a456-426614174000"`
+	SubscriptionID string            `json:"subscription_id"            doc:"Subscription ID"                      example:"222e4567-e89b-12d3-a456-426614174000"`
+	EventID        string            `json:"event_id"                   doc:"Event ID, stable across retries"      example:"333e4567-e89b-12d3-a456-426614174000"`
+	EventType      string            `json:"event_type"                 doc:"Event type"                           example:"order.status_changed"`
+	Status         string            `json:"status"                     doc:"Delivery status"                      example:"pending"                              enum:"pending,delivered,dead"`
+	Attempts       int               `json:"attempts"                   doc:"Attempts made so far"                 example:"2"`
+	NextAttemptAt  *time.Time        `json:"next_attempt_at,omitempty"  doc:"When the next automatic attempt runs" example:"2025-06-28T15:05:05Z"`
+	LastStatusCode *int              `json:"last_status_code,omitempty" doc:"HTTP status of the last attempt"      example:"503"`
+	LastError      *string           `json:"last_error,omitempty"       doc:"Error of the last attempt"            example:"subscriber responded 503"`
+	DeliveredAt    *time.Time        `json:"delivered_at,omitempty"     doc:"Date of the successful attempt"       example:"2025-06-28T15:04:06Z"`
+	CreatedAt      time.Time         `json:"created_at"                 doc:"Creation date"                        example:"2025-06-28T15:04:05Z"`
+	Log            []AttemptResponse `json:"log,omitempty"              doc:"Attempts made, oldest first"`
+}
+
+type AttemptResponse struct {
+	Number      int       `json:"number"                doc:"Attempt number"                         example:"1"`
+	StatusCode  *int      `json:"status_code,omitempty" doc:"HTTP status returned by the subscriber" example:"503"`
+	Error       *string   `json:"error,omitempty"       doc:"Attempt error"                          example:"subscriber responded 503"`
+	DurationMs  int64     `json:"duration_ms"           doc:"Request duration in milliseconds"       example:"120"`
+	AttemptedAt time.Time `json:"attempted_at"          doc:"Attempt date"                           example:"2025-06-28T15:04:05Z"`
+}
@@ -0,0 +1,603 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription"
+	"sync"
+	"time"
+)
+
+// Ensure, that RepositoryMock does implement subscription.Repository.
+// If this is not the case, regenerate this file with moq.
+var _ subscription.Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of subscription.Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked subscription.Repository
+//		mockedRepository := &RepositoryMock{
+//			ClaimDueDeliveriesFunc: func(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]subscription.Delivery, error) {
+//				panic("mock out the ClaimDueDeliveries method")
+//			},
+//			DeactivateSubscriptionFunc: func(ctx context.Context, id uuid.UUID, at time.Time) error {
+//				panic("mock out the DeactivateSubscription method")
+//			},
+//			GetDeliveryFunc: func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+//				panic("mock out the GetDelivery method")
+//			},
+//			GetSubscriptionFunc: func(ctx context.Context, id uuid.UUID) (*subscription.Subscription, error) {
+//				panic("mock out the GetSubscription method")
+//			},
+//			InsertDeliveriesFunc: func(ctx context.Context, deliveries []*subscription.Delivery) error {
+//				panic("mock out the InsertDeliveries method")
+//			},
+//			InsertSubscriptionFunc: func(ctx context.Context, s *subscription.Subscription) (uuid.UUID, error) {
+//				panic("mock out the InsertSubscription method")
+//			},
+//			ListAttemptsFunc: func(ctx context.Context, deliveryID uuid.UUID) ([]subscription.Attempt, error) {
+//				panic("mock out the ListAttempts method")
+//			},
+//			ListDeliveriesFunc: func(ctx context.Context, filter subscription.DeliveryFilter) ([]subscription.Delivery, error) {
+//				panic("mock out the ListDeliveries method")
+//			},
+//			ListSubscriptionsFunc: func(ctx context.Context) ([]subscription.Subscription, error) {
+//				panic("mock out the ListSubscriptions method")
+//			},
+//			ListSubscriptionsForEventFunc: func(ctx context.Context, eventType outbox.EventType) ([]subscription.Subscription, error) {
+//				panic("mock out the ListSubscriptionsForEvent method")
+//			},
+//			RecordAttemptFunc: func(ctx context.Context, d *subscription.Delivery, a *subscription.Attempt) error {
+//				panic("mock out the RecordAttempt method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires subscription.Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// ClaimDueDeliveriesFunc mocks the ClaimDueDeliveries method.
+	ClaimDueDeliveriesFunc func(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]subscription.Delivery, error)
+
+	// DeactivateSubscriptionFunc mocks the DeactivateSubscription method.
+	DeactivateSubscriptionFunc func(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// GetDeliveryFunc mocks the GetDelivery method.
+	GetDeliveryFunc func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error)
+
+	// GetSubscriptionFunc mocks the GetSubscription method.
+	GetSubscriptionFunc func(ctx context.Context, id uuid.UUID) (*subscription.Subscription, error)
+
+	// InsertDeliveriesFunc mocks the InsertDeliveries method.
+	InsertDeliveriesFunc func(ctx context.Context, deliveries []*subscription.Delivery) error
+
+	// InsertSubscriptionFunc mocks the InsertSubscription method.
+	InsertSubscriptionFunc func(ctx context.Context, s *subscription.Subscription) (uuid.UUID, error)
+
+	// ListAttemptsFunc mocks the ListAttempts method.
+	ListAttemptsFunc func(ctx context.Context, deliveryID uuid.UUID) ([]subscription.Attempt, error)
+
+	// ListDeliveriesFunc mocks the ListDeliveries method.
+	ListDeliveriesFunc func(ctx context.Context, filter subscription.DeliveryFilter) ([]subscription.Delivery, error)
+
+	// ListSubscriptionsFunc mocks the ListSubscriptions method.
+	ListSubscriptionsFunc func(ctx context.Context) ([]subscription.Subscription, error)
+
+	// ListSubscriptionsForEventFunc mocks the ListSubscriptionsForEvent method.
+	ListSubscriptionsForEventFunc func(ctx context.Context, eventType outbox.EventType) ([]subscription.Subscription, error)
+
+	// RecordAttemptFunc mocks the RecordAttempt method.
+	RecordAttemptFunc func(ctx context.Context, d *subscription.Delivery, a *subscription.Attempt) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClaimDueDeliveries holds details about calls to the ClaimDueDeliveries method.
+		ClaimDueDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+			// Limit is the limit argument value.
+			Limit int
+			// Lease is the lease argument value.
+			Lease time.Duration
+		}
+		// DeactivateSubscription holds details about calls to the DeactivateSubscription method.
+		DeactivateSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// At is the at argument value.
+			At time.Time
+		}
+		// GetDelivery holds details about calls to the GetDelivery method.
+		GetDelivery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// GetSubscription holds details about calls to the GetSubscription method.
+		GetSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// InsertDeliveries holds details about calls to the InsertDeliveries method.
+		InsertDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Deliveries is the deliveries argument value.
+			Deliveries []*subscription.Delivery
+		}
+		// InsertSubscription holds details about calls to the InsertSubscription method.
+		InsertSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S *subscription.Subscription
+		}
+		// ListAttempts holds details about calls to the ListAttempts method.
+		ListAttempts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DeliveryID is the deliveryID argument value.
+			DeliveryID uuid.UUID
+		}
+		// ListDeliveries holds details about calls to the ListDeliveries method.
+		ListDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter subscription.DeliveryFilter
+		}
+		// ListSubscriptions holds details about calls to the ListSubscriptions method.
+		ListSubscriptions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListSubscriptionsForEvent holds details about calls to the ListSubscriptionsForEvent method.
+		ListSubscriptionsForEvent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EventType is the eventType argument value.
+			EventType outbox.EventType
+		}
+		// RecordAttempt holds details about calls to the RecordAttempt method.
+		RecordAttempt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// D is the d argument value.
+			D *subscription.Delivery
+			// A is the a argument value.
+			A *subscription.Attempt
+		}
+	}
+	lockClaimDueDeliveries        sync.RWMutex
+	lockDeactivateSubscription    sync.RWMutex
+	lockGetDelivery               sync.RWMutex
+	lockGetSubscription           sync.RWMutex
+	lockInsertDeliveries          sync.RWMutex
+	lockInsertSubscription        sync.RWMutex
+	lockListAttempts              sync.RWMutex
+	lockListDeliveries            sync.RWMutex
+	lockListSubscriptions         sync.RWMutex
+	lockListSubscriptionsForEvent sync.RWMutex
+	lockRecordAttempt             sync.RWMutex
+}
+
+// ClaimDueDeliveries calls ClaimDueDeliveriesFunc.
+func (mock *RepositoryMock) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]subscription.Delivery, error) {
+	if mock.ClaimDueDeliveriesFunc == nil {
+		panic("RepositoryMock.ClaimDueDeliveriesFunc: method is nil but Repository.ClaimDueDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+		Lease time.Duration
+	}{
+		Ctx:   ctx,
+		Now:   now,
+		Limit: limit,
+		Lease: lease,
+	}
+	mock.lockClaimDueDeliveries.Lock()
+	mock.calls.ClaimDueDeliveries = append(mock.calls.ClaimDueDeliveries, callInfo)
+	mock.lockClaimDueDeliveries.Unlock()
+	return mock.ClaimDueDeliveriesFunc(ctx, now, limit, lease)
+}
+
+// ClaimDueDeliveriesCalls gets all the calls that were made to ClaimDueDeliveries.
+// Check the length with:
+//
+//	len(mockedRepository.ClaimDueDeliveriesCalls())
+func (mock *RepositoryMock) ClaimDueDeliveriesCalls() []struct {
+	Ctx   context.Context
+	Now   time.Time
+	Limit int
+	Lease time.Duration
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int
+		Lease time.Duration
+	}
+	mock.lockClaimDueDeliveries.RLock()
+	calls = mock.calls.ClaimDueDeliveries
+	mock.lockClaimDueDeliveries.RUnlock()
+	return calls
+}
+
+// DeactivateSubscription calls DeactivateSubscriptionFunc.
+func (mock *RepositoryMock) DeactivateSubscription(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if mock.DeactivateSubscriptionFunc == nil {
+		panic("RepositoryMock.DeactivateSubscriptionFunc: method is nil but Repository.DeactivateSubscription was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		At:  at,
+	}
+	mock.lockDeactivateSubscription.Lock()
+	mock.calls.DeactivateSubscription = append(mock.calls.DeactivateSubscription, callInfo)
+	mock.lockDeactivateSubscription.Unlock()
+	return mock.DeactivateSubscriptionFunc(ctx, id, at)
+}
+
+// DeactivateSubscriptionCalls gets all the calls that were made to DeactivateSubscription.
+// Check the length with:
+//
+//	len(mockedRepository.DeactivateSubscriptionCalls())
+func (mock *RepositoryMock) DeactivateSubscriptionCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		At  time.Time
+	}
+	mock.lockDeactivateSubscription.RLock()
+	calls = mock.calls.DeactivateSubscription
+	mock.lockDeactivateSubscription.RUnlock()
+	return calls
+}
+
+// GetDelivery calls GetDeliveryFunc.
+func (mock *RepositoryMock) GetDelivery(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+	if mock.GetDeliveryFunc == nil {
+		panic("RepositoryMock.GetDeliveryFunc: method is nil but Repository.GetDelivery was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetDelivery.Lock()
+	mock.calls.GetDelivery = append(mock.calls.GetDelivery, callInfo)
+	mock.lockGetDelivery.Unlock()
+	return mock.GetDeliveryFunc(ctx, id)
+}
+
+// GetDeliveryCalls gets all the calls that were made to GetDelivery.
+// Check the length with:
+//
+//	len(mockedRepository.GetDeliveryCalls())
+func (mock *RepositoryMock) GetDeliveryCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetDelivery.RLock()
+	calls = mock.calls.GetDelivery
+	mock.lockGetDelivery.RUnlock()
+	return calls
+}
+
+// GetSubscription calls GetSubscriptionFunc.
+func (mock *RepositoryMock) GetSubscription(ctx context.Context, id uuid.UUID) (*subscription.Subscription, error) {
+	if mock.GetSubscriptionFunc == nil {
+		panic("RepositoryMock.GetSubscriptionFunc: method is nil but Repository.GetSubscription was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetSubscription.Lock()
+	mock.calls.GetSubscription = append(mock.calls.GetSubscription, callInfo)
+	mock.lockGetSubscription.Unlock()
+	return mock.GetSubscriptionFunc(ctx, id)
+}
+
+// GetSubscriptionCalls gets all the calls that were made to GetSubscription.
+// Check the length with:
+//
+//	len(mockedRepository.GetSubscriptionCalls())
+func (mock *RepositoryMock) GetSubscriptionCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetSubscription.RLock()
+	calls = mock.calls.GetSubscription
+	mock.lockGetSubscription.RUnlock()
+	return calls
+}
+
+// InsertDeliveries calls InsertDeliveriesFunc.
+func (mock *RepositoryMock) InsertDeliveries(ctx context.Context, deliveries []*subscription.Delivery) error {
+	if mock.InsertDeliveriesFunc == nil {
+		panic("RepositoryMock.InsertDeliveriesFunc: method is nil but Repository.InsertDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Deliveries []*subscription.Delivery
+	}{
+		Ctx:        ctx,
+		Deliveries: deliveries,
+	}
+	mock.lockInsertDeliveries.Lock()
+	mock.calls.InsertDeliveries = append(mock.calls.InsertDeliveries, callInfo)
+	mock.lockInsertDeliveries.Unlock()
+	return mock.InsertDeliveriesFunc(ctx, deliveries)
+}
+
+// InsertDeliveriesCalls gets all the calls that were made to InsertDeliveries.
+// Check the length with:
+//
+//	len(mockedRepository.InsertDeliveriesCalls())
+func (mock *RepositoryMock) InsertDeliveriesCalls() []struct {
+	Ctx        context.Context
+	Deliveries []*subscription.Delivery
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Deliveries []*subscription.Delivery
+	}
+	mock.lockInsertDeliveries.RLock()
+	calls = mock.calls.InsertDeliveries
+	mock.lockInsertDeliveries.RUnlock()
+	return calls
+}
+
+// InsertSubscription calls InsertSubscriptionFunc.
+func (mock *RepositoryMock) InsertSubscription(ctx context.Context, s *subscription.Subscription) (uuid.UUID, error) {
+	if mock.InsertSubscriptionFunc == nil {
+		panic("RepositoryMock.InsertSubscriptionFunc: method is nil but Repository.InsertSubscription was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   *subscription.Subscription
+	}{
+		Ctx: ctx,
+		S:   s,
+	}
+	mock.lockInsertSubscription.Lock()
+	mock.calls.InsertSubscription = append(mock.calls.InsertSubscription, callInfo)
+	mock.lockInsertSubscription.Unlock()
+	return mock.InsertSubscriptionFunc(ctx, s)
+}
+
+// InsertSubscriptionCalls gets all the calls that were made to InsertSubscription.
+// Check the length with:
+//
+//	len(mockedRepository.InsertSubscriptionCalls())
+func (mock *RepositoryMock) InsertSubscriptionCalls() []struct {
+	Ctx context.Context
+	S   *subscription.Subscription
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   *subscription.Subscription
+	}
+	mock.lockInsertSubscription.RLock()
+	calls = mock.calls.InsertSubscription
+	mock.lockInsertSubscription.RUnlock()
+	return calls
+}
+
+// ListAttempts calls ListAttemptsFunc.
+func (mock *RepositoryMock) ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]subscription.Attempt, error) {
+	if mock.ListAttemptsFunc == nil {
+		panic("RepositoryMock.ListAttemptsFunc: method is nil but Repository.ListAttempts was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DeliveryID uuid.UUID
+	}{
+		Ctx:        ctx,
+		DeliveryID: deliveryID,
+	}
+	mock.lockListAttempts.Lock()
+	mock.calls.ListAttempts = append(mock.calls.ListAttempts, callInfo)
+	mock.lockListAttempts.Unlock()
+	return mock.ListAttemptsFunc(ctx, deliveryID)
+}
+
+// ListAttemptsCalls gets all the calls that were made to ListAttempts.
+// Check the length with:
+//
+//	len(mockedRepository.ListAttemptsCalls())
+func (mock *RepositoryMock) ListAttemptsCalls() []struct {
+	Ctx        context.Context
+	DeliveryID uuid.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DeliveryID uuid.UUID
+	}
+	mock.lockListAttempts.RLock()
+	calls = mock.calls.ListAttempts
+	mock.lockListAttempts.RUnlock()
+	return calls
+}
+
+// ListDeliveries calls ListDeliveriesFunc.
+func (mock *RepositoryMock) ListDeliveries(ctx context.Context, filter subscription.DeliveryFilter) ([]subscription.Delivery, error) {
+	if mock.ListDeliveriesFunc == nil {
+		panic("RepositoryMock.ListDeliveriesFunc: method is nil but Repository.ListDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter subscription.DeliveryFilter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockListDeliveries.Lock()
+	mock.calls.ListDeliveries = append(mock.calls.ListDeliveries, callInfo)
+	mock.lockListDeliveries.Unlock()
+	return mock.ListDeliveriesFunc(ctx, filter)
+}
+
+// ListDeliveriesCalls gets all the calls that were made to ListDeliveries.
+// Check the length with:
+//
+//	len(mockedRepository.ListDeliveriesCalls())
+func (mock *RepositoryMock) ListDeliveriesCalls() []struct {
+	Ctx    context.Context
+	Filter subscription.DeliveryFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter subscription.DeliveryFilter
+	}
+	mock.lockListDeliveries.RLock()
+	calls = mock.calls.ListDeliveries
+	mock.lockListDeliveries.RUnlock()
+	return calls
+}
+
+// ListSubscriptions calls ListSubscriptionsFunc.
+func (mock *RepositoryMock) ListSubscriptions(ctx context.Context) ([]subscription.Subscription, error) {
+	if mock.ListSubscriptionsFunc == nil {
+		panic("RepositoryMock.ListSubscriptionsFunc: method is nil but Repository.ListSubscriptions was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListSubscriptions.Lock()
+	mock.calls.ListSubscriptions = append(mock.calls.ListSubscriptions, callInfo)
+	mock.lockListSubscriptions.Unlock()
+	return mock.ListSubscriptionsFunc(ctx)
+}
+
+// ListSubscriptionsCalls gets all the calls that were made to ListSubscriptions.
+// Check the length with:
+//
+//	len(mockedRepository.ListSubscriptionsCalls())
+func (mock *RepositoryMock) ListSubscriptionsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListSubscriptions.RLock()
+	calls = mock.calls.ListSubscriptions
+	mock.lockListSubscriptions.RUnlock()
+	return calls
+}
+
+// ListSubscriptionsForEvent calls ListSubscriptionsForEventFunc.
+func (mock *RepositoryMock) ListSubscriptionsForEvent(ctx context.Context, eventType outbox.EventType) ([]subscription.Subscription, error) {
+	if mock.ListSubscriptionsForEventFunc == nil {
+		panic("RepositoryMock.ListSubscriptionsForEventFunc: method is nil but Repository.ListSubscriptionsForEvent was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EventType outbox.EventType
+	}{
+		Ctx:       ctx,
+		EventType: eventType,
+	}
+	mock.lockListSubscriptionsForEvent.Lock()
+	mock.calls.ListSubscriptionsForEvent = append(mock.calls.ListSubscriptionsForEvent, callInfo)
+	mock.lockListSubscriptionsForEvent.Unlock()
+	return mock.ListSubscriptionsForEventFunc(ctx, eventType)
+}
+
+// ListSubscriptionsForEventCalls gets all the calls that were made to ListSubscriptionsForEvent.
+// Check the length with:
+//
+//	len(mockedRepository.ListSubscriptionsForEventCalls())
+func (mock *RepositoryMock) ListSubscriptionsForEventCalls() []struct {
+	Ctx       context.Context
+	EventType outbox.EventType
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EventType outbox.EventType
+	}
+	mock.lockListSubscriptionsForEvent.RLock()
+	calls = mock.calls.ListSubscriptionsForEvent
+	mock.lockListSubscriptionsForEvent.RUnlock()
+	return calls
+}
+
+// RecordAttempt calls RecordAttemptFunc.
+func (mock *RepositoryMock) RecordAttempt(ctx context.Context, d *subscription.Delivery, a *subscription.Attempt) error {
+	if mock.RecordAttemptFunc == nil {
+		panic("RepositoryMock.RecordAttemptFunc: method is nil but Repository.RecordAttempt was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		D   *subscription.Delivery
+		A   *subscription.Attempt
+	}{
+		Ctx: ctx,
+		D:   d,
+		A:   a,
+	}
+	mock.lockRecordAttempt.Lock()
+	mock.calls.RecordAttempt = append(mock.calls.RecordAttempt, callInfo)
+	mock.lockRecordAttempt.Unlock()
+	return mock.RecordAttemptFunc(ctx, d, a)
+}
+
+// RecordAttemptCalls gets all the calls that were made to RecordAttempt.
+// Check the length with:
+//
+//	len(mockedRepository.RecordAttemptCalls())
+func (mock *RepositoryMock) RecordAttemptCalls() []struct {
+	Ctx context.Context
+	D   *subscription.Delivery
+	A   *subscription.Attempt
+} {
+	var calls []struct {
+		Ctx context.Context
+		D   *subscription.Delivery
+		A   *subscription.Attempt
+	}
+	mock.lockRecordAttempt.RLock()
+	calls = mock.calls.RecordAttempt
+	mock.lockRecordAttempt.RUnlock()
+	return calls
+}
@@ -0,0 +1,389 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription"
+	"sync"
+)
+
+// Ensure, that ServiceMock does implement subscription.Service.
+// If this is not the case, regenerate this file with moq.
+var _ subscription.Service = &ServiceMock{}
+
+// ServiceMock is a mock implementation of subscription.Service.
+//
+//	func TestSomethingThatUsesService(t *testing.T) {
+//
+//		// make and configure a mocked subscription.Service
+//		mockedService := &ServiceMock{
+//			CreateFunc: func(ctx context.Context, rawURL string, eventTypes []string, secret string) (*subscription.Subscription, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(ctx context.Context, id uuid.UUID) (*subscription.Subscription, error) {
+//				panic("mock out the Get method")
+//			},
+//			GetDeliveryFunc: func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+//				panic("mock out the GetDelivery method")
+//			},
+//			ListFunc: func(ctx context.Context) ([]subscription.Subscription, error) {
+//				panic("mock out the List method")
+//			},
+//			ListDeliveriesFunc: func(ctx context.Context, filter subscription.DeliveryFilter) ([]subscription.Delivery, error) {
+//				panic("mock out the ListDeliveries method")
+//			},
+//			RedeliverFunc: func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+//				panic("mock out the Redeliver method")
+//			},
+//		}
+//
+//		// use mockedService in code that requires subscription.Service
+//		// and then make assertions.
+//
+//	}
+type ServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, rawURL string, eventTypes []string, secret string) (*subscription.Subscription, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id uuid.UUID) error
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id uuid.UUID) (*subscription.Subscription, error)
+
+	// GetDeliveryFunc mocks the GetDelivery method.
+	GetDeliveryFunc func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context) ([]subscription.Subscription, error)
+
+	// ListDeliveriesFunc mocks the ListDeliveries method.
+	ListDeliveriesFunc func(ctx context.Context, filter subscription.DeliveryFilter) ([]subscription.Delivery, error)
+
+	// RedeliverFunc mocks the Redeliver method.
+	RedeliverFunc func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RawURL is the rawURL argument value.
+			RawURL string
+			// EventTypes is the eventTypes argument value.
+			EventTypes []string
+			// Secret is the secret argument value.
+			Secret string
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// GetDelivery holds details about calls to the GetDelivery method.
+		GetDelivery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListDeliveries holds details about calls to the ListDeliveries method.
+		ListDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter subscription.DeliveryFilter
+		}
+		// Redeliver holds details about calls to the Redeliver method.
+		Redeliver []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+	}
+	lockCreate         sync.RWMutex
+	lockDelete         sync.RWMutex
+	lockGet            sync.RWMutex
+	lockGetDelivery    sync.RWMutex
+	lockList           sync.RWMutex
+	lockListDeliveries sync.RWMutex
+	lockRedeliver      sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *ServiceMock) Create(ctx context.Context, rawURL string, eventTypes []string, secret string) (*subscription.Subscription, error) {
+	if mock.CreateFunc == nil {
+		panic("ServiceMock.CreateFunc: method is nil but Service.Create was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		RawURL     string
+		EventTypes []string
+		Secret     string
+	}{
+		Ctx:        ctx,
+		RawURL:     rawURL,
+		EventTypes: eventTypes,
+		Secret:     secret,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, rawURL, eventTypes, secret)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedService.CreateCalls())
+func (mock *ServiceMock) CreateCalls() []struct {
+	Ctx        context.Context
+	RawURL     string
+	EventTypes []string
+	Secret     string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RawURL     string
+		EventTypes []string
+		Secret     string
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *ServiceMock) Delete(ctx context.Context, id uuid.UUID) error {
+	if mock.DeleteFunc == nil {
+		panic("ServiceMock.DeleteFunc: method is nil but Service.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedService.DeleteCalls())
+func (mock *ServiceMock) DeleteCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *ServiceMock) Get(ctx context.Context, id uuid.UUID) (*subscription.Subscription, error) {
+	if mock.GetFunc == nil {
+		panic("ServiceMock.GetFunc: method is nil but Service.Get was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedService.GetCalls())
+func (mock *ServiceMock) GetCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetDelivery calls GetDeliveryFunc.
+func (mock *ServiceMock) GetDelivery(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+	if mock.GetDeliveryFunc == nil {
+		panic("ServiceMock.GetDeliveryFunc: method is nil but Service.GetDelivery was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetDelivery.Lock()
+	mock.calls.GetDelivery = append(mock.calls.GetDelivery, callInfo)
+	mock.lockGetDelivery.Unlock()
+	return mock.GetDeliveryFunc(ctx, id)
+}
+
+// GetDeliveryCalls gets all the calls that were made to GetDelivery.
+// Check the length with:
+//
+//	len(mockedService.GetDeliveryCalls())
+func (mock *ServiceMock) GetDeliveryCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockGetDelivery.RLock()
+	calls = mock.calls.GetDelivery
+	mock.lockGetDelivery.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *ServiceMock) List(ctx context.Context) ([]subscription.Subscription, error) {
+	if mock.ListFunc == nil {
+		panic("ServiceMock.ListFunc: method is nil but Service.List was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedService.ListCalls())
+func (mock *ServiceMock) ListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// ListDeliveries calls ListDeliveriesFunc.
+func (mock *ServiceMock) ListDeliveries(ctx context.Context, filter subscription.DeliveryFilter) ([]subscription.Delivery, error) {
+	if mock.ListDeliveriesFunc == nil {
+		panic("ServiceMock.ListDeliveriesFunc: method is nil but Service.ListDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter subscription.DeliveryFilter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockListDeliveries.Lock()
+	mock.calls.ListDeliveries = append(mock.calls.ListDeliveries, callInfo)
+	mock.lockListDeliveries.Unlock()
+	return mock.ListDeliveriesFunc(ctx, filter)
+}
+
+// ListDeliveriesCalls gets all the calls that were made to ListDeliveries.
+// Check the length with:
+//
+//	len(mockedService.ListDeliveriesCalls())
+func (mock *ServiceMock) ListDeliveriesCalls() []struct {
+	Ctx    context.Context
+	Filter subscription.DeliveryFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter subscription.DeliveryFilter
+	}
+	mock.lockListDeliveries.RLock()
+	calls = mock.calls.ListDeliveries
+	mock.lockListDeliveries.RUnlock()
+	return calls
+}
+
+// Redeliver calls RedeliverFunc.
+func (mock *ServiceMock) Redeliver(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+	if mock.RedeliverFunc == nil {
+		panic("ServiceMock.RedeliverFunc: method is nil but Service.Redeliver was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRedeliver.Lock()
+	mock.calls.Redeliver = append(mock.calls.Redeliver, callInfo)
+	mock.lockRedeliver.Unlock()
+	return mock.RedeliverFunc(ctx, id)
+}
+
+// RedeliverCalls gets all the calls that were made to Redeliver.
+// Check the length with:
+//
+//	len(mockedService.RedeliverCalls())
+func (mock *ServiceMock) RedeliverCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockRedeliver.RLock()
+	calls = mock.calls.Redeliver
+	mock.lockRedeliver.RUnlock()
+	return calls
+}
@@ -0,0 +1,112 @@
+package subscription
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	DeliveryStatusDead      DeliveryStatus = "dead"
+)
+
+var DeliveryStatusValues = map[string]DeliveryStatus{
+	"pending":   DeliveryStatusPending,
+	"delivered": DeliveryStatusDelivered,
+	"dead":      DeliveryStatusDead,
+}
+
+type Subscription struct {
+	ID         uuid.UUID          `json:"id"`
+	URL        string             `json:"url"`
+	EventTypes []outbox.EventType `json:"event_types"`
+	Secret     string             `json:"-"`
+	Active     bool               `json:"active"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+type Delivery struct {
+	ID             uuid.UUID        `json:"id"`
+	SubscriptionID uuid.UUID        `json:"subscription_id"`
+	EventID        uuid.UUID        `json:"event_id"`
+	EventType      outbox.EventType `json:"event_type"`
+	Payload        []byte           `json:"payload"`
+	Status         DeliveryStatus   `json:"status"`
+	Attempts       int              `json:"attempts"`
+	NextAttemptAt  time.Time        `json:"next_attempt_at"`
+	LastStatusCode *int             `json:"last_status_code"`
+	LastError      *string          `json:"last_error"`
+	DeliveredAt    *time.Time       `json:"delivered_at"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	Log            []Attempt        `json:"log"`
+}
+
+type Attempt struct {
+	ID          uuid.UUID     `json:"id"`
+	DeliveryID  uuid.UUID     `json:"delivery_id"`
+	Number      int           `json:"number"`
+	StatusCode  *int          `json:"status_code"`
+	Error       *string       `json:"error"`
+	Duration    time.Duration `json:"duration"`
+	AttemptedAt time.Time     `json:"attempted_at"`
+}
+
+type DeliveryFilter struct {
+	SubscriptionID uuid.UUID
+	Status         *DeliveryStatus
+	Limit          int
+	Offset         int
+}
+
+type envelope struct {
+	ID         uuid.UUID        `json:"id"`
+	Type       outbox.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       json.RawMessage  `json:"data"`
+}
+
+func (s Subscription) Matches(eventType outbox.EventType) bool {
+	return slices.Contains(s.EventTypes, eventType)
+}
+
+func newDelivery(s Subscription, event outbox.Event, body []byte, now time.Time) *Delivery {
+	return &Delivery{
+		SubscriptionID: s.ID,
+		EventID:        event.ID,
+		EventType:      event.Type,
+		Payload:        body,
+		Status:         DeliveryStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+func (d *Delivery) record(a *Attempt, policy RetryPolicy) {
+	d.Attempts++
+	a.Number = d.Attempts
+	d.LastStatusCode = a.StatusCode
+	d.LastError = a.Error
+	d.UpdatedAt = a.AttemptedAt
+
+	if a.Error == nil {
+		d.Status = DeliveryStatusDelivered
+		d.DeliveredAt = &a.AttemptedAt
+		return
+	}
+
+	if d.Status != DeliveryStatusPending || d.Attempts >= policy.MaxAttempts {
+		d.Status = DeliveryStatusDead
+		return
+	}
+	d.NextAttemptAt = a.AttemptedAt.Add(policy.backoff(d.Attempts))
+}
@@ -0,0 +1,45 @@
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+)
+
+type publisher struct {
+	repo Repository
+}
+
+func NewPublisher(repo Repository) outbox.Publisher {
+	return &publisher{repo: repo}
+}
+
+func (p *publisher) Publish(ctx context.Context, event outbox.Event) error {
+	subscriptions, err := p.repo.ListSubscriptionsForEvent(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(envelope{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]*Delivery, len(subscriptions))
+	for i, s := range subscriptions {
+		deliveries[i] = newDelivery(s, event, body, now)
+	}
+
+	return p.repo.InsertDeliveries(ctx, deliveries)
+}
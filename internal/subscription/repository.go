@@ -0,0 +1,437 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+)
+
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrDeliveryConflict     = errors.New("webhook delivery was updated concurrently")
+)
+
+const subscriptionDeletedReason = "subscription deleted"
+
+const (
+	queryInsertSubscription = `
+	INSERT INTO webhook_subscriptions (url, event_types, secret, active, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id
+`
+
+	subscriptionColumns = `
+	id, url, event_types, secret, active, created_at, updated_at`
+
+	querySelectSubscription = `
+	SELECT` + subscriptionColumns + `
+	FROM webhook_subscriptions
+	WHERE id = $1
+`
+
+	queryListSubscriptions = `
+	SELECT` + subscriptionColumns + `
+	FROM webhook_subscriptions
+	WHERE active
+	ORDER BY created_at
+`
+
+	queryListSubscriptionsForEvent = `
+	SELECT` + subscriptionColumns + `
+	FROM webhook_subscriptions
+	WHERE active AND $1 = ANY(event_types)
+	ORDER BY created_at
+`
+
+	queryDeactivateSubscription = `
+	UPDATE webhook_subscriptions
+	SET active = FALSE, updated_at = $2
+	WHERE id = $1 AND active
+	RETURNING id
+`
+)
+
+const (
+	queryInsertDelivery = `
+	INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, attempts,
+	                                next_attempt_at, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT ON CONSTRAINT unique_webhook_delivery_per_event DO NOTHING
+`
+
+	deliveryColumns = `
+	id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+	last_status_code, last_error, delivered_at, created_at, updated_at`
+
+	querySelectDelivery = `
+	SELECT` + deliveryColumns + `
+	FROM webhook_deliveries
+	WHERE id = $1
+`
+
+	queryClaimDueDeliveries = `
+	WITH due AS (
+		SELECT d.id AS due_id
+		FROM webhook_deliveries d
+		INNER JOIN webhook_subscriptions s ON s.id = d.subscription_id
+		WHERE d.status = 'pending' AND d.next_attempt_at <= $1 AND s.active
+		ORDER BY d.next_attempt_at, d.created_at
+		LIMIT $2
+		FOR UPDATE OF d SKIP LOCKED
+	)
+	UPDATE webhook_deliveries
+	SET next_attempt_at = $3
+	FROM due
+	WHERE id = due.due_id
+	RETURNING` + deliveryColumns + `
+`
+
+	queryKillPendingDeliveries = `
+	UPDATE webhook_deliveries
+	SET status = 'dead', last_error = $3, updated_at = $2
+	WHERE subscription_id = $1 AND status = 'pending'
+`
+
+	queryListDeliveries = `
+	SELECT` + deliveryColumns + `
+	FROM webhook_deliveries
+	WHERE subscription_id = $1
+	  AND ($2::text IS NULL OR status = $2)
+	ORDER BY created_at DESC
+	LIMIT $3 OFFSET $4
+`
+
+	queryUpdateDelivery = `
+	UPDATE webhook_deliveries
+	SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = $5, last_error = $6,
+	    delivered_at = $7, updated_at = $8
+	WHERE id = $1 AND attempts = $9
+`
+
+	queryInsertAttempt = `
+	INSERT INTO webhook_delivery_attempts (delivery_id, number, status_code, error, duration_ms, attempted_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id
+`
+
+	queryListAttempts = `
+	SELECT id, delivery_id, number, status_code, error, duration_ms, attempted_at
+	FROM webhook_delivery_attempts
+	WHERE delivery_id = $1
+	ORDER BY number
+`
+)
+
+//go:generate moq -pkg mocks -out mocks/repository.go . Repository
+type Repository interface {
+	InsertSubscription(ctx context.Context, s *Subscription) (uuid.UUID, error)
+	GetSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error)
+	ListSubscriptions(ctx context.Context) ([]Subscription, error)
+	ListSubscriptionsForEvent(ctx context.Context, eventType outbox.EventType) ([]Subscription, error)
+	DeactivateSubscription(ctx context.Context, id uuid.UUID, at time.Time) error
+	InsertDeliveries(ctx context.Context, deliveries []*Delivery) error
+	GetDelivery(ctx context.Context, id uuid.UUID) (*Delivery, error)
+	ClaimDueDeliveries(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]Delivery, error)
+	ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error)
+	ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]Attempt, error)
+	RecordAttempt(ctx context.Context, d *Delivery, a *Attempt) error
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) Repository {
+	return &repository{pool: pool}
+}
+
+func (r *repository) InsertSubscription(ctx context.Context, s *Subscription) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, queryInsertSubscription,
+		s.URL,
+		eventTypeNames(s.EventTypes),
+		s.Secret,
+		s.Active,
+		s.CreatedAt,
+		s.UpdatedAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to insert webhook subscription: %w", err)
+	}
+	return id, nil
+}
+
+func (r *repository) GetSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	s, err := scanSubscription(r.pool.QueryRow(ctx, querySelectSubscription, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *repository) ListSubscriptions(ctx context.Context) ([]Subscription, error) {
+	return r.querySubscriptions(ctx, queryListSubscriptions)
+}
+
+func (r *repository) ListSubscriptionsForEvent(
+	ctx context.Context,
+	eventType outbox.EventType,
+) ([]Subscription, error) {
+	return r.querySubscriptions(ctx, queryListSubscriptionsForEvent, string(eventType))
+}
+
+func (r *repository) querySubscriptions(ctx context.Context, query string, args ...any) ([]Subscription, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := []Subscription{}
+	for rows.Next() {
+		s, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, *s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *repository) DeactivateSubscription(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var deactivatedID uuid.UUID
+		if err := tx.QueryRow(ctx, queryDeactivateSubscription, id, at).Scan(&deactivatedID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrSubscriptionNotFound
+			}
+			return err
+		}
+
+		_, err := tx.Exec(ctx, queryKillPendingDeliveries, id, at, subscriptionDeletedReason)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrSubscriptionNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to deactivate webhook subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) InsertDeliveries(ctx context.Context, deliveries []*Delivery) error {
+	batch := &pgx.Batch{}
+	for _, d := range deliveries {
+		batch.Queue(queryInsertDelivery,
+			d.SubscriptionID,
+			d.EventID,
+			d.EventType,
+			d.Payload,
+			d.Status,
+			d.Attempts,
+			d.NextAttemptAt,
+			d.CreatedAt,
+			d.UpdatedAt,
+		)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to insert webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetDelivery(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	d, err := scanDelivery(r.pool.QueryRow(ctx, querySelectDelivery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+func (r *repository) ClaimDueDeliveries(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	lease time.Duration,
+) ([]Delivery, error) {
+	return r.queryDeliveries(ctx, queryClaimDueDeliveries, now, limit, now.Add(lease))
+}
+
+func (r *repository) ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error) {
+	return r.queryDeliveries(ctx, queryListDeliveries,
+		filter.SubscriptionID,
+		filter.Status,
+		filter.Limit,
+		filter.Offset,
+	)
+}
+
+func (r *repository) queryDeliveries(ctx context.Context, query string, args ...any) ([]Delivery, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []Delivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *repository) ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]Attempt, error) {
+	rows, err := r.pool.Query(ctx, queryListAttempts, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := []Attempt{}
+	for rows.Next() {
+		var (
+			a          Attempt
+			durationMs int64
+		)
+		if err := rows.Scan(
+			&a.ID,
+			&a.DeliveryID,
+			&a.Number,
+			&a.StatusCode,
+			&a.Error,
+			&durationMs,
+			&a.AttemptedAt,
+		); err != nil {
+			return nil, err
+		}
+		a.Duration = time.Duration(durationMs) * time.Millisecond
+		attempts = append(attempts, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+func (r *repository) RecordAttempt(ctx context.Context, d *Delivery, a *Attempt) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, queryUpdateDelivery,
+			d.ID,
+			d.Status,
+			d.Attempts,
+			d.NextAttemptAt,
+			d.LastStatusCode,
+			d.LastError,
+			d.DeliveredAt,
+			d.UpdatedAt,
+			a.Number-1,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrDeliveryConflict
+		}
+
+		return tx.QueryRow(ctx, queryInsertAttempt,
+			d.ID,
+			a.Number,
+			a.StatusCode,
+			a.Error,
+			a.Duration.Milliseconds(),
+			a.AttemptedAt,
+		).Scan(&a.ID)
+	})
+	if err != nil {
+		if errors.Is(err, ErrDeliveryConflict) {
+			return err
+		}
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+	return nil
+}
+
+func scanSubscription(row pgx.Row) (*Subscription, error) {
+	var (
+		s     Subscription
+		types []string
+	)
+	if err := row.Scan(
+		&s.ID,
+		&s.URL,
+		&types,
+		&s.Secret,
+		&s.Active,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	s.EventTypes = make([]outbox.EventType, len(types))
+	for i, t := range types {
+		s.EventTypes[i] = outbox.EventType(t)
+	}
+	return &s, nil
+}
+
+func eventTypeNames(types []outbox.EventType) []string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return names
+}
+
+func scanDelivery(row pgx.Row) (*Delivery, error) {
+	var d Delivery
+	if err := row.Scan(
+		&d.ID,
+		&d.SubscriptionID,
+		&d.EventID,
+		&d.EventType,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.NextAttemptAt,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.DeliveredAt,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -0,0 +1,174 @@
+package subscription
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	log "go.uber.org/zap"
+)
+
+var (
+	ErrInvalidURL       = errors.New("webhook URL must be an absolute http or https URL")
+	ErrUnknownEventType = errors.New("unknown event type")
+	ErrNoEventTypes     = errors.New("at least one event type is required")
+	ErrInactive         = errors.New("webhook subscription is no longer active")
+)
+
+const secretBytes = 32
+
+//go:generate moq -pkg mocks -out mocks/service.go . Service
+type Service interface {
+	Create(ctx context.Context, rawURL string, eventTypes []string, secret string) (*Subscription, error)
+	Get(ctx context.Context, id uuid.UUID) (*Subscription, error)
+	List(ctx context.Context) ([]Subscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error)
+	GetDelivery(ctx context.Context, id uuid.UUID) (*Delivery, error)
+	Redeliver(ctx context.Context, id uuid.UUID) (*Delivery, error)
+}
+
+type service struct {
+	repo       Repository
+	dispatcher *Dispatcher
+}
+
+func NewService(repo Repository, dispatcher *Dispatcher) Service {
+	return &service{repo: repo, dispatcher: dispatcher}
+}
+
+func (s *service) Create(
+	ctx context.Context,
+	rawURL string,
+	eventTypes []string,
+	secret string,
+) (*Subscription, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidURL
+	}
+
+	if len(eventTypes) == 0 {
+		return nil, ErrNoEventTypes
+	}
+	types := []outbox.EventType{}
+	seen := map[outbox.EventType]bool{}
+	for _, name := range eventTypes {
+		t, ok := outbox.EventTypes[name]
+		if !ok {
+			return nil, ErrUnknownEventType
+		}
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+
+	if secret == "" {
+		raw := make([]byte, secretBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(raw)
+	}
+
+	now := time.Now().UTC()
+	subscription := &Subscription{
+		URL:        u.String(),
+		EventTypes: types,
+		Secret:     secret,
+		Active:     true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	subscription.ID, err = s.repo.InsertSubscription(ctx, subscription)
+	if err != nil {
+		log.L().
+			Error("failed to create webhook subscription", log.String("url", subscription.URL), log.Error(err))
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *service) Get(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	return s.repo.GetSubscription(ctx, id)
+}
+
+func (s *service) List(ctx context.Context) ([]Subscription, error) {
+	subscriptions, err := s.repo.ListSubscriptions(ctx)
+	if err != nil {
+		log.L().
+			Error("failed to list webhook subscriptions", log.Error(err))
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.DeactivateSubscription(ctx, id, time.Now().UTC()); err != nil {
+		if !errors.Is(err, ErrSubscriptionNotFound) {
+			log.L().
+				Error("failed to delete webhook subscription", log.String("subscription_id", id.String()), log.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *service) ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error) {
+	if _, err := s.repo.GetSubscription(ctx, filter.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.repo.ListDeliveries(ctx, filter)
+	if err != nil {
+		log.L().
+			Error("failed to list webhook deliveries", log.String("subscription_id", filter.SubscriptionID.String()), log.Error(err))
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (s *service) GetDelivery(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	delivery, err := s.repo.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Log, err = s.repo.ListAttempts(ctx, id)
+	if err != nil {
+		log.L().
+			Error("failed to list webhook delivery attempts", log.String("delivery_id", id.String()), log.Error(err))
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (s *service) Redeliver(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	delivery, err := s.repo.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.repo.GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !subscription.Active {
+		return nil, ErrInactive
+	}
+
+	if _, err := s.dispatcher.Deliver(ctx, subscription, delivery); err != nil {
+		return nil, err
+	}
+
+	return s.GetDelivery(ctx, id)
+}
@@ -0,0 +1,112 @@
+package subscription_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/outbox"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription"
+	"github.com/victorvcruz/shipment-coordinator/internal/subscription/mocks"
+)
+
+func TestService_Create_ValidatesRequest(t *testing.T) {
+	svc := subscription.NewService(&mocks.RepositoryMock{}, nil)
+
+	_, err := svc.Create(context.Background(), "ftp://shop.example.com", []string{"order.created"}, "")
+	assert.ErrorIs(t, err, subscription.ErrInvalidURL)
+
+	_, err = svc.Create(context.Background(), "/webhooks", []string{"order.created"}, "")
+	assert.ErrorIs(t, err, subscription.ErrInvalidURL)
+
+	_, err = svc.Create(context.Background(), "https://shop.example.com", nil, "")
+	assert.ErrorIs(t, err, subscription.ErrNoEventTypes)
+
+	_, err = svc.Create(context.Background(), "https://shop.example.com", []string{"order.deleted"}, "")
+	assert.ErrorIs(t, err, subscription.ErrUnknownEventType)
+}
+
+func TestService_Create_GeneratesSecret(t *testing.T) {
+	repo := &mocks.RepositoryMock{
+		InsertSubscriptionFunc: func(ctx context.Context, s *subscription.Subscription) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+	}
+	svc := subscription.NewService(repo, nil)
+
+	created, err := svc.Create(context.Background(), "https://shop.example.com/hooks",
+		[]string{"order.created", "contract.created", "order.created"}, "")
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Len(t, created.Secret, 64)
+	assert.True(t, created.Active)
+	assert.Equal(t, []outbox.EventType{outbox.EventOrderCreated, outbox.EventContractCreated}, created.EventTypes)
+}
+
+func TestService_Redeliver_DeadDelivery(t *testing.T) {
+	srv, _ := subscriber(t, http.StatusOK)
+	s := subscription.Subscription{ID: uuid.New(), URL: srv.URL, Secret: testSecret, Active: true}
+	delivery := pendingDelivery(s)
+	delivery.Status = subscription.DeliveryStatusDead
+	delivery.Attempts = 8
+
+	repo := deliveryRepository(s, delivery)
+	repo.GetDeliveryFunc = func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+		d := *delivery
+		return &d, nil
+	}
+	repo.ListAttemptsFunc = func(ctx context.Context, deliveryID uuid.UUID) ([]subscription.Attempt, error) {
+		return []subscription.Attempt{{Number: 9, AttemptedAt: time.Now()}}, nil
+	}
+
+	dispatcher := subscription.NewDispatcher(repo, srv.Client(), subscription.DispatcherConfig{})
+	redelivered, err := subscription.NewService(repo, dispatcher).Redeliver(context.Background(), delivery.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, subscription.DeliveryStatusDelivered, redelivered.Status)
+	assert.Equal(t, 9, redelivered.Attempts)
+	assert.Len(t, redelivered.Log, 1)
+}
+
+func TestService_Redeliver_FailureKeepsDeadLetter(t *testing.T) {
+	srv, _ := subscriber(t, http.StatusInternalServerError)
+	s := subscription.Subscription{ID: uuid.New(), URL: srv.URL, Secret: testSecret, Active: true}
+	delivery := pendingDelivery(s)
+	delivery.Status = subscription.DeliveryStatusDead
+
+	repo := deliveryRepository(s, delivery)
+	repo.GetDeliveryFunc = func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+		d := *delivery
+		return &d, nil
+	}
+	repo.ListAttemptsFunc = func(ctx context.Context, deliveryID uuid.UUID) ([]subscription.Attempt, error) {
+		return nil, nil
+	}
+
+	dispatcher := subscription.NewDispatcher(repo, srv.Client(), subscription.DispatcherConfig{})
+	redelivered, err := subscription.NewService(repo, dispatcher).Redeliver(context.Background(), delivery.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, subscription.DeliveryStatusDead, redelivered.Status)
+	assert.Equal(t, http.StatusInternalServerError, *redelivered.LastStatusCode)
+}
+
+func TestService_Redeliver_RejectsInactiveSubscription(t *testing.T) {
+	srv, calls := subscriber(t, http.StatusOK)
+	s := subscription.Subscription{ID: uuid.New(), URL: srv.URL, Secret: testSecret}
+	delivery := pendingDelivery(s)
+
+	repo := deliveryRepository(s, delivery)
+	repo.GetDeliveryFunc = func(ctx context.Context, id uuid.UUID) (*subscription.Delivery, error) {
+		return delivery, nil
+	}
+
+	dispatcher := subscription.NewDispatcher(repo, srv.Client(), subscription.DispatcherConfig{})
+	redelivered, err := subscription.NewService(repo, dispatcher).Redeliver(context.Background(), delivery.ID)
+	assert.ErrorIs(t, err, subscription.ErrInactive)
+	assert.Nil(t, redelivered)
+	assert.Zero(t, atomic.LoadInt32(calls))
+	assert.Empty(t, repo.RecordAttemptCalls())
+}
@@ -0,0 +1,62 @@
+package tracking
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+)
+
+type SetTrackingCodeInput struct {
+	ID   uuid.UUID `path:"id" doc:"Contract ID"`
+	Body SetTrackingCodeInputBody
+}
+
+type SetTrackingCodeInputBody struct {
+	TrackingCode string `json:"tracking_code" required:"true" minLength:"1" maxLength:"64" doc:"Tracking code issued by the carrier" example:"BR123456789XX"`
+}
+
+type AppendEventInput struct {
+	ID   uuid.UUID `path:"id" doc:"Contract ID"`
+	Body AppendEventInputBody
+}
+
+type AppendEventInputBody struct {
+	Code        string    `json:"code"                  required:"true"  doc:"Tracking event code"                    example:"in_transit"                                enum:"picked_up,in_transit,out_for_delivery,delivered,lost,exception"`
+	Description string    `json:"description,omitempty" required:"false" doc:"Carrier description of the event"       example:"Object in transit to the destination unit"`
+	Location    string    `json:"location,omitempty"    required:"false" doc:"Where the event happened"               example:"Campinas - SP"`
+	OccurredAt  time.Time `json:"occurred_at"           required:"true"  doc:"When the event happened at the carrier" example:"2025-06-29T10:00:00Z"`
+}
+
+type EventResponseOutput struct {
+	Status int
+	Body   EventResponse
+}
+
+type EventResponse struct {
+	ID          uuid.UUID `json:"id"          doc:"Tracking event ID"                      example:"123e4567-e89b-12d3-a456-426614174000"`
+	ContractID  uuid.UUID `json:"contract_id" doc:"Contract ID"                            example:"123e4567-e89b-12d3-a456-426614174000"`
+	Code        string    `json:"code"        doc:"Tracking event code"                    example:"in_transit"`
+	Description string    `json:"description" doc:"Carrier description of the event"       example:"Object in transit to the destination unit"`
+	Location    string    `json:"location"    doc:"Where the event happened"               example:"Campinas - SP"`
+	OccurredAt  time.Time `json:"occurred_at" doc:"When the event happened at the carrier" example:"2025-06-29T10:00:00Z"`
+	CreatedAt   time.Time `json:"created_at"  doc:"When the event was recorded"            example:"2025-06-29T10:00:05Z"`
+}
+
+type GetTrailInput struct {
+	ID uuid.UUID `path:"id" doc:"Order ID"`
+}
+
+type TrailOutput struct {
+	Status int
+	Body   TrailOutputBody
+}
+
+type TrailOutputBody struct {
+	OrderID      uuid.UUID       `json:"order_id"                doc:"Order ID"                            example:"123e4567-e89b-12d3-a456-426614174000"`
+	OrderStatus  order.Status    `json:"order_status"            doc:"Current order status"                example:"shipped"`
+	ContractID   *uuid.UUID      `json:"contract_id,omitempty"   doc:"Active contract ID"                  example:"123e4567-e89b-12d3-a456-426614174000"`
+	CarrierID    *uuid.UUID      `json:"carrier_id,omitempty"    doc:"Contracted carrier ID"               example:"123e4567-e89b-12d3-a456-426614174000"`
+	TrackingCode *string         `json:"tracking_code,omitempty" doc:"Tracking code issued by the carrier" example:"BR123456789XX"`
+	Events       []EventResponse `json:"events"                  doc:"Tracking events, oldest first"`
+}
@@ -0,0 +1,140 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	"sync"
+)
+
+// Ensure, that RepositoryMock does implement tracking.Repository.
+// If this is not the case, regenerate this file with moq.
+var _ tracking.Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of tracking.Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked tracking.Repository
+//		mockedRepository := &RepositoryMock{
+//			AppendEventFunc: func(ctx context.Context, event *tracking.Event, advance *order.Status) (uuid.UUID, error) {
+//				panic("mock out the AppendEvent method")
+//			},
+//			ListEventsByOrderFunc: func(ctx context.Context, orderID uuid.UUID) ([]tracking.Event, error) {
+//				panic("mock out the ListEventsByOrder method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires tracking.Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// AppendEventFunc mocks the AppendEvent method.
+	AppendEventFunc func(ctx context.Context, event *tracking.Event, advance *order.Status) (uuid.UUID, error)
+
+	// ListEventsByOrderFunc mocks the ListEventsByOrder method.
+	ListEventsByOrderFunc func(ctx context.Context, orderID uuid.UUID) ([]tracking.Event, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AppendEvent holds details about calls to the AppendEvent method.
+		AppendEvent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event *tracking.Event
+			// Advance is the advance argument value.
+			Advance *order.Status
+		}
+		// ListEventsByOrder holds details about calls to the ListEventsByOrder method.
+		ListEventsByOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID uuid.UUID
+		}
+	}
+	lockAppendEvent       sync.RWMutex
+	lockListEventsByOrder sync.RWMutex
+}
+
+// AppendEvent calls AppendEventFunc.
+func (mock *RepositoryMock) AppendEvent(ctx context.Context, event *tracking.Event, advance *order.Status) (uuid.UUID, error) {
+	if mock.AppendEventFunc == nil {
+		panic("RepositoryMock.AppendEventFunc: method is nil but Repository.AppendEvent was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Event   *tracking.Event
+		Advance *order.Status
+	}{
+		Ctx:     ctx,
+		Event:   event,
+		Advance: advance,
+	}
+	mock.lockAppendEvent.Lock()
+	mock.calls.AppendEvent = append(mock.calls.AppendEvent, callInfo)
+	mock.lockAppendEvent.Unlock()
+	return mock.AppendEventFunc(ctx, event, advance)
+}
+
+// AppendEventCalls gets all the calls that were made to AppendEvent.
+// Check the length with:
+//
+//	len(mockedRepository.AppendEventCalls())
+func (mock *RepositoryMock) AppendEventCalls() []struct {
+	Ctx     context.Context
+	Event   *tracking.Event
+	Advance *order.Status
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Event   *tracking.Event
+		Advance *order.Status
+	}
+	mock.lockAppendEvent.RLock()
+	calls = mock.calls.AppendEvent
+	mock.lockAppendEvent.RUnlock()
+	return calls
+}
+
+// ListEventsByOrder calls ListEventsByOrderFunc.
+func (mock *RepositoryMock) ListEventsByOrder(ctx context.Context, orderID uuid.UUID) ([]tracking.Event, error) {
+	if mock.ListEventsByOrderFunc == nil {
+		panic("RepositoryMock.ListEventsByOrderFunc: method is nil but Repository.ListEventsByOrder was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OrderID uuid.UUID
+	}{
+		Ctx:     ctx,
+		OrderID: orderID,
+	}
+	mock.lockListEventsByOrder.Lock()
+	mock.calls.ListEventsByOrder = append(mock.calls.ListEventsByOrder, callInfo)
+	mock.lockListEventsByOrder.Unlock()
+	return mock.ListEventsByOrderFunc(ctx, orderID)
+}
+
+// ListEventsByOrderCalls gets all the calls that were made to ListEventsByOrder.
+// Check the length with:
+//
+//	len(mockedRepository.ListEventsByOrderCalls())
+func (mock *RepositoryMock) ListEventsByOrderCalls() []struct {
+	Ctx     context.Context
+	OrderID uuid.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OrderID uuid.UUID
+	}
+	mock.lockListEventsByOrder.RLock()
+	calls = mock.calls.ListEventsByOrder
+	mock.lockListEventsByOrder.RUnlock()
+	return calls
+}
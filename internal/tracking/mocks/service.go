@@ -0,0 +1,196 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	"sync"
+)
+
+// Ensure, that ServiceMock does implement tracking.Service.
+// If this is not the case, regenerate this file with moq.
+var _ tracking.Service = &ServiceMock{}
+
+// ServiceMock is a mock implementation of tracking.Service.
+//
+//	func TestSomethingThatUsesService(t *testing.T) {
+//
+//		// make and configure a mocked tracking.Service
+//		mockedService := &ServiceMock{
+//			AppendEventFunc: func(ctx context.Context, contractID uuid.UUID, event *tracking.Event) (*tracking.Event, error) {
+//				panic("mock out the AppendEvent method")
+//			},
+//			GetTrailFunc: func(ctx context.Context, orderID uuid.UUID) (*tracking.Trail, error) {
+//				panic("mock out the GetTrail method")
+//			},
+//			SetTrackingCodeFunc: func(ctx context.Context, contractID uuid.UUID, code string) (*shipping.Contract, error) {
+//				panic("mock out the SetTrackingCode method")
+//			},
+//		}
+//
+//		// use mockedService in code that requires tracking.Service
+//		// and then make assertions.
+//
+//	}
+type ServiceMock struct {
+	// AppendEventFunc mocks the AppendEvent method.
+	AppendEventFunc func(ctx context.Context, contractID uuid.UUID, event *tracking.Event) (*tracking.Event, error)
+
+	// GetTrailFunc mocks the GetTrail method.
+	GetTrailFunc func(ctx context.Context, orderID uuid.UUID) (*tracking.Trail, error)
+
+	// SetTrackingCodeFunc mocks the SetTrackingCode method.
+	SetTrackingCodeFunc func(ctx context.Context, contractID uuid.UUID, code string) (*shipping.Contract, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AppendEvent holds details about calls to the AppendEvent method.
+		AppendEvent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ContractID is the contractID argument value.
+			ContractID uuid.UUID
+			// Event is the event argument value.
+			Event *tracking.Event
+		}
+		// GetTrail holds details about calls to the GetTrail method.
+		GetTrail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID uuid.UUID
+		}
+		// SetTrackingCode holds details about calls to the SetTrackingCode method.
+		SetTrackingCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ContractID is the contractID argument value.
+			ContractID uuid.UUID
+			// Code is the code argument value.
+			Code string
+		}
+	}
+	lockAppendEvent     sync.RWMutex
+	lockGetTrail        sync.RWMutex
+	lockSetTrackingCode sync.RWMutex
+}
+
+// AppendEvent calls AppendEventFunc.
+func (mock *ServiceMock) AppendEvent(ctx context.Context, contractID uuid.UUID, event *tracking.Event) (*tracking.Event, error) {
+	if mock.AppendEventFunc == nil {
+		panic("ServiceMock.AppendEventFunc: method is nil but Service.AppendEvent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+		Event      *tracking.Event
+	}{
+		Ctx:        ctx,
+		ContractID: contractID,
+		Event:      event,
+	}
+	mock.lockAppendEvent.Lock()
+	mock.calls.AppendEvent = append(mock.calls.AppendEvent, callInfo)
+	mock.lockAppendEvent.Unlock()
+	return mock.AppendEventFunc(ctx, contractID, event)
+}
+
+// AppendEventCalls gets all the calls that were made to AppendEvent.
+// Check the length with:
+//
+//	len(mockedService.AppendEventCalls())
+func (mock *ServiceMock) AppendEventCalls() []struct {
+	Ctx        context.Context
+	ContractID uuid.UUID
+	Event      *tracking.Event
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+		Event      *tracking.Event
+	}
+	mock.lockAppendEvent.RLock()
+	calls = mock.calls.AppendEvent
+	mock.lockAppendEvent.RUnlock()
+	return calls
+}
+
+// GetTrail calls GetTrailFunc.
+func (mock *ServiceMock) GetTrail(ctx context.Context, orderID uuid.UUID) (*tracking.Trail, error) {
+	if mock.GetTrailFunc == nil {
+		panic("ServiceMock.GetTrailFunc: method is nil but Service.GetTrail was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OrderID uuid.UUID
+	}{
+		Ctx:     ctx,
+		OrderID: orderID,
+	}
+	mock.lockGetTrail.Lock()
+	mock.calls.GetTrail = append(mock.calls.GetTrail, callInfo)
+	mock.lockGetTrail.Unlock()
+	return mock.GetTrailFunc(ctx, orderID)
+}
+
+// GetTrailCalls gets all the calls that were made to GetTrail.
+// Check the length with:
+//
+//	len(mockedService.GetTrailCalls())
+func (mock *ServiceMock) GetTrailCalls() []struct {
+	Ctx     context.Context
+	OrderID uuid.UUID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OrderID uuid.UUID
+	}
+	mock.lockGetTrail.RLock()
+	calls = mock.calls.GetTrail
+	mock.lockGetTrail.RUnlock()
+	return calls
+}
+
+// SetTrackingCode calls SetTrackingCodeFunc.
+func (mock *ServiceMock) SetTrackingCode(ctx context.Context, contractID uuid.UUID, code string) (*shipping.Contract, error) {
+	if mock.SetTrackingCodeFunc == nil {
+		panic("ServiceMock.SetTrackingCodeFunc: method is nil but Service.SetTrackingCode was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+		Code       string
+	}{
+		Ctx:        ctx,
+		ContractID: contractID,
+		Code:       code,
+	}
+	mock.lockSetTrackingCode.Lock()
+	mock.calls.SetTrackingCode = append(mock.calls.SetTrackingCode, callInfo)
+	mock.lockSetTrackingCode.Unlock()
+	return mock.SetTrackingCodeFunc(ctx, contractID, code)
+}
+
+// SetTrackingCodeCalls gets all the calls that were made to SetTrackingCode.
+// Check the length with:
+//
+//	len(mockedService.SetTrackingCodeCalls())
+func (mock *ServiceMock) SetTrackingCodeCalls() []struct {
+	Ctx        context.Context
+	ContractID uuid.UUID
+	Code       string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ContractID uuid.UUID
+		Code       string
+	}
+	mock.lockSetTrackingCode.RLock()
+	calls = mock.calls.SetTrackingCode
+	mock.lockSetTrackingCode.RUnlock()
+	return calls
+}
@@ -0,0 +1,60 @@
+package tracking
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+)
+
+type EventCode string
+
+const (
+	EventPickedUp       EventCode = "picked_up"
+	EventInTransit      EventCode = "in_transit"
+	EventOutForDelivery EventCode = "out_for_delivery"
+	EventDelivered      EventCode = "delivered"
+	EventLost           EventCode = "lost"
+	EventException      EventCode = "exception"
+)
+
+var EventCodes = map[string]EventCode{
+	"picked_up":        EventPickedUp,
+	"in_transit":       EventInTransit,
+	"out_for_delivery": EventOutForDelivery,
+	"delivered":        EventDelivered,
+	"lost":             EventLost,
+	"exception":        EventException,
+}
+
+var orderStatusByEvent = map[EventCode]order.Status{
+	EventPickedUp:  order.StatusPickedUp,
+	EventInTransit: order.StatusShipped,
+	EventDelivered: order.StatusDelivered,
+	EventLost:      order.StatusLost,
+}
+
+type Event struct {
+	ID          uuid.UUID `json:"id"`
+	ContractID  uuid.UUID `json:"contract_id"`
+	OrderID     uuid.UUID `json:"order_id"`
+	Code        EventCode `json:"code"`
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	OccurredAt  time.Time `json:"occurred_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (e Event) OrderStatus() (order.Status, bool) {
+	status, ok := orderStatusByEvent[e.Code]
+	return status, ok
+}
+
+type Trail struct {
+	OrderID      uuid.UUID    `json:"order_id"`
+	OrderStatus  order.Status `json:"order_status"`
+	ContractID   *uuid.UUID   `json:"contract_id"`
+	CarrierID    *uuid.UUID   `json:"carrier_id"`
+	TrackingCode *string      `json:"tracking_code"`
+	Events       []Event      `json:"events"`
+}
@@ -0,0 +1,108 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	log "go.uber.org/zap"
+)
+
+const (
+	queryInsertEvent = `
+	INSERT INTO tracking_events (contract_id, order_id, code, description, location, occurred_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (contract_id, code, occurred_at) DO NOTHING
+	RETURNING id
+`
+
+	queryListEventsByOrder = `
+	SELECT id, contract_id, order_id, code, description, location, occurred_at, created_at
+	FROM tracking_events
+	WHERE order_id = $1
+	ORDER BY occurred_at, created_at
+`
+)
+
+//go:generate moq -pkg mocks -out mocks/repository.go . Repository
+type Repository interface {
+	AppendEvent(ctx context.Context, event *Event, advance *order.Status) (uuid.UUID, error)
+	ListEventsByOrder(ctx context.Context, orderID uuid.UUID) ([]Event, error)
+}
+
+type repository struct {
+	pool   *pgxpool.Pool
+	orders order.Repository
+}
+
+func NewRepository(pool *pgxpool.Pool, orders order.Repository) Repository {
+	return &repository{pool: pool, orders: orders}
+}
+
+func (r *repository) AppendEvent(ctx context.Context, event *Event, advance *order.Status) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, queryInsertEvent,
+			event.ContractID,
+			event.OrderID,
+			event.Code,
+			event.Description,
+			event.Location,
+			event.OccurredAt,
+			event.CreatedAt,
+		).Scan(&id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrDuplicateEvent
+			}
+			return fmt.Errorf("failed to append tracking event: %w", err)
+		}
+
+		if advance == nil {
+			return nil
+		}
+		advanced, err := r.orders.AdvanceStatusTx(ctx, tx, event.OrderID, *advance, event.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to advance order status: %w", err)
+		}
+		if !advanced {
+			log.L().
+				Info("tracking event does not advance order", log.String("order_id", event.OrderID.String()), log.String("event_status", string(*advance)))
+		}
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+
+func (r *repository) ListEventsByOrder(ctx context.Context, orderID uuid.UUID) ([]Event, error) {
+	rows, err := r.pool.Query(ctx, queryListEventsByOrder, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracking events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []Event{}
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(
+			&e.ID, &e.ContractID, &e.OrderID, &e.Code, &e.Description, &e.Location, &e.OccurredAt, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
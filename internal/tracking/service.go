@@ -0,0 +1,133 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	log "go.uber.org/zap"
+)
+
+var ErrDuplicateEvent = errors.New("tracking event already recorded")
+
+//go:generate moq -pkg mocks -out mocks/service.go . Service
+type Service interface {
+	SetTrackingCode(ctx context.Context, contractID uuid.UUID, code string) (*shipping.Contract, error)
+	AppendEvent(ctx context.Context, contractID uuid.UUID, event *Event) (*Event, error)
+	GetTrail(ctx context.Context, orderID uuid.UUID) (*Trail, error)
+}
+
+type service struct {
+	repo         Repository
+	contracts    shipping.Repository
+	orderService order.Service
+}
+
+func NewService(repo Repository, contracts shipping.Repository, orderService order.Service) Service {
+	return &service{
+		repo:         repo,
+		contracts:    contracts,
+		orderService: orderService,
+	}
+}
+
+func (s *service) SetTrackingCode(
+	ctx context.Context,
+	contractID uuid.UUID,
+	code string,
+) (*shipping.Contract, error) {
+	contract, err := s.activeContract(ctx, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err := s.contracts.SetTrackingCode(ctx, contract.ID, code, now); err != nil {
+		log.L().
+			Error("failed to set tracking code", log.String("contract_id", contract.ID.String()), log.Error(err))
+		return nil, err
+	}
+
+	contract.TrackingCode = &code
+	contract.UpdatedAt = now
+	return contract, nil
+}
+
+func (s *service) AppendEvent(ctx context.Context, contractID uuid.UUID, event *Event) (*Event, error) {
+	contract, err := s.activeContract(ctx, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	event.ContractID = contract.ID
+	event.OrderID = contract.OrderID
+	event.CreatedAt = time.Now().UTC()
+
+	var advance *order.Status
+	if status, ok := event.OrderStatus(); ok {
+		advance = &status
+	}
+
+	id, err := s.repo.AppendEvent(ctx, event, advance)
+	if errors.Is(err, ErrDuplicateEvent) {
+		return nil, err
+	}
+	if err != nil {
+		log.L().
+			Error("failed to append tracking event", log.String("contract_id", contract.ID.String()), log.Error(err))
+		return nil, err
+	}
+	event.ID = id
+
+	return event, nil
+}
+
+func (s *service) GetTrail(ctx context.Context, orderID uuid.UUID) (*Trail, error) {
+	o, err := s.orderService.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	trail := &Trail{
+		OrderID:     o.ID,
+		OrderStatus: o.Status,
+	}
+
+	contract, err := s.contracts.GetActiveContractByOrder(ctx, o.ID)
+	switch {
+	case err == nil:
+		trail.ContractID = &contract.ID
+		trail.CarrierID = &contract.CarrierID
+		trail.TrackingCode = contract.TrackingCode
+	case !errors.Is(err, shipping.ErrContractNotFound):
+		log.L().
+			Error("failed to get active contract", log.String("order_id", o.ID.String()), log.Error(err))
+		return nil, err
+	}
+
+	trail.Events, err = s.repo.ListEventsByOrder(ctx, o.ID)
+	if err != nil {
+		log.L().
+			Error("failed to list tracking events", log.String("order_id", o.ID.String()), log.Error(err))
+		return nil, err
+	}
+
+	return trail, nil
+}
+
+func (s *service) activeContract(ctx context.Context, contractID uuid.UUID) (*shipping.Contract, error) {
+	contract, err := s.contracts.GetContractByID(ctx, contractID)
+	if err != nil {
+		log.L().
+			Error("failed to get contract by ID", log.String("contract_id", contractID.String()), log.Error(err))
+		return nil, err
+	}
+
+	if contract.Status != shipping.ContractStatusActive {
+		return nil, shipping.ErrContractNotActive
+	}
+	return contract, nil
+}
@@ -0,0 +1,118 @@
+package tracking_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/internal/order"
+	ordermock "github.com/victorvcruz/shipment-coordinator/internal/order/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/shipping"
+	shippingmock "github.com/victorvcruz/shipment-coordinator/internal/shipping/mocks"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking"
+	"github.com/victorvcruz/shipment-coordinator/internal/tracking/mocks"
+)
+
+func activeContracts(orderID uuid.UUID) *shippingmock.RepositoryMock {
+	return &shippingmock.RepositoryMock{
+		GetContractByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: id, OrderID: orderID, Status: shipping.ContractStatusActive}, nil
+		},
+	}
+}
+
+func TestService_AppendEvent_AdvancesOrder(t *testing.T) {
+	ctx := context.Background()
+	orderID := uuid.New()
+	eventID := uuid.New()
+
+	repo := &mocks.RepositoryMock{
+		AppendEventFunc: func(ctx context.Context, e *tracking.Event, advance *order.Status) (uuid.UUID, error) {
+			return eventID, nil
+		},
+	}
+	orderSvc := &ordermock.ServiceMock{}
+
+	svc := tracking.NewService(repo, activeContracts(orderID), orderSvc)
+	event, err := svc.AppendEvent(ctx, uuid.New(), &tracking.Event{
+		Code:       tracking.EventPickedUp,
+		OccurredAt: time.Now().UTC(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, eventID, event.ID)
+	assert.Equal(t, orderID, event.OrderID)
+	assert.Len(t, repo.AppendEventCalls(), 1)
+	assert.Equal(t, order.StatusPickedUp, *repo.AppendEventCalls()[0].Advance)
+	assert.Empty(t, orderSvc.UpdateStatusCalls())
+}
+
+func TestService_AppendEvent_InformationalEvent(t *testing.T) {
+	repo := &mocks.RepositoryMock{
+		AppendEventFunc: func(ctx context.Context, e *tracking.Event, advance *order.Status) (uuid.UUID, error) {
+			return uuid.New(), nil
+		},
+	}
+	orderSvc := &ordermock.ServiceMock{}
+
+	svc := tracking.NewService(repo, activeContracts(uuid.New()), orderSvc)
+	_, err := svc.AppendEvent(context.Background(), uuid.New(), &tracking.Event{Code: tracking.EventOutForDelivery})
+	assert.NoError(t, err)
+	assert.Nil(t, repo.AppendEventCalls()[0].Advance)
+	assert.Empty(t, orderSvc.GetByIDCalls())
+}
+
+func TestService_AppendEvent_VoidedContract(t *testing.T) {
+	repo := &mocks.RepositoryMock{}
+	contracts := &shippingmock.RepositoryMock{
+		GetContractByIDFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return &shipping.Contract{ID: id, Status: shipping.ContractStatusVoided}, nil
+		},
+	}
+
+	svc := tracking.NewService(repo, contracts, &ordermock.ServiceMock{})
+	event, err := svc.AppendEvent(context.Background(), uuid.New(), &tracking.Event{Code: tracking.EventDelivered})
+	assert.ErrorIs(t, err, shipping.ErrContractNotActive)
+	assert.Nil(t, event)
+	assert.Empty(t, repo.AppendEventCalls())
+}
+
+func TestService_GetTrail_WithoutContract(t *testing.T) {
+	orderID := uuid.New()
+	repo := &mocks.RepositoryMock{
+		ListEventsByOrderFunc: func(ctx context.Context, id uuid.UUID) ([]tracking.Event, error) {
+			return []tracking.Event{}, nil
+		},
+	}
+	contracts := &shippingmock.RepositoryMock{
+		GetActiveContractByOrderFunc: func(ctx context.Context, id uuid.UUID) (*shipping.Contract, error) {
+			return nil, shipping.ErrContractNotFound
+		},
+	}
+	orderSvc := &ordermock.ServiceMock{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*order.Order, error) {
+			return &order.Order{ID: id, Status: order.StatusCreated}, nil
+		},
+	}
+
+	svc := tracking.NewService(repo, contracts, orderSvc)
+	trail, err := svc.GetTrail(context.Background(), orderID)
+	assert.NoError(t, err)
+	assert.Equal(t, orderID, trail.OrderID)
+	assert.Nil(t, trail.ContractID)
+	assert.Empty(t, trail.Events)
+}
+
+func TestService_AppendEvent_Duplicate(t *testing.T) {
+	repo := &mocks.RepositoryMock{
+		AppendEventFunc: func(ctx context.Context, e *tracking.Event, advance *order.Status) (uuid.UUID, error) {
+			return uuid.Nil, tracking.ErrDuplicateEvent
+		},
+	}
+
+	svc := tracking.NewService(repo, activeContracts(uuid.New()), &ordermock.ServiceMock{})
+	event, err := svc.AppendEvent(context.Background(), uuid.New(), &tracking.Event{Code: tracking.EventPickedUp})
+	assert.ErrorIs(t, err, tracking.ErrDuplicateEvent)
+	assert.Nil(t, event)
+}
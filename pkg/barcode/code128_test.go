@@ -0,0 +1,38 @@
+package barcode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/pkg/barcode"
+)
+
+func sum(widths []int) int {
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	return total
+}
+
+func TestCode128_ModuleCount(t *testing.T) {
+	widths, err := barcode.Code128("BR123456789XX")
+	assert.NoError(t, err)
+	assert.Equal(t, 11*(13+2)+13, sum(widths))
+	assert.Len(t, widths, 6*(13+2)+7)
+}
+
+func TestCode128_Checksum(t *testing.T) {
+	widths, err := barcode.Code128("A")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{2, 1, 1, 2, 1, 4}, widths[0:6])
+	assert.Equal(t, []int{1, 1, 1, 3, 2, 3}, widths[6:12])
+	assert.Equal(t, []int{1, 3, 1, 1, 2, 3}, widths[12:18])
+	assert.Equal(t, []int{2, 3, 3, 1, 1, 1, 2}, widths[18:])
+}
+
+func TestCode128_UnsupportedCharacter(t *testing.T) {
+	_, err := barcode.Code128("código")
+	assert.ErrorIs(t, err, barcode.ErrUnsupportedCharacter)
+}
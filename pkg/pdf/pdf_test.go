@@ -0,0 +1,34 @@
+package pdf_test
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/pkg/pdf"
+)
+
+func TestDocument_Bytes(t *testing.T) {
+	doc := pdf.New(288, 432)
+	page := doc.AddPage()
+	page.BoldText(10, 10, 16, "Fast (Delivery)")
+	page.Text(10, 40, 10, "São Paulo")
+	page.Rect(10, 100, 2, 50)
+	page.Line(0, 90, 288, 90, 1)
+	doc.AddPage()
+
+	out := doc.Bytes()
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), `/Count 2`)
+	assert.Contains(t, string(out), `(Fast \(Delivery\)) Tj`)
+	assert.Contains(t, string(out), `(S\343o Paulo) Tj`)
+	assert.Contains(t, string(out), "10 282 2 50 re f")
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	assert.NotNil(t, startxref)
+	offset, _ := strconv.Atoi(string(startxref[1]))
+	assert.True(t, bytes.HasPrefix(out[offset:], []byte("xref\n")))
+}
@@ -2,7 +2,10 @@ package states
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 type State struct {
@@ -129,3 +132,72 @@ func (r *Region) UnmarshalJSON(data []byte) error {
 func (r *Region) MarshalJSON() ([]byte, error) {
 	return json.Marshal(r.Name)
 }
+
+var ErrInvalidCEP = errors.New("invalid CEP")
+
+type cepRange struct {
+	from, to int
+	state    State
+}
+
+var cepRanges = []cepRange{
+	{1000, 19999, SP},
+	{20000, 28999, RJ},
+	{29000, 29999, ES},
+	{30000, 39999, MG},
+	{40000, 48999, BA},
+	{49000, 49999, SE},
+	{50000, 56999, PE},
+	{57000, 57999, AL},
+	{58000, 58999, PB},
+	{59000, 59999, RN},
+	{60000, 63999, CE},
+	{64000, 64999, PI},
+	{65000, 65999, MA},
+	{66000, 68899, PA},
+	{68900, 68999, AP},
+	{69000, 69299, AM},
+	{69300, 69399, RR},
+	{69400, 69899, AM},
+	{69900, 69999, AC},
+	{70000, 72799, DF},
+	{72800, 72999, GO},
+	{73000, 73699, DF},
+	{73700, 76799, GO},
+	{76800, 76999, RO},
+	{77000, 77999, TO},
+	{78000, 78899, MT},
+	{79000, 79999, MS},
+	{80000, 87999, PR},
+	{88000, 89999, SC},
+	{90000, 99999, RS},
+}
+
+func FromCEP(cep string) (State, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' || r == ' ' {
+			return -1
+		}
+		return r
+	}, cep)
+
+	if len(digits) != 8 {
+		return State{}, ErrInvalidCEP
+	}
+
+	prefix, err := strconv.Atoi(digits[:5])
+	if err != nil {
+		return State{}, ErrInvalidCEP
+	}
+	if _, err := strconv.Atoi(digits[5:]); err != nil {
+		return State{}, ErrInvalidCEP
+	}
+
+	for _, r := range cepRanges {
+		if prefix >= r.from && prefix <= r.to {
+			return r.state, nil
+		}
+	}
+
+	return State{}, ErrInvalidCEP
+}
@@ -108,3 +108,28 @@ func TestRegion_JSONRoundTrip2(t *testing.T) {
 		"expected same state code after round trip",
 	)
 }
+
+func TestFromCEP(t *testing.T) {
+	cases := map[string]string{
+		"01310-100": "SP",
+		"20040020":  "RJ",
+		"69301-000": "RR",
+		"69900-000": "AC",
+		"70040-010": "DF",
+		"74000-000": "GO",
+		"90010-000": "RS",
+	}
+
+	for cep, sigla := range cases {
+		state, err := FromCEP(cep)
+		assert.NoError(t, err, "expected no error for CEP %s", cep)
+		assert.Equal(t, sigla, state.Sigla, "unexpected state for CEP %s", cep)
+	}
+}
+
+func TestFromCEP_Invalid(t *testing.T) {
+	for _, cep := range []string{"", "1234", "00000-000", "ABCDE-123", "01310-10X"} {
+		_, err := FromCEP(cep)
+		assert.ErrorIs(t, err, ErrInvalidCEP, "expected error for CEP %q", cep)
+	}
+}
@@ -0,0 +1,85 @@
+package tax
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+)
+
+type Route struct {
+	Origin      states.State
+	Destination states.State
+}
+
+type ICMS struct {
+	Rate   decimal.Decimal
+	Amount decimal.Decimal
+}
+
+var (
+	interstateRate        = decimal.NewFromInt(12)
+	reducedInterstateRate = decimal.NewFromInt(7)
+	hundred               = decimal.NewFromInt(100)
+)
+
+var intrastateRates = map[string]decimal.Decimal{
+	states.AC.Sigla: decimal.NewFromInt(19),
+	states.AL.Sigla: decimal.NewFromInt(19),
+	states.AP.Sigla: decimal.NewFromInt(18),
+	states.AM.Sigla: decimal.NewFromInt(20),
+	states.BA.Sigla: decimal.NewFromFloat(20.5),
+	states.CE.Sigla: decimal.NewFromInt(20),
+	states.DF.Sigla: decimal.NewFromInt(20),
+	states.ES.Sigla: decimal.NewFromInt(17),
+	states.GO.Sigla: decimal.NewFromInt(19),
+	states.MA.Sigla: decimal.NewFromInt(23),
+	states.MT.Sigla: decimal.NewFromInt(17),
+	states.MS.Sigla: decimal.NewFromInt(17),
+	states.MG.Sigla: decimal.NewFromInt(18),
+	states.PA.Sigla: decimal.NewFromInt(19),
+	states.PB.Sigla: decimal.NewFromInt(20),
+	states.PR.Sigla: decimal.NewFromFloat(19.5),
+	states.PE.Sigla: decimal.NewFromFloat(20.5),
+	states.PI.Sigla: decimal.NewFromFloat(22.5),
+	states.RJ.Sigla: decimal.NewFromInt(22),
+	states.RN.Sigla: decimal.NewFromInt(20),
+	states.RS.Sigla: decimal.NewFromInt(17),
+	states.RO.Sigla: decimal.NewFromFloat(19.5),
+	states.RR.Sigla: decimal.NewFromInt(20),
+	states.SC.Sigla: decimal.NewFromInt(17),
+	states.SP.Sigla: decimal.NewFromInt(18),
+	states.SE.Sigla: decimal.NewFromInt(20),
+	states.TO.Sigla: decimal.NewFromInt(20),
+}
+
+func (r Route) Interstate() bool {
+	return r.Origin.Sigla != r.Destination.Sigla
+}
+
+func (r Route) ICMSRate() decimal.Decimal {
+	if r.Origin.Sigla == "" || r.Destination.Sigla == "" {
+		return decimal.Zero
+	}
+
+	if !r.Interstate() {
+		return intrastateRates[r.Origin.Sigla]
+	}
+
+	if developed(r.Origin) && !developed(r.Destination) {
+		return reducedInterstateRate
+	}
+	return interstateRate
+}
+
+func (r Route) ICMS(netFreight decimal.Decimal) ICMS {
+	rate := r.ICMSRate()
+	if rate.IsZero() || !netFreight.IsPositive() {
+		return ICMS{Rate: rate, Amount: decimal.Zero}
+	}
+
+	gross := netFreight.Div(decimal.NewFromInt(1).Sub(rate.Div(hundred)))
+	return ICMS{Rate: rate, Amount: gross.Sub(netFreight).Round(2)}
+}
+
+func developed(s states.State) bool {
+	return (s.Region == states.Sul.Name || s.Region == states.Sudeste.Name) && s.Sigla != states.ES.Sigla
+}
@@ -0,0 +1,49 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorvcruz/shipment-coordinator/pkg/states"
+	"github.com/victorvcruz/shipment-coordinator/pkg/tax"
+)
+
+func TestRoute_ICMSRate(t *testing.T) {
+	tests := []struct {
+		name  string
+		route tax.Route
+		rate  string
+	}{
+		{"intrastate", tax.Route{Origin: states.SP, Destination: states.SP}, "18.00"},
+		{"south to south", tax.Route{Origin: states.SP, Destination: states.RJ}, "12.00"},
+		{"south to north", tax.Route{Origin: states.SP, Destination: states.BA}, "7.00"},
+		{"south to espirito santo", tax.Route{Origin: states.PR, Destination: states.ES}, "7.00"},
+		{"espirito santo to south", tax.Route{Origin: states.ES, Destination: states.SP}, "12.00"},
+		{"north to south", tax.Route{Origin: states.AM, Destination: states.SP}, "12.00"},
+		{"no origin", tax.Route{Destination: states.SP}, "0.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.rate, tt.route.ICMSRate().StringFixed(2))
+		})
+	}
+}
+
+func TestRoute_ICMS(t *testing.T) {
+	route := tax.Route{Origin: states.SP, Destination: states.RJ}
+
+	icms := route.ICMS(decimal.NewFromFloat(88))
+
+	assert.Equal(t, "12.00", icms.Rate.StringFixed(2))
+	assert.Equal(t, "12.00", icms.Amount.StringFixed(2))
+}
+
+func TestRoute_ICMS_ZeroFreight(t *testing.T) {
+	route := tax.Route{Origin: states.SP, Destination: states.RJ}
+
+	icms := route.ICMS(decimal.Zero)
+
+	assert.True(t, icms.Amount.IsZero())
+}